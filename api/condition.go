@@ -75,6 +75,7 @@ const (
 	ManagedNamespacesReadyType         ConditionType = "ManagedNamespacesReady"
 	CustomZoneMappingReadyType         ConditionType = "CustomZoneMappingReady"
 	SearchNodesReadyType               ConditionType = "SearchNodesReady"
+	PendingChangeWindowType            ConditionType = "PendingChangeWindow"
 )
 
 // AtlasDatabaseUser condition types
@@ -98,6 +99,7 @@ const (
 const (
 	StreamInstanceReadyType   ConditionType = "StreamInstanceReady"
 	StreamConnectionReadyType ConditionType = "StreamConnectionReady"
+	StreamProcessorReadyType  ConditionType = "StreamProcessorReady"
 )
 
 const (
@@ -126,9 +128,35 @@ const (
 	NetworkContainerReady ConditionType = "NetworkContainerReady"
 )
 
+// Atlas Backup Restore Job condition types
+const (
+	BackupRestoreJobReady ConditionType = "BackupRestoreJobReady"
+)
+
+// Atlas Backup Snapshot condition types
+const (
+	BackupSnapshotReady ConditionType = "BackupSnapshotReady"
+)
+
+// Atlas Search Index condition types
+const (
+	SearchIndexReady ConditionType = "SearchIndexReady"
+)
+
+// Atlas Online Archive condition types
+const (
+	OnlineArchiveReady ConditionType = "OnlineArchiveReady"
+)
+
 // Generic condition type
 const (
 	ResourceVersionStatus ConditionType = "ResourceVersionIsValid"
+	// DriftedType is set on resources reconciled with the detect-only policy, it is true
+	// when the resource in Atlas differs from its spec.
+	DriftedType ConditionType = "Drifted"
+	// AlertsOpenType is set on the resources of projects polling their Atlas alerts, it is true
+	// while alerts about the resource are open in Atlas.
+	AlertsOpenType ConditionType = "AlertsOpen"
 )
 
 // Condition describes the state of an Atlas Custom Resource at a certain point.
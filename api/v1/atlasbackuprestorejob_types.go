@@ -0,0 +1,112 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasBackupRestoreJob{}, &AtlasBackupRestoreJobList{})
+}
+
+// AtlasBackupRestoreJob is the Schema for the atlasbackuprestorejobs API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Atlas ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:categories=atlas,shortName=abrj
+type AtlasBackupRestoreJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasBackupRestoreJobSpec          `json:"spec,omitempty"`
+	Status status.AtlasBackupRestoreJobStatus `json:"status,omitempty"`
+}
+
+// AtlasBackupRestoreJobSpec defines the desired state of a cloud backup restore in Atlas.
+// A restore runs at most once, the spec cannot be changed once set.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable, create a new AtlasBackupRestoreJob to run another restore"
+// +kubebuilder:validation:XValidation:rule="[has(self.snapshotId), has(self.pointInTimeUTCSeconds), has(self.oplogTs)].filter(x, x).size() == 1",message="exactly one of snapshotId, pointInTimeUTCSeconds or oplogTs must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.oplogTs) == has(self.oplogInc)",message="oplogTs and oplogInc must be set together"
+type AtlasBackupRestoreJobSpec struct {
+	// deploymentRef is a reference to the AtlasDeployment whose backup is restored.
+	// The Atlas project and credentials of the deployment are used to run the restore.
+	// +required
+	DeploymentRef common.ResourceRefNamespaced `json:"deploymentRef"`
+
+	// snapshotId is the unique identifier of the snapshot to restore.
+	// Mutually exclusive with pointInTimeUTCSeconds and oplogTs.
+	// +kubebuilder:validation:Pattern:=^([a-f0-9]{24})$
+	// +optional
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// pointInTimeUTCSeconds is the timestamp, in seconds since the epoch, to restore to.
+	// Requires Continuous Cloud Backup on the source deployment.
+	// Mutually exclusive with snapshotId and oplogTs.
+	// +kubebuilder:validation:Minimum:=1199145600
+	// +optional
+	PointInTimeUTCSeconds *int `json:"pointInTimeUTCSeconds,omitempty"`
+
+	// oplogTs is the oplog timestamp, in seconds since the epoch, to restore to.
+	// Requires Continuous Cloud Backup on the source deployment and oplogInc.
+	// Mutually exclusive with snapshotId and pointInTimeUTCSeconds.
+	// +kubebuilder:validation:Minimum:=1199145600
+	// +optional
+	OplogTs *int `json:"oplogTs,omitempty"`
+
+	// oplogInc is the 32-bit incrementing ordinal of the operation within oplogTs to restore to.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	OplogInc *int `json:"oplogInc,omitempty"`
+
+	// targetDeploymentRef is a reference to the AtlasDeployment to restore the backup to.
+	// All data in the target deployment is replaced by the restored data.
+	// It may belong to a different project than the source deployment, as long as the
+	// credentials of the source deployment can access it.
+	// +required
+	TargetDeploymentRef common.ResourceRefNamespaced `json:"targetDeploymentRef"`
+}
+
+var _ api.AtlasCustomResource = &AtlasBackupRestoreJob{}
+
+func (rj *AtlasBackupRestoreJob) GetStatus() api.Status {
+	return rj.Status
+}
+
+func (rj *AtlasBackupRestoreJob) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	rj.Status.Conditions = conditions
+	rj.Status.ObservedGeneration = rj.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasBackupRestoreJobStatusOption)
+		v(&rj.Status)
+	}
+}
+
+// AtlasBackupRestoreJobList contains a list of AtlasBackupRestoreJob
+// +kubebuilder:object:root=true
+type AtlasBackupRestoreJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasBackupRestoreJob `json:"items"`
+}
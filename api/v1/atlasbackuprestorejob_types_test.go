@@ -0,0 +1,113 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestBackupRestoreJobCELChecks(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasBackupRestoreJob
+		expectedErrors []string
+	}{
+		{
+			title: "snapshot ID alone is valid",
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					SnapshotID: "678f55e2c5d1a34b2e4b2d10",
+				},
+			},
+		},
+		{
+			title: "oplog position is valid",
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					OplogTs:  new(1735787045),
+					OplogInc: new(1),
+				},
+			},
+		},
+		{
+			title: "fails without a backup selector",
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{},
+			},
+			expectedErrors: []string{"spec: Invalid value: exactly one of snapshotId, pointInTimeUTCSeconds or oplogTs must be set"},
+		},
+		{
+			title: "fails with two backup selectors",
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					SnapshotID:            "678f55e2c5d1a34b2e4b2d10",
+					PointInTimeUTCSeconds: new(1735787045),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: exactly one of snapshotId, pointInTimeUTCSeconds or oplogTs must be set"},
+		},
+		{
+			title: "fails with oplogTs but no oplogInc",
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					OplogTs: new(1735787045),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: oplogTs and oplogInc must be set together"},
+		},
+		{
+			title: "spec cannot be changed",
+			old: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					PointInTimeUTCSeconds: new(1735787045),
+				},
+			},
+			obj: &AtlasBackupRestoreJob{
+				Spec: AtlasBackupRestoreJobSpec{
+					PointInTimeUTCSeconds: new(1735790000),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: spec is immutable, create a new AtlasBackupRestoreJob to run another restore"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			// inject the deployments to avoid other validations being hit
+			for _, obj := range []*AtlasBackupRestoreJob{tc.old, tc.obj} {
+				if obj != nil {
+					obj.Spec.DeploymentRef = common.ResourceRefNamespaced{Name: "source"}
+					obj.Spec.TargetDeploymentRef = common.ResourceRefNamespaced{Name: "target"}
+				}
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasbackuprestorejobs.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
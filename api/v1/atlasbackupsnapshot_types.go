@@ -0,0 +1,100 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasBackupSnapshot{}, &AtlasBackupSnapshotList{})
+}
+
+// AtlasBackupSnapshot is the Schema for the atlasbackupsnapshots API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Atlas ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:printcolumn:name="Size",type=integer,JSONPath=`.status.storageSizeBytes`
+// +kubebuilder:resource:categories=atlas,shortName=abss
+type AtlasBackupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasBackupSnapshotSpec          `json:"spec,omitempty"`
+	Status status.AtlasBackupSnapshotStatus `json:"status,omitempty"`
+}
+
+// AtlasBackupSnapshotSpec defines the desired state of an on-demand cloud backup snapshot in Atlas.
+// A snapshot is taken once, only its retention can be changed afterwards.
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && !has(self.deploymentRef)) || (!has(self.externalDeploymentRef) && has(self.deploymentRef))",message="must define only one deployment reference through externalDeploymentRef or deploymentRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && has(self.connectionSecret)) || !has(self.externalDeploymentRef)",message="must define a local connection secret when referencing an external deployment"
+// +kubebuilder:validation:XValidation:rule="(self.deploymentRef == oldSelf.deploymentRef) || (!has(self.deploymentRef) && !has(oldSelf.deploymentRef))",message="deploymentRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.externalDeploymentRef == oldSelf.externalDeploymentRef) || (!has(self.externalDeploymentRef) && !has(oldSelf.externalDeploymentRef))",message="externalDeploymentRef is immutable"
+// +kubebuilder:validation:XValidation:rule="self.description == oldSelf.description",message="description is immutable"
+type AtlasBackupSnapshotSpec struct {
+	DeploymentDualReference `json:",inline"`
+
+	// description of the on-demand snapshot.
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	Description string `json:"description"`
+
+	// retentionInDays is the number of days Atlas keeps the snapshot.
+	// It can be changed after the snapshot was taken.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	// +optional
+	RetentionInDays int `json:"retentionInDays,omitempty"`
+}
+
+var _ api.AtlasCustomResource = &AtlasBackupSnapshot{}
+
+func (bs *AtlasBackupSnapshot) GetStatus() api.Status {
+	return bs.Status
+}
+
+func (bs *AtlasBackupSnapshot) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	bs.Status.Conditions = conditions
+	bs.Status.ObservedGeneration = bs.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasBackupSnapshotStatusOption)
+		v(&bs.Status)
+	}
+}
+
+// ProjectDualRef returns the project of an external deployment reference.
+// Snapshots of an AtlasDeployment resolve the project through the AtlasDeployment instead.
+func (bs *AtlasBackupSnapshot) ProjectDualRef() *ProjectDualReference {
+	if ref := bs.Spec.ProjectDualReference(); ref != nil {
+		return ref
+	}
+	return &ProjectDualReference{}
+}
+
+// AtlasBackupSnapshotList contains a list of AtlasBackupSnapshot
+// +kubebuilder:object:root=true
+type AtlasBackupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasBackupSnapshot `json:"items"`
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestBackupSnapshotCELChecks(t *testing.T) {
+	deploymentRef := DeploymentDualReference{
+		DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+	}
+	externalDeploymentRef := DeploymentDualReference{
+		ExternalDeploymentRef: &ExternalDeploymentReference{ProjectID: "project-id", Name: "deployment"},
+		ConnectionSecret:      &api.LocalObjectReference{Name: "secret"},
+	}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasBackupSnapshot
+		expectedErrors []string
+	}{
+		{
+			title: "deployment reference is valid",
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: deploymentRef},
+			},
+		},
+		{
+			title: "external deployment reference is valid",
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: externalDeploymentRef},
+			},
+		},
+		{
+			title: "fails without a deployment reference",
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{},
+			},
+			expectedErrors: []string{"spec: Invalid value: must define only one deployment reference through externalDeploymentRef or deploymentRef"},
+		},
+		{
+			title: "fails with an external deployment reference but no connection secret",
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{
+					DeploymentDualReference: DeploymentDualReference{
+						ExternalDeploymentRef: externalDeploymentRef.ExternalDeploymentRef,
+					},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: must define a local connection secret when referencing an external deployment"},
+		},
+		{
+			title: "description cannot be changed",
+			old: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: deploymentRef, Description: "old"},
+			},
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: deploymentRef, Description: "new"},
+			},
+			expectedErrors: []string{"spec: Invalid value: description is immutable"},
+		},
+		{
+			title: "retention can be changed",
+			old: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: deploymentRef, RetentionInDays: 1},
+			},
+			obj: &AtlasBackupSnapshot{
+				Spec: AtlasBackupSnapshotSpec{DeploymentDualReference: deploymentRef, RetentionInDays: 7},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			for _, obj := range []*AtlasBackupSnapshot{tc.old, tc.obj} {
+				if obj != nil && obj.Spec.Description == "" {
+					obj.Spec.Description = "some description"
+				}
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasbackupsnapshots.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConnectionSecretTemplateAnnotation names the AtlasConnectionSecretTemplate, in the namespace of the
+// database user, rendering additional keys into its connection Secrets.
+const ConnectionSecretTemplateAnnotation = "atlas.mongodb.com/connection-secret-template"
+
+func init() {
+	SchemeBuilder.Register(&AtlasConnectionSecretTemplate{}, &AtlasConnectionSecretTemplateList{})
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=atlas,shortName=acst
+//
+// AtlasConnectionSecretTemplate renders additional keys into the connection Secrets of the database users
+// annotated with it.
+type AtlasConnectionSecretTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AtlasConnectionSecretTemplateSpec `json:"spec,omitempty"`
+}
+
+// AtlasConnectionSecretTemplateList contains a list of AtlasConnectionSecretTemplate
+// +kubebuilder:object:root=true
+type AtlasConnectionSecretTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasConnectionSecretTemplate `json:"items"`
+}
+
+// AtlasConnectionSecretTemplateSpec defines the keys rendered into the connection Secrets.
+type AtlasConnectionSecretTemplateSpec struct {
+	// Data maps the connection Secret keys to the Go templates rendering their values.
+	// The templates are rendered with the connection data of each deployment, see the documentation for
+	// the available fields and functions. The keys written by the operator itself can't be overridden.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[-._a-zA-Z0-9]+$'))",message="keys must consist of alphanumeric characters, '-', '_' or '.'"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, !(k in ['username', 'password', 'type', 'provider', 'host', 'port', 'uri']) && !k.startsWith('connectionString'))",message="keys written by the operator can't be overridden"
+	Data map[string]string `json:"data"`
+}
+
+// ConnectionSecretTemplateKey returns the AtlasConnectionSecretTemplate annotated on the database user, if any.
+func ConnectionSecretTemplateKey(user client.Object) *client.ObjectKey {
+	name := user.GetAnnotations()[ConnectionSecretTemplateAnnotation]
+	if name == "" {
+		return nil
+	}
+	return &client.ObjectKey{Namespace: user.GetNamespace(), Name: name}
+}
+
+// ReadConnectionSecretTemplates returns the templates of the AtlasConnectionSecretTemplate annotated on the
+// database user, if any.
+func ReadConnectionSecretTemplates(ctx context.Context, kubeClient client.Client, user client.Object) (map[string]string, error) {
+	key := ConnectionSecretTemplateKey(user)
+	if key == nil {
+		return nil, nil
+	}
+	template := &AtlasConnectionSecretTemplate{}
+	if err := kubeClient.Get(ctx, *key, template); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasConnectionSecretTemplate %s: %w", key, err)
+	}
+	return template.Spec.Data, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestConnectionSecretTemplateCELChecks(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		obj            *AtlasConnectionSecretTemplate
+		expectedErrors []string
+	}{
+		{
+			title: "templates are valid",
+			obj: testConnectionSecretTemplate(map[string]string{
+				"SPRING_DATA_MONGODB_URI": "{{ .SrvURI }}",
+				"config.yaml":             "uri: {{ json .SrvURI }}",
+			}),
+		},
+		{
+			title: "a key the operator doesn't write is valid",
+			obj:   testConnectionSecretTemplate(map[string]string{"previousPassword": "{{ .Password }}"}),
+		},
+		{
+			title:          "fails with an invalid key",
+			obj:            testConnectionSecretTemplate(map[string]string{"my uri": "{{ .SrvURI }}"}),
+			expectedErrors: []string{"spec.data: Invalid value: keys must consist of alphanumeric characters, '-', '_' or '.'"},
+		},
+		{
+			title:          "fails when overriding the password",
+			obj:            testConnectionSecretTemplate(map[string]string{"password": "{{ .Password }}"}),
+			expectedErrors: []string{"spec.data: Invalid value: keys written by the operator can't be overridden"},
+		},
+		{
+			title:          "fails when overriding a service binding entry",
+			obj:            testConnectionSecretTemplate(map[string]string{"uri": "{{ .SrvURI }}"}),
+			expectedErrors: []string{"spec.data: Invalid value: keys written by the operator can't be overridden"},
+		},
+		{
+			title:          "fails when overriding a connection string",
+			obj:            testConnectionSecretTemplate(map[string]string{"connectionStringStandardSrv": "{{ .SrvURI }}"}),
+			expectedErrors: []string{"spec.data: Invalid value: keys written by the operator can't be overridden"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasconnectionsecrettemplates.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, nil)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
+
+func testConnectionSecretTemplate(data map[string]string) *AtlasConnectionSecretTemplate {
+	return &AtlasConnectionSecretTemplate{
+		Spec: AtlasConnectionSecretTemplateSpec{Data: data},
+	}
+}
@@ -50,6 +50,7 @@ const (
 // AtlasDatabaseUserSpec defines the target state of Database User in Atlas
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="!has(self.passwordPolicy) || has(self.passwordSecretRef)",message="passwordSecretRef must be set to name the Secret the password is generated into"
 type AtlasDatabaseUserSpec struct {
 	// ProjectReference is the dual external or kubernetes reference with access credentials.
 	ProjectDualReference `json:",inline"`
@@ -84,6 +85,10 @@ type AtlasDatabaseUserSpec struct {
 	// PasswordSecret is a reference to the Secret keeping the user password.
 	PasswordSecret *common.ResourceRef `json:"passwordSecretRef,omitempty"`
 
+	// PasswordPolicy makes the operator generate the password into the PasswordSecret, and rotate it on schedule.
+	// +optional
+	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty"`
+
 	// Username is a username for authenticating to MongoDB
 	// Human-readable label that represents the user that authenticates to MongoDB. The format of this label depends on the method of authentication:
 	// In case of AWS IAM: the value should be AWS ARN for the IAM User/Role;
@@ -114,6 +119,52 @@ type AtlasDatabaseUserSpec struct {
 	X509Type string `json:"x509Type,omitempty"`
 }
 
+const (
+	// StandbyUsernameSuffix names the second Atlas user of a database user whose password is rotated.
+	// A rotation alternates between both users, so the replaced password keeps working until the
+	// connection Secrets are switched to the new one.
+	StandbyUsernameSuffix = "-b"
+
+	// PasswordSecretUsernameKey records in the password Secret the Atlas user the password belongs to.
+	PasswordSecretUsernameKey = "username"
+)
+
+// PasswordPolicy configures the passwords generated by the operator.
+// +kubebuilder:validation:XValidation:rule="(has(self.digits) ? self.digits : 0) + (has(self.symbols) ? self.symbols : 0) <= (has(self.length) ? self.length : 32)",message="digits and symbols cannot exceed the length of the password"
+// +kubebuilder:validation:XValidation:rule="!has(self.rotationInterval) || duration(self.rotationInterval) > duration(has(self.rotationGracePeriod) ? self.rotationGracePeriod : '1h')",message="rotationGracePeriod must be shorter than rotationInterval"
+type PasswordPolicy struct {
+	// Length is the number of characters of the password.
+	// +kubebuilder:validation:Minimum:=12
+	// +kubebuilder:validation:Maximum:=128
+	// +kubebuilder:default:=32
+	// +optional
+	Length int `json:"length,omitempty"`
+
+	// Digits is the number of digits in the password.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:default:=6
+	// +optional
+	Digits int `json:"digits,omitempty"`
+
+	// Symbols is the number of symbols in the password.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Symbols int `json:"symbols,omitempty"`
+
+	// NoUpper excludes upper case letters from the password.
+	// +optional
+	NoUpper bool `json:"noUpper,omitempty"`
+
+	// RotationInterval is the time after which the password is replaced with a new one, e.g. 720h.
+	// The password is never rotated if not set.
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// RotationGracePeriod is the time the Atlas user holding the replaced password is kept after a rotation, 1h by default.
+	// +optional
+	RotationGracePeriod *metav1.Duration `json:"rotationGracePeriod,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
@@ -211,19 +262,59 @@ func (p *AtlasDatabaseUser) ReadPassword(ctx context.Context, kubeClient client.
 		if err := kubeClient.Get(ctx, *p.PasswordSecretObjectKey(), secret); err != nil {
 			return "", err
 		}
-		p, exist := secret.Data["password"]
-		switch {
-		case !exist:
-			return "", fmt.Errorf("secret %s is invalid: it doesn't contain 'password' field", secret.Name)
-		case len(p) == 0:
-			return "", fmt.Errorf("secret %s is invalid: the 'password' field is empty", secret.Name)
-		default:
-			return string(p), nil
-		}
+		return passwordFromSecret(secret)
 	}
 	return "", nil
 }
 
+// ReadCredentials returns the Atlas user authenticating with the password of the password Secret, and the password.
+func (p *AtlasDatabaseUser) ReadCredentials(ctx context.Context, kubeClient client.Client) (string, string, error) {
+	if p.Spec.PasswordSecret == nil {
+		return p.Spec.Username, "", nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, *p.PasswordSecretObjectKey(), secret); err != nil {
+		return "", "", err
+	}
+	password, err := passwordFromSecret(secret)
+	if err != nil {
+		return "", "", err
+	}
+	return p.PasswordUsername(secret), password, nil
+}
+
+// StandbyUsername returns the Atlas user alternating with the username of the spec when the password is rotated.
+func (p *AtlasDatabaseUser) StandbyUsername() string {
+	return p.Spec.Username + StandbyUsernameSuffix
+}
+
+// PasswordUsername returns the Atlas user the password of the given password Secret belongs to:
+// the standby user when the Secret records it, the username of the spec otherwise.
+func (p *AtlasDatabaseUser) PasswordUsername(secret *corev1.Secret) string {
+	if string(secret.Data[PasswordSecretUsernameKey]) == p.StandbyUsername() {
+		return p.StandbyUsername()
+	}
+	return p.Spec.Username
+}
+
+func passwordFromSecret(secret *corev1.Secret) (string, error) {
+	password, exist := secret.Data["password"]
+	switch {
+	case !exist:
+		return "", fmt.Errorf("secret %s is invalid: it doesn't contain 'password' field", secret.Name)
+	case len(password) == 0:
+		return "", fmt.Errorf("secret %s is invalid: the 'password' field is empty", secret.Name)
+	default:
+		return string(password), nil
+	}
+}
+
+// ReadConnectionSecretTemplates returns the templates of the annotated AtlasConnectionSecretTemplate, if any.
+func (p *AtlasDatabaseUser) ReadConnectionSecretTemplates(ctx context.Context, kubeClient client.Client) (map[string]string, error) {
+	return ReadConnectionSecretTemplates(ctx, kubeClient, p)
+}
+
 // ToAtlasSDK is clone of ToAtlas used temporarily for test migrations
 func (p AtlasDatabaseUser) ToAtlasSDK(ctx context.Context, kubeClient client.Client) (*admin.CloudDatabaseUser, error) {
 	password, err := p.ReadPassword(ctx, kubeClient)
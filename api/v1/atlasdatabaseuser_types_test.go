@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestDatabaseUserPasswordPolicyCELChecks(t *testing.T) {
+	spec := func(secret *common.ResourceRef, policy *PasswordPolicy) AtlasDatabaseUserSpec {
+		return AtlasDatabaseUserSpec{
+			ProjectDualReference: ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "project"}},
+			Username:             "user",
+			Roles:                []RoleSpec{{RoleName: "readWrite", DatabaseName: "admin"}},
+			PasswordSecret:       secret,
+			PasswordPolicy:       policy,
+		}
+	}
+	for _, tc := range []struct {
+		title          string
+		obj            *AtlasDatabaseUser
+		expectedErrors []string
+	}{
+		{
+			title: "password policy with a password secret is valid",
+			obj:   &AtlasDatabaseUser{Spec: spec(&common.ResourceRef{Name: "user-pass"}, &PasswordPolicy{Length: 24, Digits: 6, Symbols: 4})},
+		},
+		{
+			title:          "password policy requires a password secret",
+			obj:            &AtlasDatabaseUser{Spec: spec(nil, &PasswordPolicy{Length: 24})},
+			expectedErrors: []string{"spec: Invalid value: passwordSecretRef must be set to name the Secret the password is generated into"},
+		},
+		{
+			title:          "digits and symbols cannot exceed the length",
+			obj:            &AtlasDatabaseUser{Spec: spec(&common.ResourceRef{Name: "user-pass"}, &PasswordPolicy{Length: 12, Digits: 8, Symbols: 8})},
+			expectedErrors: []string{"spec.passwordPolicy: Invalid value: digits and symbols cannot exceed the length of the password"},
+		},
+		{
+			title: "rotation grace period shorter than the interval is valid",
+			obj: &AtlasDatabaseUser{Spec: spec(&common.ResourceRef{Name: "user-pass"}, &PasswordPolicy{
+				RotationInterval:    &metav1.Duration{Duration: 720 * time.Hour},
+				RotationGracePeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			})},
+		},
+		{
+			title: "rotation grace period cannot exceed the interval",
+			obj: &AtlasDatabaseUser{Spec: spec(&common.ResourceRef{Name: "user-pass"}, &PasswordPolicy{
+				RotationInterval: &metav1.Duration{Duration: 30 * time.Minute},
+			})},
+			expectedErrors: []string{"spec.passwordPolicy: Invalid value: rotationGracePeriod must be shorter than rotationInterval"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasdatabaseusers.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, nil)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
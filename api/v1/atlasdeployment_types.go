@@ -43,6 +43,8 @@ const (
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
 // +kubebuilder:validation:XValidation:rule="!has(self.serverlessSpec) || (oldSelf.hasValue() && oldSelf.value().serverlessSpec != null)",optionalOldSelf=true,message="serverlessSpec cannot be added - serverless instances are deprecated",fieldPath=.serverlessSpec
+// +kubebuilder:validation:XValidation:rule="!has(self.schedules) || has(self.deploymentSpec)",message="schedules are only supported with deploymentSpec",fieldPath=.schedules
+// +kubebuilder:validation:XValidation:rule="!has(self.changeWindow) || has(self.deploymentSpec)",message="changeWindow is only supported with deploymentSpec",fieldPath=.changeWindow
 type AtlasDeploymentSpec struct {
 	// ProjectReference is the dual external or kubernetes reference with access credentials
 	ProjectDualReference `json:",inline"`
@@ -73,6 +75,77 @@ type AtlasDeploymentSpec struct {
 	// Configuration for the Flex cluster API. https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Flex-Clusters
 	// +optional
 	FlexSpec *FlexSpec `json:"flexSpec,omitempty"`
+
+	// Schedules temporarily override the pause state or the instance sizes of the deployment,
+	// e.g. to pause a development deployment at night or to scale up before a known traffic peak.
+	// When several entries are active at the same time, the first one in the list applies.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Schedules []DeploymentSchedule `json:"schedules,omitempty"`
+
+	// ChangeWindow restricts when disruptive changes, i.e. changes of the cluster type, the MongoDB major version,
+	// the number of shards, the regions or the instance sizes, are applied to the deployment. Outside the window,
+	// only the other changes are applied and the disruptive ones are held until the window opens.
+	// +optional
+	ChangeWindow *ChangeWindow `json:"changeWindow,omitempty"`
+}
+
+// ChangeWindow is a recurring window, open from every activation of Start until the following activation of End.
+type ChangeWindow struct {
+	// Start is the cron expression of the opening of the window, in the minute, hour, day of month,
+	// month and day of week format, e.g. "0 2 * * SAT".
+	// +kubebuilder:validation:MinLength=1
+	Start string `json:"start"`
+
+	// End is the cron expression of the closing of the window, e.g. "0 6 * * SAT".
+	// +kubebuilder:validation:MinLength=1
+	End string `json:"end"`
+
+	// TimeZone is the IANA time zone Start and End are evaluated in, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// DeploymentSchedule is an entry of the schedule of a deployment. The entry is active from every
+// activation of Start until the following activation of End.
+// +kubebuilder:validation:XValidation:rule="has(self.paused) || has(self.instanceSize) || has(self.analyticsInstanceSize)",message="one of paused, instanceSize or analyticsInstanceSize must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.paused) || !self.paused || (!has(self.instanceSize) && !has(self.analyticsInstanceSize))",message="a paused deployment cannot be scaled"
+type DeploymentSchedule struct {
+	// Name identifies the entry in the status of the deployment.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Start is the cron expression of the start of the entry, in the minute, hour, day of month,
+	// month and day of week format, e.g. "0 20 * * MON-FRI".
+	// +kubebuilder:validation:MinLength=1
+	Start string `json:"start"`
+
+	// End is the cron expression of the end of the entry, e.g. "0 7 * * MON-FRI".
+	// +kubebuilder:validation:MinLength=1
+	End string `json:"end"`
+
+	// TimeZone is the IANA time zone Start and End are evaluated in, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Paused overrides spec.deploymentSpec.paused while the entry is active.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+
+	// InstanceSize overrides the instance size of the electable and read-only nodes of all regions while
+	// the entry is active. With compute auto-scaling, the instance size is also the minimum instance size
+	// auto-scaling may scale down to, it must be within the auto-scaling range and scaleDownEnabled must be set.
+	// +optional
+	InstanceSize string `json:"instanceSize,omitempty"`
+
+	// AnalyticsInstanceSize overrides the instance size of the analytics nodes of all regions while
+	// the entry is active. With analytics compute auto-scaling, it is also the minimum instance size of the
+	// analytics nodes, it must be within the auto-scaling range and scaleDownEnabled must be set.
+	// +optional
+	AnalyticsInstanceSize string `json:"analyticsInstanceSize,omitempty"`
 }
 
 type SearchNode struct {
@@ -0,0 +1,234 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasOnlineArchive{}, &AtlasOnlineArchiveList{})
+}
+
+// AtlasOnlineArchive is the Schema for the atlasonlinearchives API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Atlas ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:categories=atlas,shortName=aoa
+type AtlasOnlineArchive struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasOnlineArchiveSpec          `json:"spec,omitempty"`
+	Status status.AtlasOnlineArchiveStatus `json:"status,omitempty"`
+}
+
+// AtlasOnlineArchiveSpec defines the desired state of an Online Archive of a deployment collection in Atlas.
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && !has(self.deploymentRef)) || (!has(self.externalDeploymentRef) && has(self.deploymentRef))",message="must define only one deployment reference through externalDeploymentRef or deploymentRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && has(self.connectionSecret)) || !has(self.externalDeploymentRef)",message="must define a local connection secret when referencing an external deployment"
+// +kubebuilder:validation:XValidation:rule="(self.deploymentRef == oldSelf.deploymentRef) || (!has(self.deploymentRef) && !has(oldSelf.deploymentRef))",message="deploymentRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.externalDeploymentRef == oldSelf.externalDeploymentRef) || (!has(self.externalDeploymentRef) && !has(oldSelf.externalDeploymentRef))",message="externalDeploymentRef is immutable"
+type AtlasOnlineArchiveSpec struct {
+	DeploymentDualReference `json:",inline"`
+
+	AtlasOnlineArchiveConfig `json:",inline"`
+}
+
+// AtlasOnlineArchiveConfig defines the Atlas specifics of the desired state of an Online Archive
+// +kubebuilder:validation:XValidation:rule="self.dbName == oldSelf.dbName",message="dbName is immutable"
+// +kubebuilder:validation:XValidation:rule="self.collName == oldSelf.collName",message="collName is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.collectionType == oldSelf.collectionType) || (!has(self.collectionType) && !has(oldSelf.collectionType))",message="collectionType is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.partitionFields == oldSelf.partitionFields) || (!has(self.partitionFields) && !has(oldSelf.partitionFields))",message="partitionFields are immutable"
+type AtlasOnlineArchiveConfig struct {
+	// dbName is the name of the database that contains the collection to archive.
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	DBName string `json:"dbName"`
+
+	// collName is the name of the collection to archive.
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	CollName string `json:"collName"`
+
+	// collectionType is the type of the collection to archive.
+	// +kubebuilder:validation:Enum:=STANDARD;TIMESERIES
+	// +kubebuilder:default:=STANDARD
+	// +optional
+	CollectionType string `json:"collectionType,omitempty"`
+
+	// criteria selects the documents to archive.
+	// +required
+	Criteria OnlineArchiveCriteria `json:"criteria"`
+
+	// partitionFields are the fields, in order, used to partition the archived data.
+	// They cannot be changed once the archive is created.
+	// +kubebuilder:validation:MaxItems:=2
+	// +optional
+	PartitionFields []OnlineArchivePartitionField `json:"partitionFields,omitempty"`
+
+	// schedule restricts when Atlas runs the archive job. Atlas runs it every 5 minutes by default.
+	// +optional
+	Schedule *OnlineArchiveSchedule `json:"schedule,omitempty"`
+
+	// dataExpirationRule deletes archived data after the given number of days.
+	// +optional
+	DataExpirationRule *OnlineArchiveDataExpirationRule `json:"dataExpirationRule,omitempty"`
+
+	// paused stops archiving data, when true. Archived data remains available.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// OnlineArchiveCriteria selects the documents to archive, either by age or by a custom query.
+// +kubebuilder:validation:XValidation:rule="self.type != 'DATE' || (has(self.dateField) && has(self.expireAfterDays) && !has(self.query))",message="DATE criteria require dateField and expireAfterDays, and do not accept a query"
+// +kubebuilder:validation:XValidation:rule="self.type != 'CUSTOM' || (has(self.query) && !has(self.dateField) && !has(self.dateFormat) && !has(self.expireAfterDays))",message="CUSTOM criteria require a query, and do not accept date fields"
+// +kubebuilder:validation:XValidation:rule="self.type == oldSelf.type",message="criteria type is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.dateField == oldSelf.dateField) || (!has(self.dateField) && !has(oldSelf.dateField))",message="dateField is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.dateFormat == oldSelf.dateFormat) || (!has(self.dateFormat) && !has(oldSelf.dateFormat))",message="dateFormat is immutable"
+type OnlineArchiveCriteria struct {
+	// type of the criteria: DATE archives documents older than expireAfterDays, CUSTOM archives documents matching the query.
+	// +kubebuilder:validation:Enum:=DATE;CUSTOM
+	// +required
+	Type string `json:"type"`
+
+	// dateField is the indexed date field that holds the age of the documents, for DATE criteria.
+	// +optional
+	DateField string `json:"dateField,omitempty"`
+
+	// dateFormat is the format of the dateField, for DATE criteria.
+	// +kubebuilder:validation:Enum:=ISODATE;EPOCH_SECONDS;EPOCH_MILLIS;EPOCH_NANOSECONDS
+	// +optional
+	DateFormat string `json:"dateFormat,omitempty"`
+
+	// expireAfterDays is the age, in days, after which documents are archived, for DATE criteria.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	ExpireAfterDays *int `json:"expireAfterDays,omitempty"`
+
+	// query is the MongoDB find query, as JSON, that selects the documents to archive, for CUSTOM criteria.
+	// +optional
+	Query string `json:"query,omitempty"`
+}
+
+// OnlineArchivePartitionField is a field used to partition archived data.
+type OnlineArchivePartitionField struct {
+	// fieldName is the name of the field to partition by.
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	FieldName string `json:"fieldName"`
+
+	// order is the position of the field in the partition, starting at 0.
+	// The date field of DATE criteria is always partitioned first.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=2
+	// +required
+	Order int `json:"order"`
+}
+
+// OnlineArchiveSchedule is the time window in which Atlas runs the archive job.
+// +kubebuilder:validation:XValidation:rule="self.type == 'DEFAULT' || (has(self.startHour) && has(self.startMinute) && has(self.endHour) && has(self.endMinute))",message="DAILY, WEEKLY and MONTHLY schedules require a start and end time"
+// +kubebuilder:validation:XValidation:rule="self.type != 'WEEKLY' || has(self.dayOfWeek)",message="WEEKLY schedules require dayOfWeek"
+// +kubebuilder:validation:XValidation:rule="self.type != 'MONTHLY' || has(self.dayOfMonth)",message="MONTHLY schedules require dayOfMonth"
+type OnlineArchiveSchedule struct {
+	// type of the schedule.
+	// +kubebuilder:validation:Enum:=DEFAULT;DAILY;WEEKLY;MONTHLY
+	// +required
+	Type string `json:"type"`
+
+	// startHour is the UTC hour at which the archive job may start.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=23
+	// +optional
+	StartHour *int `json:"startHour,omitempty"`
+
+	// startMinute is the UTC minute at which the archive job may start.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=59
+	// +optional
+	StartMinute *int `json:"startMinute,omitempty"`
+
+	// endHour is the UTC hour at which the archive job must stop.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=23
+	// +optional
+	EndHour *int `json:"endHour,omitempty"`
+
+	// endMinute is the UTC minute at which the archive job must stop.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=59
+	// +optional
+	EndMinute *int `json:"endMinute,omitempty"`
+
+	// dayOfWeek is the day of the week, from 1 (Monday) to 7 (Sunday), for WEEKLY schedules.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=7
+	// +optional
+	DayOfWeek *int `json:"dayOfWeek,omitempty"`
+
+	// dayOfMonth is the day of the month, from 1 to 31, for MONTHLY schedules.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=31
+	// +optional
+	DayOfMonth *int `json:"dayOfMonth,omitempty"`
+}
+
+// OnlineArchiveDataExpirationRule deletes archived data once expired.
+type OnlineArchiveDataExpirationRule struct {
+	// expireAfterDays is the number of days after which archived data is deleted.
+	// +kubebuilder:validation:Minimum:=7
+	// +kubebuilder:validation:Maximum:=9215
+	// +required
+	ExpireAfterDays int `json:"expireAfterDays"`
+}
+
+var _ api.AtlasCustomResource = &AtlasOnlineArchive{}
+
+func (oa *AtlasOnlineArchive) GetStatus() api.Status {
+	return oa.Status
+}
+
+func (oa *AtlasOnlineArchive) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	oa.Status.Conditions = conditions
+	oa.Status.ObservedGeneration = oa.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasOnlineArchiveStatusOption)
+		v(&oa.Status)
+	}
+}
+
+// ProjectDualRef returns the project of an external deployment reference.
+// Archives of an AtlasDeployment resolve the project through the AtlasDeployment instead.
+func (oa *AtlasOnlineArchive) ProjectDualRef() *ProjectDualReference {
+	if ref := oa.Spec.ProjectDualReference(); ref != nil {
+		return ref
+	}
+	return &ProjectDualReference{}
+}
+
+// AtlasOnlineArchiveList contains a list of AtlasOnlineArchive
+// +kubebuilder:object:root=true
+type AtlasOnlineArchiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasOnlineArchive `json:"items"`
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestOnlineArchiveCELChecks(t *testing.T) {
+	deploymentRef := DeploymentDualReference{
+		DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+	}
+	dateCriteria := OnlineArchiveCriteria{Type: "DATE", DateField: "createdAt", ExpireAfterDays: new(90)}
+	customCriteria := OnlineArchiveCriteria{Type: "CUSTOM", Query: `{"status": "archived"}`}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasOnlineArchive
+		expectedErrors []string
+	}{
+		{
+			title: "date criteria are valid",
+			obj:   testOnlineArchive(deploymentRef, dateCriteria),
+		},
+		{
+			title: "custom criteria are valid",
+			obj:   testOnlineArchive(deploymentRef, customCriteria),
+		},
+		{
+			title: "fails without a deployment reference",
+			obj:   testOnlineArchive(DeploymentDualReference{}, dateCriteria),
+			expectedErrors: []string{
+				"spec: Invalid value: must define only one deployment reference through externalDeploymentRef or deploymentRef",
+			},
+		},
+		{
+			title: "fails with an external deployment reference but no connection secret",
+			obj: testOnlineArchive(DeploymentDualReference{
+				ExternalDeploymentRef: &ExternalDeploymentReference{ProjectID: "project-id", Name: "deployment"},
+			}, dateCriteria),
+			expectedErrors: []string{
+				"spec: Invalid value: must define a local connection secret when referencing an external deployment",
+			},
+		},
+		{
+			title: "external deployment reference is valid",
+			obj: testOnlineArchive(DeploymentDualReference{
+				ExternalDeploymentRef: &ExternalDeploymentReference{ProjectID: "project-id", Name: "deployment"},
+				ConnectionSecret:      &api.LocalObjectReference{Name: "secret"},
+			}, dateCriteria),
+		},
+		{
+			title: "fails with date criteria without expireAfterDays",
+			obj:   testOnlineArchive(deploymentRef, OnlineArchiveCriteria{Type: "DATE", DateField: "createdAt"}),
+			expectedErrors: []string{
+				"spec.criteria: Invalid value: DATE criteria require dateField and expireAfterDays, and do not accept a query",
+			},
+		},
+		{
+			title: "fails with custom criteria with a date field",
+			obj:   testOnlineArchive(deploymentRef, OnlineArchiveCriteria{Type: "CUSTOM", Query: "{}", DateField: "createdAt"}),
+			expectedErrors: []string{
+				"spec.criteria: Invalid value: CUSTOM criteria require a query, and do not accept date fields",
+			},
+		},
+		{
+			title: "collection cannot be changed",
+			old:   testOnlineArchive(deploymentRef, dateCriteria),
+			obj: func() *AtlasOnlineArchive {
+				archive := testOnlineArchive(deploymentRef, dateCriteria)
+				archive.Spec.CollName = "invoices"
+				return archive
+			}(),
+			expectedErrors: []string{"spec: Invalid value: collName is immutable"},
+		},
+		{
+			title:          "criteria type cannot be changed",
+			old:            testOnlineArchive(deploymentRef, dateCriteria),
+			obj:            testOnlineArchive(deploymentRef, customCriteria),
+			expectedErrors: []string{"spec.criteria: Invalid value: criteria type is immutable"},
+		},
+		{
+			title: "archive can be paused and expiration changed",
+			old:   testOnlineArchive(deploymentRef, dateCriteria),
+			obj: func() *AtlasOnlineArchive {
+				archive := testOnlineArchive(deploymentRef, OnlineArchiveCriteria{Type: "DATE", DateField: "createdAt", ExpireAfterDays: new(30)})
+				archive.Spec.Paused = true
+				return archive
+			}(),
+		},
+		{
+			title: "fails with a weekly schedule without a day",
+			obj: func() *AtlasOnlineArchive {
+				archive := testOnlineArchive(deploymentRef, dateCriteria)
+				archive.Spec.Schedule = &OnlineArchiveSchedule{
+					Type:        "WEEKLY",
+					StartHour:   new(1),
+					StartMinute: new(0),
+					EndHour:     new(5),
+					EndMinute:   new(0),
+				}
+				return archive
+			}(),
+			expectedErrors: []string{"spec.schedule: Invalid value: WEEKLY schedules require dayOfWeek"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasonlinearchives.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
+
+func testOnlineArchive(ref DeploymentDualReference, criteria OnlineArchiveCriteria) *AtlasOnlineArchive {
+	return &AtlasOnlineArchive{
+		Spec: AtlasOnlineArchiveSpec{
+			DeploymentDualReference: ref,
+			AtlasOnlineArchiveConfig: AtlasOnlineArchiveConfig{
+				DBName:   "sales",
+				CollName: "orders",
+				Criteria: criteria,
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasPolicy{}, &AtlasPolicyList{}, &AtlasClusterPolicy{}, &AtlasClusterPolicyList{})
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=atlas,shortName=apol
+//
+// AtlasPolicy restricts the Atlas resources that can be created in its namespace.
+type AtlasPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AtlasPolicySpec `json:"spec,omitempty"`
+}
+
+// AtlasPolicyList contains a list of AtlasPolicy
+// +kubebuilder:object:root=true
+type AtlasPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasPolicy `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=atlas,scope=Cluster,shortName=acpol
+//
+// AtlasClusterPolicy restricts the Atlas resources that can be created in all namespaces,
+// or in the namespaces matching its namespace selector.
+type AtlasClusterPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AtlasClusterPolicySpec `json:"spec,omitempty"`
+}
+
+// AtlasClusterPolicyList contains a list of AtlasClusterPolicy
+// +kubebuilder:object:root=true
+type AtlasClusterPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasClusterPolicy `json:"items"`
+}
+
+// AtlasClusterPolicySpec defines the restrictions of an AtlasClusterPolicy.
+type AtlasClusterPolicySpec struct {
+	// NamespaceSelector selects the namespaces the policy applies to. The policy applies to all namespaces if unset.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	AtlasPolicySpec `json:",inline"`
+}
+
+// AtlasPolicySpec defines the restrictions of a policy. A resource must comply with all the policies
+// applying to its namespace.
+type AtlasPolicySpec struct {
+	// Deployments restricts the AtlasDeployments.
+	// +optional
+	Deployments *DeploymentPolicy `json:"deployments,omitempty"`
+	// IPAccessList restricts the IP access list entries of AtlasProjects and AtlasIPAccessLists.
+	// +optional
+	IPAccessList *IPAccessListPolicy `json:"ipAccessList,omitempty"`
+}
+
+// DeploymentPolicy restricts the AtlasDeployments.
+type DeploymentPolicy struct {
+	// AllowedProviders lists the cloud providers deployments can be created in.
+	// The backing provider is checked for shared, serverless and flex deployments.
+	// +kubebuilder:validation:items:Enum=AWS;GCP;AZURE
+	// +optional
+	AllowedProviders []string `json:"allowedProviders,omitempty"`
+	// AllowedRegions lists the Atlas regions deployments can be created in, e.g. US_EAST_1.
+	// +optional
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+	// MinInstanceSize is the smallest instance size of dedicated deployments, including their autoscaling range.
+	// +kubebuilder:validation:Pattern=^[MR][0-9]+(_NVME)?$
+	// +optional
+	MinInstanceSize string `json:"minInstanceSize,omitempty"`
+	// MaxInstanceSize is the largest instance size of dedicated deployments, including their autoscaling range.
+	// +kubebuilder:validation:Pattern=^[MR][0-9]+(_NVME)?$
+	// +optional
+	MaxInstanceSize string `json:"maxInstanceSize,omitempty"`
+	// AllowedMongoDBVersions lists the MongoDB major versions of dedicated deployments, e.g. 8.0.
+	// Deployments must set spec.deploymentSpec.mongoDBMajorVersion when this list is set.
+	// +kubebuilder:validation:items:Pattern=^[0-9]+\.[0-9]+$
+	// +optional
+	AllowedMongoDBVersions []string `json:"allowedMongoDBVersions,omitempty"`
+	// RequireBackup requires dedicated deployments to enable cloud backups.
+	// Serverless and flex deployments are always backed up by Atlas and are exempt.
+	// +optional
+	RequireBackup bool `json:"requireBackup,omitempty"`
+	// ChangeWindow restricts when disruptive changes are applied to dedicated deployments, in addition to
+	// their own spec.changeWindow. Disruptive changes are applied when all the windows are open.
+	// +optional
+	ChangeWindow *ChangeWindow `json:"changeWindow,omitempty"`
+}
+
+// IPAccessListPolicy restricts the IP access list entries.
+type IPAccessListPolicy struct {
+	// AllowedCIDRs lists the ranges the IP addresses and CIDR blocks of the entries must be within.
+	// AWS security groups can't be checked against the ranges and are not allowed.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))",message="allowedCIDRs must be valid CIDR blocks"
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestPolicyCELChecks(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		obj            *AtlasPolicy
+		expectedErrors []string
+	}{
+		{
+			title: "deployment policy is valid",
+			obj: &AtlasPolicy{
+				Spec: AtlasPolicySpec{
+					Deployments: &DeploymentPolicy{AllowedProviders: []string{"AWS"}, MinInstanceSize: "M10"},
+				},
+			},
+		},
+		{
+			title: "IPv4 and IPv6 CIDRs are valid",
+			obj: &AtlasPolicy{
+				Spec: AtlasPolicySpec{
+					IPAccessList: &IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}},
+				},
+			},
+		},
+		{
+			title: "fails with an IP address instead of a CIDR",
+			obj: &AtlasPolicy{
+				Spec: AtlasPolicySpec{
+					IPAccessList: &IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.1"}},
+				},
+			},
+			expectedErrors: []string{
+				"spec.ipAccessList.allowedCIDRs: Invalid value: allowedCIDRs must be valid CIDR blocks",
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&AtlasPolicy{})
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlaspolicies.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
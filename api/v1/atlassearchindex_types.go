@@ -16,8 +16,11 @@ package v1
 
 import (
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 )
 
 // SearchIndex is the CRD to configure part of the Atlas Search Index.
@@ -94,3 +97,77 @@ type VectorSearch struct {
 	// +required
 	Fields *apiextensions.JSON `json:"fields,omitempty"`
 }
+
+func init() {
+	SchemeBuilder.Register(&AtlasSearchIndex{}, &AtlasSearchIndexList{})
+}
+
+// AtlasSearchIndex is the Schema for the atlassearchindexes API.
+// It manages a single Atlas Search or Vector Search index of a deployment,
+// independently of the search indexes declared inline in the AtlasDeployment.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Queryable",type=boolean,JSONPath=`.status.queryable`
+// +kubebuilder:printcolumn:name="Atlas ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:categories=atlas,shortName=asi
+type AtlasSearchIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasSearchIndexSpec          `json:"spec,omitempty"`
+	Status status.AtlasSearchIndexStatus `json:"status,omitempty"`
+}
+
+// AtlasSearchIndexSpec defines the desired state of a search index of an Atlas deployment.
+// The index configuration of the "search" type is read from the referenced AtlasSearchIndexConfig.
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && !has(self.deploymentRef)) || (!has(self.externalDeploymentRef) && has(self.deploymentRef))",message="must define only one deployment reference through externalDeploymentRef or deploymentRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalDeploymentRef) && has(self.connectionSecret)) || !has(self.externalDeploymentRef)",message="must define a local connection secret when referencing an external deployment"
+// +kubebuilder:validation:XValidation:rule="(self.deploymentRef == oldSelf.deploymentRef) || (!has(self.deploymentRef) && !has(oldSelf.deploymentRef))",message="deploymentRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.externalDeploymentRef == oldSelf.externalDeploymentRef) || (!has(self.externalDeploymentRef) && !has(oldSelf.externalDeploymentRef))",message="externalDeploymentRef is immutable"
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+// +kubebuilder:validation:XValidation:rule="self.DBName == oldSelf.DBName",message="DBName is immutable"
+// +kubebuilder:validation:XValidation:rule="self.collectionName == oldSelf.collectionName",message="collectionName is immutable"
+// +kubebuilder:validation:XValidation:rule="self.type == oldSelf.type",message="type is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.type == 'search' && has(self.search) && !has(self.vectorSearch)) || (self.type == 'vectorSearch' && has(self.vectorSearch) && !has(self.search))",message="must define only the search or vectorSearch configuration matching the index type"
+type AtlasSearchIndexSpec struct {
+	DeploymentDualReference `json:",inline"`
+
+	SearchIndex `json:",inline"`
+}
+
+var _ api.AtlasCustomResource = &AtlasSearchIndex{}
+
+func (si *AtlasSearchIndex) GetStatus() api.Status {
+	return si.Status
+}
+
+func (si *AtlasSearchIndex) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	si.Status.Conditions = conditions
+	si.Status.ObservedGeneration = si.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasSearchIndexStatusOption)
+		v(&si.Status)
+	}
+}
+
+// ProjectDualRef returns the project of an external deployment reference.
+// Indexes of an AtlasDeployment resolve the project through the AtlasDeployment instead.
+func (si *AtlasSearchIndex) ProjectDualRef() *ProjectDualReference {
+	if ref := si.Spec.ProjectDualReference(); ref != nil {
+		return ref
+	}
+	return &ProjectDualReference{}
+}
+
+// AtlasSearchIndexList contains a list of AtlasSearchIndex
+// +kubebuilder:object:root=true
+type AtlasSearchIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasSearchIndex `json:"items"`
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestSearchIndexCELChecks(t *testing.T) {
+	deploymentRef := DeploymentDualReference{
+		DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+	}
+	externalDeploymentRef := DeploymentDualReference{
+		ExternalDeploymentRef: &ExternalDeploymentReference{ProjectID: "project-id", Name: "deployment"},
+		ConnectionSecret:      &api.LocalObjectReference{Name: "secret"},
+	}
+	searchIndex := SearchIndex{
+		Name:           "index",
+		DBName:         "db",
+		CollectionName: "collection",
+		Type:           "search",
+		Search: &Search{
+			SearchConfigurationRef: common.ResourceRefNamespaced{Name: "config"},
+		},
+	}
+	vectorSearchIndex := SearchIndex{
+		Name:           "index",
+		DBName:         "db",
+		CollectionName: "collection",
+		Type:           "vectorSearch",
+		VectorSearch:   &VectorSearch{},
+	}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasSearchIndex
+		expectedErrors []string
+	}{
+		{
+			title: "deployment reference is valid",
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: searchIndex},
+			},
+		},
+		{
+			title: "external deployment reference is valid",
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: externalDeploymentRef, SearchIndex: vectorSearchIndex},
+			},
+		},
+		{
+			title: "fails without a deployment reference",
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{SearchIndex: searchIndex},
+			},
+			expectedErrors: []string{"spec: Invalid value: must define only one deployment reference through externalDeploymentRef or deploymentRef"},
+		},
+		{
+			title: "fails with an external deployment reference but no connection secret",
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{
+					DeploymentDualReference: DeploymentDualReference{
+						ExternalDeploymentRef: externalDeploymentRef.ExternalDeploymentRef,
+					},
+					SearchIndex: searchIndex,
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: must define a local connection secret when referencing an external deployment"},
+		},
+		{
+			title: "fails with a configuration not matching the type",
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{
+					DeploymentDualReference: deploymentRef,
+					SearchIndex: SearchIndex{
+						Name:           "index",
+						DBName:         "db",
+						CollectionName: "collection",
+						Type:           "search",
+						VectorSearch:   &VectorSearch{},
+					},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: must define only the search or vectorSearch configuration matching the index type"},
+		},
+		{
+			title: "name cannot be changed",
+			old: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: searchIndex},
+			},
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{
+					DeploymentDualReference: deploymentRef,
+					SearchIndex: SearchIndex{
+						Name:           "renamed",
+						DBName:         searchIndex.DBName,
+						CollectionName: searchIndex.CollectionName,
+						Type:           searchIndex.Type,
+						Search:         searchIndex.Search,
+					},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: name is immutable"},
+		},
+		{
+			title: "type cannot be changed",
+			old: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: searchIndex},
+			},
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: vectorSearchIndex},
+			},
+			expectedErrors: []string{"spec: Invalid value: type is immutable"},
+		},
+		{
+			title: "collection cannot be changed",
+			old: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: searchIndex},
+			},
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{
+					DeploymentDualReference: deploymentRef,
+					SearchIndex: SearchIndex{
+						Name:           searchIndex.Name,
+						DBName:         searchIndex.DBName,
+						CollectionName: "other",
+						Type:           searchIndex.Type,
+						Search:         searchIndex.Search,
+					},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: collectionName is immutable"},
+		},
+		{
+			title: "mappings can be changed",
+			old: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{DeploymentDualReference: deploymentRef, SearchIndex: searchIndex},
+			},
+			obj: &AtlasSearchIndex{
+				Spec: AtlasSearchIndexSpec{
+					DeploymentDualReference: deploymentRef,
+					SearchIndex: SearchIndex{
+						Name:           searchIndex.Name,
+						DBName:         searchIndex.DBName,
+						CollectionName: searchIndex.CollectionName,
+						Type:           searchIndex.Type,
+						Search: &Search{
+							Mappings:               &Mappings{Dynamic: &apiextensions.JSON{Raw: []byte("true")}},
+							SearchConfigurationRef: searchIndex.Search.SearchConfigurationRef,
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlassearchindexes.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -23,16 +23,25 @@ import (
 )
 
 // AtlasStreamConnectionSpec defines the target state of AtlasStreamConnection.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Https' || has(self.httpsConfig)",message="httpsConfig is required for Https connections"
+// +kubebuilder:validation:XValidation:rule="!has(self.httpsConfig) || self.type == 'Https'",message="httpsConfig is only allowed for Https connections"
+// +kubebuilder:validation:XValidation:rule="!(self.type in ['AWSLambda', 'S3']) || has(self.awsConfig)",message="awsConfig is required for AWSLambda and S3 connections"
+// +kubebuilder:validation:XValidation:rule="!has(self.awsConfig) || self.type in ['AWSLambda', 'S3']",message="awsConfig is only allowed for AWSLambda and S3 connections"
+// +kubebuilder:validation:XValidation:rule="!has(self.awsConfig) || !has(self.awsConfig.testBucket) || self.type == 'S3'",message="awsConfig.testBucket is only allowed for S3 connections"
 type AtlasStreamConnectionSpec struct {
 	// Human-readable label that uniquely identifies the stream connection.
 	Name string `json:"name"`
-	// Type of the connection. Can be either Cluster or Kafka.
-	// +kubebuilder:validation:Enum:=Kafka;Cluster;Sample
+	// Type of the connection. Can be one of Cluster, Kafka, Sample, Https, AWSLambda or S3.
+	// +kubebuilder:validation:Enum:=Kafka;Cluster;Sample;Https;AWSLambda;S3
 	ConnectionType string `json:"type"`
 	// The configuration to be used to connect to an Atlas Cluster.
 	ClusterConfig *ClusterConnectionConfig `json:"clusterConfig,omitempty"`
 	// The configuration to be used to connect to a Kafka Cluster.
 	KafkaConfig *StreamsKafkaConnection `json:"kafkaConfig,omitempty"`
+	// The configuration to be used to connect to an HTTPS endpoint.
+	HTTPSConfig *StreamsHTTPSConnection `json:"httpsConfig,omitempty"`
+	// The configuration to be used to connect to AWS Lambda or S3.
+	AWSConfig *StreamsAWSConnection `json:"awsConfig,omitempty"`
 }
 
 type ClusterConnectionConfig struct {
@@ -77,6 +86,26 @@ type StreamsKafkaSecurity struct {
 	Certificate common.ResourceRefNamespaced `json:"certificate,omitempty"`
 }
 
+type StreamsHTTPSConnection struct {
+	// URL of the HTTPS endpoint.
+	// +kubebuilder:validation:Pattern:=^https://
+	URL string `json:"url"`
+	// Headers to send with every request to the endpoint.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Reference to a secret whose keys and values are sent as additional headers, e.g. an Authorization header.
+	// Headers from the secret take precedence over headers with the same name.
+	HeadersSecret *common.ResourceRefNamespaced `json:"headersSecret,omitempty"`
+}
+
+type StreamsAWSConnection struct {
+	// Amazon Resource Name of the IAM role Atlas assumes to access AWS.
+	// The role must be an authorized cloud provider access role of the project the stream instance belongs to.
+	// +kubebuilder:validation:Pattern:=^arn:aws:iam::[0-9]{12}:role/.+$
+	RoleARN string `json:"roleArn"`
+	// Name of an S3 bucket Atlas uses to verify it can access S3 with the role. Only used by S3 connections.
+	TestBucket string `json:"testBucket,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:categories=atlas,shortName=asc
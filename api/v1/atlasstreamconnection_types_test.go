@@ -0,0 +1,90 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestStreamConnectionCELChecks(t *testing.T) {
+	https := &StreamsHTTPSConnection{URL: "https://example.com/ingest"}
+	lambda := &StreamsAWSConnection{RoleARN: "arn:aws:iam::123456789012:role/streams"}
+	s3 := &StreamsAWSConnection{RoleARN: "arn:aws:iam::123456789012:role/streams", TestBucket: "bucket"}
+	for _, tc := range []struct {
+		title          string
+		obj            *AtlasStreamConnection
+		expectedErrors []string
+	}{
+		{
+			title: "sample connection is valid",
+			obj:   &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "sample_stream_solar", ConnectionType: "Sample"}},
+		},
+		{
+			title: "https connection is valid",
+			obj:   &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "https", ConnectionType: "Https", HTTPSConfig: https}},
+		},
+		{
+			title: "aws lambda connection is valid",
+			obj:   &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "lambda", ConnectionType: "AWSLambda", AWSConfig: lambda}},
+		},
+		{
+			title: "s3 connection with a test bucket is valid",
+			obj:   &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "s3", ConnectionType: "S3", AWSConfig: s3}},
+		},
+		{
+			title:          "https connection requires httpsConfig",
+			obj:            &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "https", ConnectionType: "Https"}},
+			expectedErrors: []string{"spec: Invalid value: httpsConfig is required for Https connections"},
+		},
+		{
+			title:          "httpsConfig is not allowed for other connections",
+			obj:            &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "sample_stream_solar", ConnectionType: "Sample", HTTPSConfig: https}},
+			expectedErrors: []string{"spec: Invalid value: httpsConfig is only allowed for Https connections"},
+		},
+		{
+			title:          "s3 connection requires awsConfig",
+			obj:            &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "s3", ConnectionType: "S3"}},
+			expectedErrors: []string{"spec: Invalid value: awsConfig is required for AWSLambda and S3 connections"},
+		},
+		{
+			title:          "awsConfig is not allowed for other connections",
+			obj:            &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "https", ConnectionType: "Https", HTTPSConfig: https, AWSConfig: lambda}},
+			expectedErrors: []string{"spec: Invalid value: awsConfig is only allowed for AWSLambda and S3 connections"},
+		},
+		{
+			title:          "test bucket is only allowed for s3 connections",
+			obj:            &AtlasStreamConnection{Spec: AtlasStreamConnectionSpec{Name: "lambda", ConnectionType: "AWSLambda", AWSConfig: s3}},
+			expectedErrors: []string{"spec: Invalid value: awsConfig.testBucket is only allowed for S3 connections"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasstreamconnections.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, nil)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+)
+
+const (
+	StreamProcessorStateStarted = "STARTED"
+	StreamProcessorStateStopped = "STOPPED"
+)
+
+// AtlasStreamProcessorSpec defines the target state of AtlasStreamProcessor.
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+// +kubebuilder:validation:XValidation:rule="self.instanceRef == oldSelf.instanceRef",message="instanceRef is immutable"
+type AtlasStreamProcessorSpec struct {
+	// Human-readable label that identifies the stream processor.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Stream instance the processor runs on.
+	Instance common.ResourceRefNamespaced `json:"instanceRef"`
+	// Aggregation pipeline of the processor. Either a list of stages, or a string holding the JSON array of stages.
+	// Connections used by the $source, $merge or $emit stages must be registered in the stream instance.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Pipeline apiextensions.JSON `json:"pipeline"`
+	// Optional configuration of the processor.
+	Options *StreamProcessorOptions `json:"options,omitempty"`
+	// Desired state of the processor. Can be either STARTED or STOPPED.
+	// +kubebuilder:validation:Enum:=STARTED;STOPPED
+	// +kubebuilder:default=STARTED
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+type StreamProcessorOptions struct {
+	// Dead letter queue receiving the documents the processor fails to process.
+	DLQ *StreamProcessorDLQ `json:"dlq,omitempty"`
+}
+
+type StreamProcessorDLQ struct {
+	// Name of the connection, registered in the stream instance, to write the dead letter queue to.
+	ConnectionName string `json:"connectionName"`
+	// Name of the database of the dead letter queue collection.
+	DB string `json:"db"`
+	// Name of the dead letter queue collection.
+	Coll string `json:"coll"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:resource:categories=atlas,shortName=asp
+
+// AtlasStreamProcessor is the Schema for the atlasstreamprocessors API
+type AtlasStreamProcessor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasStreamProcessorSpec          `json:"spec,omitempty"`
+	Status status.AtlasStreamProcessorStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasStreamProcessorList contains a list of AtlasStreamProcessor
+type AtlasStreamProcessorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasStreamProcessor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AtlasStreamProcessor{}, &AtlasStreamProcessorList{})
+}
+
+func (f *AtlasStreamProcessor) GetStatus() api.Status {
+	return f.Status
+}
+
+func (f *AtlasStreamProcessor) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	f.Status.Conditions = conditions
+	f.Status.ObservedGeneration = f.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasStreamProcessorStatusOption)
+		v(&f.Status)
+	}
+}
+
+func (f *AtlasStreamProcessor) AtlasStreamInstanceObjectKey() client.ObjectKey {
+	ns := f.Namespace
+	if f.Spec.Instance.Namespace != "" {
+		ns = f.Spec.Instance.Namespace
+	}
+
+	return kube.ObjectKey(ns, f.Spec.Instance.Name)
+}
+
+// DesiredState returns the desired state of the processor, started unless stopped explicitly.
+func (f *AtlasStreamProcessor) DesiredState() string {
+	if f.Spec.State == StreamProcessorStateStopped {
+		return StreamProcessorStateStopped
+	}
+	return StreamProcessorStateStarted
+}
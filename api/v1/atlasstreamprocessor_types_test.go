@@ -0,0 +1,82 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestStreamProcessorCELChecks(t *testing.T) {
+	spec := func(name, instance, pipeline string) AtlasStreamProcessorSpec {
+		return AtlasStreamProcessorSpec{
+			Name:     name,
+			Instance: common.ResourceRefNamespaced{Name: instance},
+			Pipeline: apiextensions.JSON{Raw: []byte(pipeline)},
+		}
+	}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasStreamProcessor
+		expectedErrors []string
+	}{
+		{
+			title: "pipeline as a list is valid",
+			obj:   &AtlasStreamProcessor{Spec: spec("solar", "instance", `[{"$source":{"connectionName":"sample"}}]`)},
+		},
+		{
+			title: "pipeline as a string is valid",
+			obj:   &AtlasStreamProcessor{Spec: spec("solar", "instance", `"[{\"$source\":{\"connectionName\":\"sample\"}}]"`)},
+		},
+		{
+			title: "pipeline can be changed",
+			old:   &AtlasStreamProcessor{Spec: spec("solar", "instance", `[{"$source":{"connectionName":"sample"}}]`)},
+			obj:   &AtlasStreamProcessor{Spec: spec("solar", "instance", `[{"$source":{"connectionName":"kafka"}}]`)},
+		},
+		{
+			title:          "name cannot be changed",
+			old:            &AtlasStreamProcessor{Spec: spec("solar", "instance", `[]`)},
+			obj:            &AtlasStreamProcessor{Spec: spec("wind", "instance", `[]`)},
+			expectedErrors: []string{"spec: Invalid value: name is immutable"},
+		},
+		{
+			title:          "instance cannot be changed",
+			old:            &AtlasStreamProcessor{Spec: spec("solar", "instance", `[]`)},
+			obj:            &AtlasStreamProcessor{Spec: spec("solar", "other-instance", `[]`)},
+			expectedErrors: []string{"spec: Invalid value: instanceRef is immutable"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasstreamprocessors.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+// DeploymentDualReference encapsulates the common constructs to refer to a parent deployment;
+// by either a Kubernetes reference or its Atlas project ID and name, which also requires access credentials.
+type DeploymentDualReference struct {
+	// deploymentRef is a reference to the parent AtlasDeployment resource.
+	// Mutually exclusive with the "externalDeploymentRef" field.
+	// +kubebuilder:validation:Optional
+	DeploymentRef *common.ResourceRefNamespaced `json:"deploymentRef,omitempty"`
+	// externalDeploymentRef holds the Atlas project ID and name of the parent deployment.
+	// Mutually exclusive with the "deploymentRef" field.
+	// +kubebuilder:validation:Optional
+	ExternalDeploymentRef *ExternalDeploymentReference `json:"externalDeploymentRef,omitempty"`
+	// Name of the secret containing Atlas API private and public keys.
+	ConnectionSecret *api.LocalObjectReference `json:"connectionSecret,omitempty"`
+}
+
+// ProjectDualReference returns the project reference equivalent to an external deployment reference,
+// so that credentials and projects are resolved the same way as for project children.
+// It returns nil for Kubernetes references, the parent AtlasDeployment resolves those.
+func (ref *DeploymentDualReference) ProjectDualReference() *ProjectDualReference {
+	if ref.ExternalDeploymentRef == nil {
+		return nil
+	}
+	return &ProjectDualReference{
+		ExternalProjectRef: &ExternalProjectReference{ID: ref.ExternalDeploymentRef.ProjectID},
+		ConnectionSecret:   ref.ConnectionSecret,
+	}
+}
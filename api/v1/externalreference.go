@@ -19,3 +19,12 @@ type ExternalProjectReference struct {
 	// +kubebuilder:validation:Required
 	ID string `json:"id"`
 }
+
+type ExternalDeploymentReference struct {
+	// projectId is the Atlas project ID of the deployment.
+	// +kubebuilder:validation:Required
+	ProjectID string `json:"projectId"`
+	// name is the Atlas name of the deployment.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+// OperationApproval is a destructive operation the operator holds until it is approved.
+type OperationApproval struct {
+	// Operation describes the destructive operation, e.g. "delete deployment my-deployment".
+	Operation string `json:"operation"`
+
+	// Token approves the operation when set in the mongodb.com/atlas-approve annotation.
+	// A new token is issued whenever the operation changes.
+	Token string `json:"token"`
+
+	// RequestedAt is the time, in ISO 8601 format in UTC, the operation was first held.
+	RequestedAt string `json:"requestedAt"`
+
+	// ApprovedAt is the time, in ISO 8601 format in UTC, the operation was approved. Empty while it is held.
+	// +optional
+	ApprovedAt string `json:"approvedAt,omitempty"`
+
+	// ApprovedBy is the identity that approved the operation, as recorded by the admission webhook.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasBackupRestoreJobPhase is the progress of a restore job in Atlas.
+type AtlasBackupRestoreJobPhase string
+
+const (
+	BackupRestoreJobPhaseInProgress AtlasBackupRestoreJobPhase = "InProgress"
+	BackupRestoreJobPhaseCompleted  AtlasBackupRestoreJobPhase = "Completed"
+	BackupRestoreJobPhaseFailed     AtlasBackupRestoreJobPhase = "Failed"
+	BackupRestoreJobPhaseCancelled  AtlasBackupRestoreJobPhase = "Cancelled"
+	BackupRestoreJobPhaseExpired    AtlasBackupRestoreJobPhase = "Expired"
+)
+
+// IsFinal returns true when the restore job can no longer progress.
+func (p AtlasBackupRestoreJobPhase) IsFinal() bool {
+	switch p {
+	case BackupRestoreJobPhaseCompleted, BackupRestoreJobPhaseFailed, BackupRestoreJobPhaseCancelled, BackupRestoreJobPhaseExpired:
+		return true
+	}
+	return false
+}
+
+// AtlasBackupRestoreJobStatus is the most recent observed status of the AtlasBackupRestoreJob.
+type AtlasBackupRestoreJobStatus struct {
+	api.Common `json:",inline"`
+
+	// ID of the restore job in Atlas.
+	ID string `json:"id,omitempty"`
+
+	// Phase of the restore job: InProgress, Completed, Failed, Cancelled or Expired.
+	Phase AtlasBackupRestoreJobPhase `json:"phase,omitempty"`
+
+	// SpecHash is the hash of the spec the restore job was started for.
+	// It prevents the same restore from running twice.
+	SpecHash string `json:"specHash,omitempty"`
+
+	// ProjectID is the Atlas project of the source deployment.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ClusterName is the Atlas name of the source deployment.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// SnapshotID is the snapshot restored, as reported by Atlas.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// FinishedAt is the time the restore job completed in Atlas, in ISO 8601 format.
+	FinishedAt string `json:"finishedAt,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasBackupRestoreJobStatusOption func(s *AtlasBackupRestoreJobStatus)
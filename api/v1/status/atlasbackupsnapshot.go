@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasBackupSnapshotPhase is the progress of an on-demand snapshot in Atlas.
+type AtlasBackupSnapshotPhase string
+
+const (
+	BackupSnapshotPhaseQueued     AtlasBackupSnapshotPhase = "Queued"
+	BackupSnapshotPhaseInProgress AtlasBackupSnapshotPhase = "InProgress"
+	BackupSnapshotPhaseCompleted  AtlasBackupSnapshotPhase = "Completed"
+	BackupSnapshotPhaseFailed     AtlasBackupSnapshotPhase = "Failed"
+)
+
+// IsFinal returns true when the snapshot can no longer progress.
+func (p AtlasBackupSnapshotPhase) IsFinal() bool {
+	return p == BackupSnapshotPhaseCompleted || p == BackupSnapshotPhaseFailed
+}
+
+// AtlasBackupSnapshotStatus is the most recent observed status of the AtlasBackupSnapshot.
+type AtlasBackupSnapshotStatus struct {
+	api.Common `json:",inline"`
+
+	// ID of the snapshot in Atlas.
+	ID string `json:"id,omitempty"`
+
+	// Phase of the snapshot: Queued, InProgress, Completed or Failed.
+	Phase AtlasBackupSnapshotPhase `json:"phase,omitempty"`
+
+	// ProjectID is the Atlas project of the deployment.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ClusterName is the Atlas name of the deployment.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// StorageSizeBytes is the size of the snapshot, as reported by Atlas.
+	StorageSizeBytes int64 `json:"storageSizeBytes,omitempty"`
+
+	// RetentionInDays is the retention last applied to the snapshot.
+	RetentionInDays int `json:"retentionInDays,omitempty"`
+
+	// CreatedAt is the time Atlas started the snapshot, in ISO 8601 format.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// CompletedAt is the time the snapshot was first observed completed, in ISO 8601 format.
+	CompletedAt string `json:"completedAt,omitempty"`
+
+	// ExpiresAt is the time Atlas deletes the snapshot, in ISO 8601 format.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasBackupSnapshotStatusOption func(s *AtlasBackupSnapshotStatus)
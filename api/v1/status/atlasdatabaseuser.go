@@ -29,12 +29,41 @@ func AtlasDatabaseUserPasswordVersion(passwordVersion string) AtlasDatabaseUserS
 	}
 }
 
+func AtlasDatabaseUserPasswordRotatedAt(rotatedAt string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.PasswordRotatedAt = rotatedAt
+	}
+}
+
+// AtlasDatabaseUserBindingOption advertises the connection Secret as the Service Binding of the user,
+// an empty name removes the binding.
+func AtlasDatabaseUserBindingOption(secretName string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.Binding = newBinding(secretName)
+	}
+}
+
 func AtlasDatabaseUserNameOption(name string) AtlasDatabaseUserStatusOption {
 	return func(s *AtlasDatabaseUserStatus) {
 		s.UserName = name
 	}
 }
 
+// AtlasDatabaseUserRetiringNameOption sets the Atlas user deleted once the grace period of the password rotation
+// elapsed, an empty name removes it.
+func AtlasDatabaseUserRetiringNameOption(name string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.RetiringUserName = name
+	}
+}
+
+// AtlasDatabaseUserApprovalOption sets the destructive operation held until it is approved, nil removes it.
+func AtlasDatabaseUserApprovalOption(approval *OperationApproval) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.Approval = approval
+	}
+}
+
 // AtlasDatabaseUserStatus defines the observed state of AtlasProject
 type AtlasDatabaseUserStatus struct {
 	api.Common `json:",inline"`
@@ -44,4 +73,20 @@ type AtlasDatabaseUserStatus struct {
 
 	// UserName is the current name of database user.
 	UserName string `json:"name,omitempty"`
+
+	// PasswordRotatedAt is the time the operator last generated the password, in RFC 3339 format.
+	// Only set when the password is generated according to a password policy.
+	PasswordRotatedAt string `json:"passwordRotatedAt,omitempty"`
+
+	// RetiringUserName is the Atlas user holding the password replaced by the last rotation.
+	// It is deleted once the rotation grace period elapsed.
+	RetiringUserName string `json:"retiringName,omitempty"`
+
+	// Binding is the connection Secret exposed according to the Service Binding specification (servicebinding.io).
+	// Only set when the user has a single connection Secret.
+	Binding *api.LocalObjectReference `json:"binding,omitempty"`
+
+	// Approval is the destructive operation on the database user held until it is approved.
+	// +optional
+	Approval *OperationApproval `json:"approval,omitempty"`
 }
@@ -52,6 +52,45 @@ type AtlasDeploymentStatus struct {
 
 	// SearchIndexes contains a list of search indexes statuses configured for a project.
 	SearchIndexes []DeploymentSearchIndexStatus `json:"searchIndexes,omitempty"`
+
+	// Binding is the connection Secret exposed according to the Service Binding specification (servicebinding.io).
+	// Only set when a single database user has a connection Secret for the deployment.
+	Binding *api.LocalObjectReference `json:"binding,omitempty"`
+
+	// Schedule reports the state of the schedules of the deployment.
+	Schedule *DeploymentScheduleStatus `json:"schedule,omitempty"`
+
+	// Approval is the destructive operation on the deployment held until it is approved.
+	// +optional
+	Approval *OperationApproval `json:"approval,omitempty"`
+
+	// Deletion reports the deletion of the deployment from Atlas scheduled after the resource was deleted.
+	// +optional
+	Deletion *DeploymentDeletionStatus `json:"deletion,omitempty"`
+}
+
+// DeploymentDeletionStatus reports the deletion of a deployment from Atlas held by the deletion grace period or the
+// final snapshot.
+type DeploymentDeletionStatus struct {
+	// ScheduledAt is the time, in ISO 8601 format in UTC, at which the deletion grace period ends.
+	ScheduledAt string `json:"scheduledAt"`
+
+	// FinalSnapshotID is the ID of the on-demand snapshot taken before deleting the deployment from Atlas.
+	// +optional
+	FinalSnapshotID string `json:"finalSnapshotID,omitempty"`
+
+	// FinalSnapshotPhase is the phase of the final snapshot.
+	// +optional
+	FinalSnapshotPhase AtlasBackupSnapshotPhase `json:"finalSnapshotPhase,omitempty"`
+}
+
+// DeploymentScheduleStatus reports the state of the schedules of a deployment.
+type DeploymentScheduleStatus struct {
+	// ActiveEntry is the name of the schedule entry applied to the deployment, empty when none is active.
+	ActiveEntry string `json:"activeEntry,omitempty"`
+
+	// NextTransition is the time, in ISO 8601 format in UTC, at which the next schedule entry starts or ends.
+	NextTransition string `json:"nextTransition,omitempty"`
 }
 
 const (
@@ -183,6 +222,35 @@ func AtlasDeploymentConnectionStringsOption(connStr *ConnectionStrings) AtlasDep
 	}
 }
 
+// AtlasDeploymentBindingOption advertises the connection Secret as the Service Binding of the deployment,
+// an empty name removes the binding.
+func AtlasDeploymentBindingOption(secretName string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Binding = newBinding(secretName)
+	}
+}
+
+// AtlasDeploymentScheduleOption sets the state of the schedules of the deployment, nil removes it.
+func AtlasDeploymentScheduleOption(schedule *DeploymentScheduleStatus) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Schedule = schedule
+	}
+}
+
+// AtlasDeploymentDeletionOption sets the scheduled deletion of the deployment from Atlas, nil removes it.
+func AtlasDeploymentDeletionOption(deletion *DeploymentDeletionStatus) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Deletion = deletion
+	}
+}
+
+// AtlasDeploymentApprovalOption sets the destructive operation held until it is approved, nil removes it.
+func AtlasDeploymentApprovalOption(approval *OperationApproval) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Approval = approval
+	}
+}
+
 func AtlasDeploymentRemoveStatusesWithEmptyIDs() AtlasDeploymentStatusOption {
 	return func(s *AtlasDeploymentStatus) {
 		var result []DeploymentSearchIndexStatus
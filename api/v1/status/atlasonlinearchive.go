@@ -0,0 +1,38 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasOnlineArchiveStatus is the most recent observed status of the AtlasOnlineArchive.
+type AtlasOnlineArchiveStatus struct {
+	api.Common `json:",inline"`
+
+	// ID of the Online Archive in Atlas.
+	ID string `json:"id,omitempty"`
+
+	// State of the Online Archive in Atlas: PENDING, ACTIVE, ARCHIVING, IDLE, PAUSING, PAUSED, ORPHANED or DELETED.
+	State string `json:"state,omitempty"`
+
+	// ProjectID is the Atlas project of the deployment.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ClusterName is the Atlas name of the deployment.
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasOnlineArchiveStatusOption func(s *AtlasOnlineArchiveStatus)
@@ -97,6 +97,13 @@ func AtlasProjectPrometheusOption(prometheus *Prometheus) AtlasProjectStatusOpti
 	}
 }
 
+// AtlasProjectApprovalOption sets the destructive operation held until it is approved, nil removes it.
+func AtlasProjectApprovalOption(approval *OperationApproval) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.Approval = approval
+	}
+}
+
 // AtlasProjectStatus defines the observed state of AtlasProject
 type AtlasProjectStatus struct {
 	api.Common `json:",inline"`
@@ -139,4 +146,8 @@ type AtlasProjectStatus struct {
 
 	// Status of the multiple regionalized private endpoint setting ("Multiple Regionalized Private Endpoints" setting in the UI)
 	RegionalizedPrivateEndpoint *project.RegionalizedPrivateEndpoint `json:"regionalizedPrivateEndpoint,omitempty"`
+
+	// Approval is the destructive operation on the project held until it is approved.
+	// +optional
+	Approval *OperationApproval `json:"approval,omitempty"`
 }
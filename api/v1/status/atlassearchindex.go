@@ -0,0 +1,56 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasSearchIndexStatus is the most recent observed status of the AtlasSearchIndex.
+type AtlasSearchIndexStatus struct {
+	api.Common `json:",inline"`
+
+	// ID of the search index in Atlas.
+	ID string `json:"id,omitempty"`
+
+	// ProjectID is the Atlas project of the deployment.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ClusterName is the Atlas name of the deployment.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// State is the build state of the index reported by Atlas, e.g. PENDING, BUILDING, READY or FAILED.
+	State string `json:"state,omitempty"`
+
+	// Queryable is true when the index can serve queries, which may happen before all nodes finished building it.
+	Queryable bool `json:"queryable,omitempty"`
+
+	// Hosts lists the build state of the index on each node of the deployment.
+	Hosts []SearchIndexHostStatus `json:"hosts,omitempty"`
+}
+
+// SearchIndexHostStatus is the state of a search index on a node of the deployment.
+type SearchIndexHostStatus struct {
+	// Hostname of the node.
+	Hostname string `json:"hostname"`
+
+	// State of the index on the node.
+	State string `json:"state,omitempty"`
+
+	// Queryable is true when the index can serve queries on the node.
+	Queryable bool `json:"queryable,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasSearchIndexStatusOption func(s *AtlasSearchIndexStatus)
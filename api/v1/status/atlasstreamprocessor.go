@@ -0,0 +1,51 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasStreamProcessorStatus defines the observed state of AtlasStreamProcessor.
+type AtlasStreamProcessorStatus struct {
+	api.Common `json:",inline"`
+	// Unique 24-hexadecimal character string that identifies the processor
+	ID string `json:"id,omitempty"`
+	// State of the processor in Atlas: CREATED, STARTED, STOPPED or FAILED.
+	State string `json:"state,omitempty"`
+	// Project which the stream instance belongs to.
+	ProjectID string `json:"projectId,omitempty"`
+	// Name of the stream instance the processor runs on.
+	InstanceName string `json:"instanceName,omitempty"`
+	// Statistics of the processor, as last reported by Atlas.
+	Stats *StreamProcessorStats `json:"stats,omitempty"`
+}
+
+type StreamProcessorStats struct {
+	// Number of documents received by the processor.
+	InputMessageCount int64 `json:"inputMessageCount,omitempty"`
+	// Size in bytes of the documents received by the processor.
+	InputMessageSize int64 `json:"inputMessageSize,omitempty"`
+	// Number of documents emitted by the processor.
+	OutputMessageCount int64 `json:"outputMessageCount,omitempty"`
+	// Size in bytes of the documents emitted by the processor.
+	OutputMessageSize int64 `json:"outputMessageSize,omitempty"`
+	// Number of documents sent to the dead letter queue.
+	DLQMessageCount int64 `json:"dlqMessageCount,omitempty"`
+	// Size in bytes of the documents sent to the dead letter queue.
+	DLQMessageSize int64 `json:"dlqMessageSize,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasStreamProcessorStatusOption func(s *AtlasStreamProcessorStatus)
@@ -21,6 +21,7 @@ package status
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/authmode"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
@@ -103,6 +104,38 @@ func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupRestoreJobStatus) DeepCopyInto(out *AtlasBackupRestoreJobStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupRestoreJobStatus.
+func (in *AtlasBackupRestoreJobStatus) DeepCopy() *AtlasBackupRestoreJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupRestoreJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupSnapshotStatus) DeepCopyInto(out *AtlasBackupSnapshotStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupSnapshotStatus.
+func (in *AtlasBackupSnapshotStatus) DeepCopy() *AtlasBackupSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasCustomRoleStatus) DeepCopyInto(out *AtlasCustomRoleStatus) {
 	*out = *in
@@ -123,6 +156,16 @@ func (in *AtlasCustomRoleStatus) DeepCopy() *AtlasCustomRoleStatus {
 func (in *AtlasDatabaseUserStatus) DeepCopyInto(out *AtlasDatabaseUserStatus) {
 	*out = *in
 	in.Common.DeepCopyInto(&out.Common)
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserStatus.
@@ -171,6 +214,26 @@ func (in *AtlasDeploymentStatus) DeepCopyInto(out *AtlasDeploymentStatus) {
 		*out = make([]DeploymentSearchIndexStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(DeploymentScheduleStatus)
+		**out = **in
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		**out = **in
+	}
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = new(DeploymentDeletionStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentStatus.
@@ -282,6 +345,22 @@ func (in *AtlasNetworkPeeringStatus) DeepCopy() *AtlasNetworkPeeringStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOnlineArchiveStatus) DeepCopyInto(out *AtlasOnlineArchiveStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnlineArchiveStatus.
+func (in *AtlasOnlineArchiveStatus) DeepCopy() *AtlasOnlineArchiveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOnlineArchiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasOrgSettingsStatus) DeepCopyInto(out *AtlasOrgSettingsStatus) {
 	*out = *in
@@ -391,6 +470,11 @@ func (in *AtlasProjectStatus) DeepCopyInto(out *AtlasProjectStatus) {
 		*out = new(project.RegionalizedPrivateEndpoint)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectStatus.
@@ -419,6 +503,27 @@ func (in *AtlasSearchIndexConfigStatus) DeepCopy() *AtlasSearchIndexConfigStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexStatus) DeepCopyInto(out *AtlasSearchIndexStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]SearchIndexHostStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexStatus.
+func (in *AtlasSearchIndexStatus) DeepCopy() *AtlasSearchIndexStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasStreamConnectionStatus) DeepCopyInto(out *AtlasStreamConnectionStatus) {
 	*out = *in
@@ -466,6 +571,27 @@ func (in *AtlasStreamInstanceStatus) DeepCopy() *AtlasStreamInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasStreamProcessorStatus) DeepCopyInto(out *AtlasStreamProcessorStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = new(StreamProcessorStats)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamProcessorStatus.
+func (in *AtlasStreamProcessorStatus) DeepCopy() *AtlasStreamProcessorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasStreamProcessorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasThirdPartyIntegrationStatus) DeepCopyInto(out *AtlasThirdPartyIntegrationStatus) {
 	*out = *in
@@ -715,6 +841,36 @@ func (in *DataFederationStatus) DeepCopy() *DataFederationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentDeletionStatus) DeepCopyInto(out *DeploymentDeletionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentDeletionStatus.
+func (in *DeploymentDeletionStatus) DeepCopy() *DeploymentDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentScheduleStatus) DeepCopyInto(out *DeploymentScheduleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentScheduleStatus.
+func (in *DeploymentScheduleStatus) DeepCopy() *DeploymentScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentSearchIndexStatus) DeepCopyInto(out *DeploymentSearchIndexStatus) {
 	*out = *in
@@ -950,6 +1106,21 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationApproval) DeepCopyInto(out *OperationApproval) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationApproval.
+func (in *OperationApproval) DeepCopy() *OperationApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -1041,6 +1212,21 @@ func (in *ReplicaSet) DeepCopy() *ReplicaSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexHostStatus) DeepCopyInto(out *SearchIndexHostStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexHostStatus.
+func (in *SearchIndexHostStatus) DeepCopy() *SearchIndexHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessPrivateEndpoint) DeepCopyInto(out *ServerlessPrivateEndpoint) {
 	*out = *in
@@ -1072,6 +1258,21 @@ func (in *StreamConnection) DeepCopy() *StreamConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamProcessorStats) DeepCopyInto(out *StreamProcessorStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamProcessorStats.
+func (in *StreamProcessorStats) DeepCopy() *StreamProcessorStats {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamProcessorStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamProject) DeepCopyInto(out *TeamProject) {
 	*out = *in
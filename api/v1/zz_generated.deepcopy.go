@@ -20,6 +20,7 @@ package v1
 
 import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -511,6 +512,97 @@ func (in *AtlasBackupPolicySpec) DeepCopy() *AtlasBackupPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupRestoreJob) DeepCopyInto(out *AtlasBackupRestoreJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupRestoreJob.
+func (in *AtlasBackupRestoreJob) DeepCopy() *AtlasBackupRestoreJob {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupRestoreJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupRestoreJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupRestoreJobList) DeepCopyInto(out *AtlasBackupRestoreJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasBackupRestoreJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupRestoreJobList.
+func (in *AtlasBackupRestoreJobList) DeepCopy() *AtlasBackupRestoreJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupRestoreJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupRestoreJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupRestoreJobSpec) DeepCopyInto(out *AtlasBackupRestoreJobSpec) {
+	*out = *in
+	out.DeploymentRef = in.DeploymentRef
+	if in.PointInTimeUTCSeconds != nil {
+		in, out := &in.PointInTimeUTCSeconds, &out.PointInTimeUTCSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.OplogTs != nil {
+		in, out := &in.OplogTs, &out.OplogTs
+		*out = new(int)
+		**out = **in
+	}
+	if in.OplogInc != nil {
+		in, out := &in.OplogInc, &out.OplogInc
+		*out = new(int)
+		**out = **in
+	}
+	out.TargetDeploymentRef = in.TargetDeploymentRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupRestoreJobSpec.
+func (in *AtlasBackupRestoreJobSpec) DeepCopy() *AtlasBackupRestoreJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupRestoreJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasBackupSchedule) DeepCopyInto(out *AtlasBackupSchedule) {
 	*out = *in
@@ -598,6 +690,240 @@ func (in *AtlasBackupScheduleSpec) DeepCopy() *AtlasBackupScheduleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupSnapshot) DeepCopyInto(out *AtlasBackupSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupSnapshot.
+func (in *AtlasBackupSnapshot) DeepCopy() *AtlasBackupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupSnapshotList) DeepCopyInto(out *AtlasBackupSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasBackupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupSnapshotList.
+func (in *AtlasBackupSnapshotList) DeepCopy() *AtlasBackupSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupSnapshotSpec) DeepCopyInto(out *AtlasBackupSnapshotSpec) {
+	*out = *in
+	in.DeploymentDualReference.DeepCopyInto(&out.DeploymentDualReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupSnapshotSpec.
+func (in *AtlasBackupSnapshotSpec) DeepCopy() *AtlasBackupSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasClusterPolicy) DeepCopyInto(out *AtlasClusterPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasClusterPolicy.
+func (in *AtlasClusterPolicy) DeepCopy() *AtlasClusterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasClusterPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasClusterPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasClusterPolicyList) DeepCopyInto(out *AtlasClusterPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasClusterPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasClusterPolicyList.
+func (in *AtlasClusterPolicyList) DeepCopy() *AtlasClusterPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasClusterPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasClusterPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasClusterPolicySpec) DeepCopyInto(out *AtlasClusterPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.AtlasPolicySpec.DeepCopyInto(&out.AtlasPolicySpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasClusterPolicySpec.
+func (in *AtlasClusterPolicySpec) DeepCopy() *AtlasClusterPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasClusterPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasConnectionSecretTemplate) DeepCopyInto(out *AtlasConnectionSecretTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasConnectionSecretTemplate.
+func (in *AtlasConnectionSecretTemplate) DeepCopy() *AtlasConnectionSecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasConnectionSecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasConnectionSecretTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasConnectionSecretTemplateList) DeepCopyInto(out *AtlasConnectionSecretTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasConnectionSecretTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasConnectionSecretTemplateList.
+func (in *AtlasConnectionSecretTemplateList) DeepCopy() *AtlasConnectionSecretTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasConnectionSecretTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasConnectionSecretTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasConnectionSecretTemplateSpec) DeepCopyInto(out *AtlasConnectionSecretTemplateSpec) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasConnectionSecretTemplateSpec.
+func (in *AtlasConnectionSecretTemplateSpec) DeepCopy() *AtlasConnectionSecretTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasConnectionSecretTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasCustomRole) DeepCopyInto(out *AtlasCustomRole) {
 	*out = *in
@@ -816,6 +1142,11 @@ func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
 		*out = new(common.ResourceRef)
 		**out = **in
 	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(PasswordPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserSpec.
@@ -912,6 +1243,18 @@ func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
 		*out = new(FlexSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]DeploymentSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChangeWindow != nil {
+		in, out := &in.ChangeWindow, &out.ChangeWindow
+		*out = new(ChangeWindow)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
@@ -1236,61 +1579,153 @@ func (in *AtlasNetworkPeering) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasNetworkPeeringConfig) DeepCopyInto(out *AtlasNetworkPeeringConfig) {
+func (in *AtlasNetworkPeeringConfig) DeepCopyInto(out *AtlasNetworkPeeringConfig) {
+	*out = *in
+	if in.AWSConfiguration != nil {
+		in, out := &in.AWSConfiguration, &out.AWSConfiguration
+		*out = new(AWSNetworkPeeringConfiguration)
+		**out = **in
+	}
+	if in.AzureConfiguration != nil {
+		in, out := &in.AzureConfiguration, &out.AzureConfiguration
+		*out = new(AzureNetworkPeeringConfiguration)
+		**out = **in
+	}
+	if in.GCPConfiguration != nil {
+		in, out := &in.GCPConfiguration, &out.GCPConfiguration
+		*out = new(GCPNetworkPeeringConfiguration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringConfig.
+func (in *AtlasNetworkPeeringConfig) DeepCopy() *AtlasNetworkPeeringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkPeeringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkPeeringList) DeepCopyInto(out *AtlasNetworkPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasNetworkPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringList.
+func (in *AtlasNetworkPeeringList) DeepCopy() *AtlasNetworkPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasNetworkPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkPeeringSpec) DeepCopyInto(out *AtlasNetworkPeeringSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	out.ContainerRef = in.ContainerRef
+	in.AtlasNetworkPeeringConfig.DeepCopyInto(&out.AtlasNetworkPeeringConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringSpec.
+func (in *AtlasNetworkPeeringSpec) DeepCopy() *AtlasNetworkPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOnDemandPolicy) DeepCopyInto(out *AtlasOnDemandPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnDemandPolicy.
+func (in *AtlasOnDemandPolicy) DeepCopy() *AtlasOnDemandPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOnDemandPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOnlineArchive) DeepCopyInto(out *AtlasOnlineArchive) {
 	*out = *in
-	if in.AWSConfiguration != nil {
-		in, out := &in.AWSConfiguration, &out.AWSConfiguration
-		*out = new(AWSNetworkPeeringConfiguration)
-		**out = **in
-	}
-	if in.AzureConfiguration != nil {
-		in, out := &in.AzureConfiguration, &out.AzureConfiguration
-		*out = new(AzureNetworkPeeringConfiguration)
-		**out = **in
-	}
-	if in.GCPConfiguration != nil {
-		in, out := &in.GCPConfiguration, &out.GCPConfiguration
-		*out = new(GCPNetworkPeeringConfiguration)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringConfig.
-func (in *AtlasNetworkPeeringConfig) DeepCopy() *AtlasNetworkPeeringConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnlineArchive.
+func (in *AtlasOnlineArchive) DeepCopy() *AtlasOnlineArchive {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasNetworkPeeringConfig)
+	out := new(AtlasOnlineArchive)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOnlineArchive) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasNetworkPeeringList) DeepCopyInto(out *AtlasNetworkPeeringList) {
+func (in *AtlasOnlineArchiveList) DeepCopyInto(out *AtlasOnlineArchiveList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasNetworkPeering, len(*in))
+		*out = make([]AtlasOnlineArchive, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringList.
-func (in *AtlasNetworkPeeringList) DeepCopy() *AtlasNetworkPeeringList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnlineArchiveList.
+func (in *AtlasOnlineArchiveList) DeepCopy() *AtlasOnlineArchiveList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasNetworkPeeringList)
+	out := new(AtlasOnlineArchiveList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasNetworkPeeringList) DeepCopyObject() runtime.Object {
+func (in *AtlasOnlineArchiveList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1298,34 +1733,49 @@ func (in *AtlasNetworkPeeringList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasNetworkPeeringSpec) DeepCopyInto(out *AtlasNetworkPeeringSpec) {
+func (in *AtlasOnlineArchiveConfig) DeepCopyInto(out *AtlasOnlineArchiveConfig) {
 	*out = *in
-	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
-	out.ContainerRef = in.ContainerRef
-	in.AtlasNetworkPeeringConfig.DeepCopyInto(&out.AtlasNetworkPeeringConfig)
+	in.Criteria.DeepCopyInto(&out.Criteria)
+	if in.PartitionFields != nil {
+		in, out := &in.PartitionFields, &out.PartitionFields
+		*out = make([]OnlineArchivePartitionField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(OnlineArchiveSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataExpirationRule != nil {
+		in, out := &in.DataExpirationRule, &out.DataExpirationRule
+		*out = new(OnlineArchiveDataExpirationRule)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkPeeringSpec.
-func (in *AtlasNetworkPeeringSpec) DeepCopy() *AtlasNetworkPeeringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnlineArchiveConfig.
+func (in *AtlasOnlineArchiveConfig) DeepCopy() *AtlasOnlineArchiveConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasNetworkPeeringSpec)
+	out := new(AtlasOnlineArchiveConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasOnDemandPolicy) DeepCopyInto(out *AtlasOnDemandPolicy) {
+func (in *AtlasOnlineArchiveSpec) DeepCopyInto(out *AtlasOnlineArchiveSpec) {
 	*out = *in
+	in.DeploymentDualReference.DeepCopyInto(&out.DeploymentDualReference)
+	in.AtlasOnlineArchiveConfig.DeepCopyInto(&out.AtlasOnlineArchiveConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnDemandPolicy.
-func (in *AtlasOnDemandPolicy) DeepCopy() *AtlasOnDemandPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOnlineArchiveSpec.
+func (in *AtlasOnlineArchiveSpec) DeepCopy() *AtlasOnlineArchiveSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasOnDemandPolicy)
+	out := new(AtlasOnlineArchiveSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1444,6 +1894,89 @@ func (in *AtlasOrgSettingsSpec) DeepCopy() *AtlasOrgSettingsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPolicy) DeepCopyInto(out *AtlasPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPolicy.
+func (in *AtlasPolicy) DeepCopy() *AtlasPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPolicyList) DeepCopyInto(out *AtlasPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPolicyList.
+func (in *AtlasPolicyList) DeepCopy() *AtlasPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPolicySpec) DeepCopyInto(out *AtlasPolicySpec) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = new(DeploymentPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAccessList != nil {
+		in, out := &in.IPAccessList, &out.IPAccessList
+		*out = new(IPAccessListPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPolicySpec.
+func (in *AtlasPolicySpec) DeepCopy() *AtlasPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasPrivateEndpoint) DeepCopyInto(out *AtlasPrivateEndpoint) {
 	*out = *in
@@ -1715,6 +2248,33 @@ func (in *AtlasProjectSpec) DeepCopy() *AtlasProjectSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndex) DeepCopyInto(out *AtlasSearchIndex) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndex.
+func (in *AtlasSearchIndex) DeepCopy() *AtlasSearchIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndex) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasSearchIndexAnalyzer) DeepCopyInto(out *AtlasSearchIndexAnalyzer) {
 	*out = *in
@@ -1841,6 +2401,55 @@ func (in *AtlasSearchIndexConfigSpec) DeepCopy() *AtlasSearchIndexConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexList) DeepCopyInto(out *AtlasSearchIndexList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasSearchIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexList.
+func (in *AtlasSearchIndexList) DeepCopy() *AtlasSearchIndexList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndexList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexSpec) DeepCopyInto(out *AtlasSearchIndexSpec) {
+	*out = *in
+	in.DeploymentDualReference.DeepCopyInto(&out.DeploymentDualReference)
+	in.SearchIndex.DeepCopyInto(&out.SearchIndex)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexSpec.
+func (in *AtlasSearchIndexSpec) DeepCopy() *AtlasSearchIndexSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasStreamConnection) DeepCopyInto(out *AtlasStreamConnection) {
 	*out = *in
@@ -1913,6 +2522,16 @@ func (in *AtlasStreamConnectionSpec) DeepCopyInto(out *AtlasStreamConnectionSpec
 		*out = new(StreamsKafkaConnection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTPSConfig != nil {
+		in, out := &in.HTTPSConfig, &out.HTTPSConfig
+		*out = new(StreamsHTTPSConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSConfig != nil {
+		in, out := &in.AWSConfig, &out.AWSConfig
+		*out = new(StreamsAWSConnection)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamConnectionSpec.
@@ -1920,13 +2539,94 @@ func (in *AtlasStreamConnectionSpec) DeepCopy() *AtlasStreamConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasStreamConnectionSpec)
+	out := new(AtlasStreamConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasStreamInstance) DeepCopyInto(out *AtlasStreamInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstance.
+func (in *AtlasStreamInstance) DeepCopy() *AtlasStreamInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasStreamInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasStreamInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasStreamInstanceList) DeepCopyInto(out *AtlasStreamInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasStreamInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstanceList.
+func (in *AtlasStreamInstanceList) DeepCopy() *AtlasStreamInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasStreamInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasStreamInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasStreamInstanceSpec) DeepCopyInto(out *AtlasStreamInstanceSpec) {
+	*out = *in
+	out.Config = in.Config
+	out.Project = in.Project
+	if in.ConnectionRegistry != nil {
+		in, out := &in.ConnectionRegistry, &out.ConnectionRegistry
+		*out = make([]common.ResourceRefNamespaced, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstanceSpec.
+func (in *AtlasStreamInstanceSpec) DeepCopy() *AtlasStreamInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasStreamInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasStreamInstance) DeepCopyInto(out *AtlasStreamInstance) {
+func (in *AtlasStreamProcessor) DeepCopyInto(out *AtlasStreamProcessor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1934,18 +2634,18 @@ func (in *AtlasStreamInstance) DeepCopyInto(out *AtlasStreamInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstance.
-func (in *AtlasStreamInstance) DeepCopy() *AtlasStreamInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamProcessor.
+func (in *AtlasStreamProcessor) DeepCopy() *AtlasStreamProcessor {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasStreamInstance)
+	out := new(AtlasStreamProcessor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasStreamInstance) DeepCopyObject() runtime.Object {
+func (in *AtlasStreamProcessor) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1953,31 +2653,31 @@ func (in *AtlasStreamInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasStreamInstanceList) DeepCopyInto(out *AtlasStreamInstanceList) {
+func (in *AtlasStreamProcessorList) DeepCopyInto(out *AtlasStreamProcessorList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasStreamInstance, len(*in))
+		*out = make([]AtlasStreamProcessor, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstanceList.
-func (in *AtlasStreamInstanceList) DeepCopy() *AtlasStreamInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamProcessorList.
+func (in *AtlasStreamProcessorList) DeepCopy() *AtlasStreamProcessorList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasStreamInstanceList)
+	out := new(AtlasStreamProcessorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasStreamInstanceList) DeepCopyObject() runtime.Object {
+func (in *AtlasStreamProcessorList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1985,23 +2685,23 @@ func (in *AtlasStreamInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasStreamInstanceSpec) DeepCopyInto(out *AtlasStreamInstanceSpec) {
+func (in *AtlasStreamProcessorSpec) DeepCopyInto(out *AtlasStreamProcessorSpec) {
 	*out = *in
-	out.Config = in.Config
-	out.Project = in.Project
-	if in.ConnectionRegistry != nil {
-		in, out := &in.ConnectionRegistry, &out.ConnectionRegistry
-		*out = make([]common.ResourceRefNamespaced, len(*in))
-		copy(*out, *in)
+	out.Instance = in.Instance
+	in.Pipeline.DeepCopyInto(&out.Pipeline)
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(StreamProcessorOptions)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamInstanceSpec.
-func (in *AtlasStreamInstanceSpec) DeepCopy() *AtlasStreamInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasStreamProcessorSpec.
+func (in *AtlasStreamProcessorSpec) DeepCopy() *AtlasStreamProcessorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasStreamInstanceSpec)
+	out := new(AtlasStreamProcessorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2362,6 +3062,21 @@ func (in *BiConnectorSpec) DeepCopy() *BiConnectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeWindow) DeepCopyInto(out *ChangeWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeWindow.
+func (in *ChangeWindow) DeepCopy() *ChangeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CharFilter) DeepCopyInto(out *CharFilter) {
 	*out = *in
@@ -2804,6 +3519,91 @@ func (in *DatadogIntegration) DeepCopy() *DatadogIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentDualReference) DeepCopyInto(out *DeploymentDualReference) {
+	*out = *in
+	if in.DeploymentRef != nil {
+		in, out := &in.DeploymentRef, &out.DeploymentRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.ExternalDeploymentRef != nil {
+		in, out := &in.ExternalDeploymentRef, &out.ExternalDeploymentRef
+		*out = new(ExternalDeploymentReference)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentDualReference.
+func (in *DeploymentDualReference) DeepCopy() *DeploymentDualReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentDualReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPolicy) DeepCopyInto(out *DeploymentPolicy) {
+	*out = *in
+	if in.AllowedProviders != nil {
+		in, out := &in.AllowedProviders, &out.AllowedProviders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRegions != nil {
+		in, out := &in.AllowedRegions, &out.AllowedRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedMongoDBVersions != nil {
+		in, out := &in.AllowedMongoDBVersions, &out.AllowedMongoDBVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChangeWindow != nil {
+		in, out := &in.ChangeWindow, &out.ChangeWindow
+		*out = new(ChangeWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPolicy.
+func (in *DeploymentPolicy) DeepCopy() *DeploymentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSchedule) DeepCopyInto(out *DeploymentSchedule) {
+	*out = *in
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSchedule.
+func (in *DeploymentSchedule) DeepCopy() *DeploymentSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskGB) DeepCopyInto(out *DiskGB) {
 	*out = *in
@@ -2857,6 +3657,21 @@ func (in *EndpointSpec) DeepCopy() *EndpointSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDeploymentReference) DeepCopyInto(out *ExternalDeploymentReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDeploymentReference.
+func (in *ExternalDeploymentReference) DeepCopy() *ExternalDeploymentReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDeploymentReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalProjectReference) DeepCopyInto(out *ExternalProjectReference) {
 	*out = *in
@@ -3057,6 +3872,26 @@ func (in *IPAccessEntry) DeepCopy() *IPAccessEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAccessListPolicy) DeepCopyInto(out *IPAccessListPolicy) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAccessListPolicy.
+func (in *IPAccessListPolicy) DeepCopy() *IPAccessListPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAccessListPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespace) DeepCopyInto(out *ManagedNamespace) {
 	*out = *in
@@ -3230,6 +4065,101 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnlineArchiveCriteria) DeepCopyInto(out *OnlineArchiveCriteria) {
+	*out = *in
+	if in.ExpireAfterDays != nil {
+		in, out := &in.ExpireAfterDays, &out.ExpireAfterDays
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnlineArchiveCriteria.
+func (in *OnlineArchiveCriteria) DeepCopy() *OnlineArchiveCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(OnlineArchiveCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnlineArchiveDataExpirationRule) DeepCopyInto(out *OnlineArchiveDataExpirationRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnlineArchiveDataExpirationRule.
+func (in *OnlineArchiveDataExpirationRule) DeepCopy() *OnlineArchiveDataExpirationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnlineArchiveDataExpirationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnlineArchivePartitionField) DeepCopyInto(out *OnlineArchivePartitionField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnlineArchivePartitionField.
+func (in *OnlineArchivePartitionField) DeepCopy() *OnlineArchivePartitionField {
+	if in == nil {
+		return nil
+	}
+	out := new(OnlineArchivePartitionField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnlineArchiveSchedule) DeepCopyInto(out *OnlineArchiveSchedule) {
+	*out = *in
+	if in.StartHour != nil {
+		in, out := &in.StartHour, &out.StartHour
+		*out = new(int)
+		**out = **in
+	}
+	if in.StartMinute != nil {
+		in, out := &in.StartMinute, &out.StartMinute
+		*out = new(int)
+		**out = **in
+	}
+	if in.EndHour != nil {
+		in, out := &in.EndHour, &out.EndHour
+		*out = new(int)
+		**out = **in
+	}
+	if in.EndMinute != nil {
+		in, out := &in.EndMinute, &out.EndMinute
+		*out = new(int)
+		**out = **in
+	}
+	if in.DayOfWeek != nil {
+		in, out := &in.DayOfWeek, &out.DayOfWeek
+		*out = new(int)
+		**out = **in
+	}
+	if in.DayOfMonth != nil {
+		in, out := &in.DayOfMonth, &out.DayOfMonth
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnlineArchiveSchedule.
+func (in *OnlineArchiveSchedule) DeepCopy() *OnlineArchiveSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnlineArchiveSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsGenieIntegration) DeepCopyInto(out *OpsGenieIntegration) {
 	*out = *in
@@ -3262,6 +4192,31 @@ func (in *PagerDutyIntegration) DeepCopy() *PagerDutyIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RotationGracePeriod != nil {
+		in, out := &in.RotationGracePeriod, &out.RotationGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -3891,6 +4846,56 @@ func (in *Store) DeepCopy() *Store {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamProcessorDLQ) DeepCopyInto(out *StreamProcessorDLQ) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamProcessorDLQ.
+func (in *StreamProcessorDLQ) DeepCopy() *StreamProcessorDLQ {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamProcessorDLQ)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamProcessorOptions) DeepCopyInto(out *StreamProcessorOptions) {
+	*out = *in
+	if in.DLQ != nil {
+		in, out := &in.DLQ, &out.DLQ
+		*out = new(StreamProcessorDLQ)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamProcessorOptions.
+func (in *StreamProcessorOptions) DeepCopy() *StreamProcessorOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamProcessorOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamsAWSConnection) DeepCopyInto(out *StreamsAWSConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamsAWSConnection.
+func (in *StreamsAWSConnection) DeepCopy() *StreamsAWSConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamsAWSConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StreamsClusterDBRole) DeepCopyInto(out *StreamsClusterDBRole) {
 	*out = *in
@@ -3906,6 +4911,33 @@ func (in *StreamsClusterDBRole) DeepCopy() *StreamsClusterDBRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamsHTTPSConnection) DeepCopyInto(out *StreamsHTTPSConnection) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HeadersSecret != nil {
+		in, out := &in.HeadersSecret, &out.HeadersSecret
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamsHTTPSConnection.
+func (in *StreamsHTTPSConnection) DeepCopy() *StreamsHTTPSConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamsHTTPSConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StreamsKafkaAuthentication) DeepCopyInto(out *StreamsKafkaAuthentication) {
 	*out = *in
@@ -0,0 +1,57 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/migration"
+)
+
+const usage = `Usage: ako <command> [flags]
+
+Commands:
+  export    export existing Atlas resources as custom resources
+  migrate   migrate legacy custom resources to the generated custom resources
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch command := os.Args[1]; command {
+	case "export":
+		fs := flag.NewFlagSet("export", flag.ExitOnError)
+		err = exporter.Run(ctrl.SetupSignalHandler(), fs, os.Args[2:], os.Stdout)
+	case "migrate":
+		fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+		err = migration.Run(ctrl.SetupSignalHandler(), fs, os.Args[2:], os.Stdout)
+	default:
+		fmt.Printf("unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
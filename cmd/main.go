@@ -18,6 +18,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	// the time zones of deployment schedules are loaded from the embedded database,
+	// the operator image doesn't ship one
+	_ "time/tzdata"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
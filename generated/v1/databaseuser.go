@@ -170,6 +170,10 @@ type Scopes struct {
 }
 
 type DatabaseUserStatus struct {
+	// Binding The connection Secret exposed according to the Service Binding
+	// specification (servicebinding.io).
+	Binding *k8s.LocalReference `json:"binding,omitempty"`
+
 	// Conditions Represents the latest available observations of a resource's current
 	// state.
 	Conditions *[]metav1.Condition `json:"conditions,omitempty"`
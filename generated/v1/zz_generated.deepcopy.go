@@ -265,6 +265,11 @@ func (in *ClusterSpecV20250312) DeepCopy() *ClusterSpecV20250312 {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(k8s.LocalReference)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = new([]metav1.Condition)
@@ -704,6 +709,11 @@ func (in *DatabaseUserSpecV20250312Entry) DeepCopy() *DatabaseUserSpecV20250312E
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseUserStatus) DeepCopyInto(out *DatabaseUserStatus) {
 	*out = *in
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(k8s.LocalReference)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = new([]metav1.Condition)
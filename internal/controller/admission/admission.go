@@ -0,0 +1,163 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admission contains the validating admission webhooks of the operator.
+// The webhooks reuse the validations the reconcilers run, so invalid resources
+// and changes to immutable fields are rejected at apply time instead of being
+// reported in the status conditions after the fact.
+//
+// The mutating webhooks record who approved a destructive operation, see the approval package.
+package admission
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+)
+
+var (
+	specPath        = field.NewPath("spec")
+	projectRefPath  = specPath.Child("projectRef")
+	externalRefPath = specPath.Child("externalProjectRef", "id")
+)
+
+// SetupWithManager registers the validating and mutating webhooks with the webhook server of the given manager.
+// The policies that apply to a resource are read from the cache of the manager.
+func SetupWithManager(mgr manager.Manager, atlasProvider atlas.Provider) error {
+	reader := mgr.GetClient()
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2.AtlasProject{}).
+		WithDefaulter(approverRecorder[*akov2.AtlasProject]{}).
+		WithValidator(&projectValidator{atlasProvider: atlasProvider, reader: reader}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the AtlasProject webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2.AtlasDeployment{}).
+		WithDefaulter(approverRecorder[*akov2.AtlasDeployment]{}).
+		WithValidator(&deploymentValidator{atlasProvider: atlasProvider, reader: reader}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the AtlasDeployment webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2.AtlasDatabaseUser{}).
+		WithDefaulter(approverRecorder[*akov2.AtlasDatabaseUser]{}).
+		WithValidator(&databaseUserValidator{atlasProvider: atlasProvider}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the AtlasDatabaseUser webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2.AtlasPrivateEndpoint{}).
+		WithValidator(&privateEndpointValidator{atlasProvider: atlasProvider}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the AtlasPrivateEndpoint webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2.AtlasIPAccessList{}).
+		WithValidator(&ipAccessListValidator{reader: reader}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the AtlasIPAccessList webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2generated.Cluster{}).
+		WithValidator(clusterValidator(reader)).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the Cluster webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2generated.FlexCluster{}).
+		WithValidator(flexClusterValidator(reader)).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the FlexCluster webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &akov2generated.IPAccessListEntry{}).
+		WithValidator(ipAccessListEntryValidator(reader)).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register the IPAccessListEntry webhook: %w", err)
+	}
+
+	return nil
+}
+
+// toError converts the given field errors into an Invalid API error,
+// which kubectl renders next to the offending fields.
+func toError(kind string, obj client.Object, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{Group: akov2.GroupVersion.Group, Kind: kind}, obj.GetName(), errs)
+}
+
+// specError wraps an error returned by the validate package.
+func specError(err error) *field.Error {
+	return field.Invalid(specPath, field.OmitValueType{}, err.Error())
+}
+
+// policyError rejects a resource violating an AtlasPolicy or AtlasClusterPolicy.
+// Policies that can't be read fail the admission rather than silently allowing the resource.
+func policyError(err error) *field.Error {
+	if policy.IsViolation(err) {
+		return field.Forbidden(specPath, err.Error())
+	}
+
+	return field.InternalError(specPath, err)
+}
+
+func unsupportedError(kind string) *field.Error {
+	return field.Forbidden(specPath, fmt.Sprintf("the %s is not supported by Atlas for government", kind))
+}
+
+func immutable(path *field.Path, oldValue, newValue any) field.ErrorList {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+
+	return field.ErrorList{field.Invalid(path, newValue, apimachineryvalidation.FieldImmutableErrorMsg)}
+}
+
+// immutableProjectReference rejects pointing a resource to a different Atlas project.
+// Switching between projectRef and externalProjectRef is allowed, as both may refer to the same project.
+func immutableProjectReference(oldRef, newRef akov2.ProjectDualReference) field.ErrorList {
+	var errs field.ErrorList
+
+	if oldRef.ProjectRef != nil && newRef.ProjectRef != nil {
+		errs = append(errs, immutable(projectRefPath, *oldRef.ProjectRef, *newRef.ProjectRef)...)
+	}
+
+	if oldRef.ExternalProjectRef != nil && newRef.ExternalProjectRef != nil {
+		errs = append(errs, immutable(externalRefPath, oldRef.ExternalProjectRef.ID, newRef.ExternalProjectRef.ID)...)
+	}
+
+	return errs
+}
+
+// isBeingDeleted skips the validation of updates to resources that are being deleted,
+// so an invalid spec never blocks the removal of the finalizer.
+func isBeingDeleted(obj client.Object) bool {
+	return !obj.GetDeletionTimestamp().IsZero()
+}
@@ -0,0 +1,547 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func testProvider(isGov, isSupported bool) *atlas.TestProvider {
+	return &atlas.TestProvider{
+		IsCloudGovFunc:  func() bool { return isGov },
+		IsSupportedFunc: func() bool { return isSupported },
+	}
+}
+
+func testReader(t *testing.T, objects ...client.Object) client.Reader {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func TestDeploymentValidator(t *testing.T) {
+	flex := func(name, project string) *akov2.AtlasDeployment {
+		return &akov2.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "ns"},
+			Spec: akov2.AtlasDeploymentSpec{
+				ProjectDualReference: akov2.ProjectDualReference{
+					ProjectRef: &common.ResourceRefNamespaced{Name: project},
+				},
+				FlexSpec: &akov2.FlexSpec{
+					Name: name,
+					ProviderSettings: &akov2.FlexProviderSettings{
+						BackingProviderName: "AWS",
+						RegionName:          "US_EAST_1",
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name        string
+		oldObj      *akov2.AtlasDeployment
+		obj         *akov2.AtlasDeployment
+		policies    []client.Object
+		isSupported bool
+		wantErr     string
+	}{
+		{
+			name:        "valid create",
+			obj:         flex("cluster0", "project"),
+			isSupported: true,
+		},
+		{
+			name:        "create without any deployment spec",
+			obj:         &akov2.AtlasDeployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment"}},
+			isSupported: true,
+			wantErr:     "expected exactly one of spec.deploymentSpec or spec.serverlessSpec or spec.flexSpec to be present",
+		},
+		{
+			name:    "create unsupported in Atlas for government",
+			obj:     flex("cluster0", "project"),
+			wantErr: "not supported by Atlas for government",
+		},
+		{
+			name:        "valid update",
+			oldObj:      flex("cluster0", "project"),
+			obj:         flex("cluster0", "project"),
+			isSupported: true,
+		},
+		{
+			name:        "rename deployment",
+			oldObj:      flex("cluster0", "project"),
+			obj:         flex("cluster1", "project"),
+			isSupported: true,
+			wantErr:     "spec.flexSpec.name: Invalid value: \"cluster1\": field is immutable",
+		},
+		{
+			name:        "move deployment to another project",
+			oldObj:      flex("cluster0", "project"),
+			obj:         flex("cluster0", "other-project"),
+			isSupported: true,
+			wantErr:     "spec.projectRef",
+		},
+		{
+			name: "create violating a policy",
+			obj:  flex("cluster0", "project"),
+			policies: []client.Object{
+				&akov2.AtlasPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "providers", Namespace: "ns"},
+					Spec: akov2.AtlasPolicySpec{
+						Deployments: &akov2.DeploymentPolicy{AllowedProviders: []string{"GCP"}},
+					},
+				},
+			},
+			isSupported: true,
+			wantErr:     "spec: Forbidden: AtlasPolicy ns/providers violated: provider AWS is not allowed, allowed providers: [GCP]",
+		},
+		{
+			name: "create allowed by a policy of another namespace",
+			obj:  flex("cluster0", "project"),
+			policies: []client.Object{
+				&akov2.AtlasPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "providers", Namespace: "other"},
+					Spec: akov2.AtlasPolicySpec{
+						Deployments: &akov2.DeploymentPolicy{AllowedProviders: []string{"GCP"}},
+					},
+				},
+			},
+			isSupported: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &deploymentValidator{atlasProvider: testProvider(false, tc.isSupported), reader: testReader(t, tc.policies...)}
+
+			var err error
+			if tc.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.obj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.oldObj, tc.obj)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestDeploymentValidatorSkipsDeletedResources(t *testing.T) {
+	v := &deploymentValidator{atlasProvider: testProvider(false, true)}
+	deleted := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment", DeletionTimestamp: &metav1.Time{}},
+	}
+
+	_, err := v.ValidateUpdate(context.Background(), &akov2.AtlasDeployment{}, deleted)
+	assert.NoError(t, err)
+}
+
+func TestProjectValidator(t *testing.T) {
+	project := func(name, restrictions string) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns"},
+			Spec:       akov2.AtlasProjectSpec{Name: name, RegionUsageRestrictions: restrictions},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		isGov   bool
+		oldObj  *akov2.AtlasProject
+		obj     *akov2.AtlasProject
+		wantErr string
+	}{
+		{
+			name: "valid create",
+			obj:  project("my-project", ""),
+		},
+		{
+			name:    "region usage restrictions in commercial Atlas",
+			obj:     project("my-project", "GOV_REGIONS_ONLY"),
+			wantErr: "regionUsageRestriction can be used only with Atlas for government",
+		},
+		{
+			name:   "unset region usage restrictions equals the default",
+			oldObj: project("my-project", ""),
+			obj:    project("my-project", "NONE"),
+		},
+		{
+			name:    "change region usage restrictions",
+			isGov:   true,
+			oldObj:  project("my-project", "GOV_REGIONS_ONLY"),
+			obj:     project("my-project", "COMMERCIAL_FEDRAMP_REGIONS_ONLY"),
+			wantErr: "spec.regionUsageRestrictions: Invalid value: \"COMMERCIAL_FEDRAMP_REGIONS_ONLY\": field is immutable",
+		},
+		{
+			name:    "rename project",
+			oldObj:  project("my-project", ""),
+			obj:     project("renamed", ""),
+			wantErr: "spec.name: Invalid value: \"renamed\": field is immutable",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &projectValidator{atlasProvider: testProvider(tc.isGov, true), reader: testReader(t)}
+
+			var err error
+			if tc.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.obj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.oldObj, tc.obj)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestIPAccessListValidator(t *testing.T) {
+	ipAccessList := func(entries ...akov2.IPAccessEntry) *akov2.AtlasIPAccessList {
+		return &akov2.AtlasIPAccessList{
+			ObjectMeta: metav1.ObjectMeta{Name: "ip-access-list", Namespace: "ns"},
+			Spec:       akov2.AtlasIPAccessListSpec{Entries: entries},
+		}
+	}
+	clusterPolicy := &akov2.AtlasClusterPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "private-networks"},
+		Spec: akov2.AtlasClusterPolicySpec{
+			AtlasPolicySpec: akov2.AtlasPolicySpec{
+				IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		oldObj  *akov2.AtlasIPAccessList
+		obj     *akov2.AtlasIPAccessList
+		wantErr string
+	}{
+		{
+			name: "create within the allowed CIDRs",
+			obj:  ipAccessList(akov2.IPAccessEntry{CIDRBlock: "10.1.0.0/16"}, akov2.IPAccessEntry{IPAddress: "10.2.3.4"}),
+		},
+		{
+			name:    "create outside of the allowed CIDRs",
+			obj:     ipAccessList(akov2.IPAccessEntry{IPAddress: "192.168.1.1"}),
+			wantErr: "AtlasClusterPolicy private-networks violated: 192.168.1.1 is not within the allowed CIDRs [10.0.0.0/8]",
+		},
+		{
+			name:    "update outside of the allowed CIDRs",
+			oldObj:  ipAccessList(akov2.IPAccessEntry{CIDRBlock: "10.1.0.0/16"}),
+			obj:     ipAccessList(akov2.IPAccessEntry{CIDRBlock: "0.0.0.0/0"}),
+			wantErr: "0.0.0.0/0 is not within the allowed CIDRs [10.0.0.0/8]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &ipAccessListValidator{reader: testReader(t, clusterPolicy)}
+
+			var err error
+			if tc.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.obj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.oldObj, tc.obj)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestGeneratedValidators(t *testing.T) {
+	reader := testReader(t, &akov2.AtlasPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "ns"},
+		Spec: akov2.AtlasPolicySpec{
+			Deployments:  &akov2.DeploymentPolicy{AllowedProviders: []string{"AWS"}},
+			IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+		},
+	})
+
+	flexCluster := &akov2generated.FlexCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "flex", Namespace: "ns"},
+		Spec: akov2generated.FlexClusterSpec{
+			V20250312: &akov2generated.FlexClusterSpecV20250312{
+				Entry: &akov2generated.FlexClusterSpecV20250312Entry{
+					ProviderSettings: akov2generated.ProviderSettings{BackingProviderName: "GCP", RegionName: "CENTRAL_US"},
+				},
+			},
+		},
+	}
+	_, err := flexClusterValidator(reader).ValidateCreate(context.Background(), flexCluster)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.ErrorContains(t, err, "AtlasPolicy ns/limits violated: provider GCP is not allowed, allowed providers: [AWS]")
+
+	entry := &akov2generated.IPAccessListEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: "ns"},
+		Spec: akov2generated.IPAccessListEntrySpec{
+			V20250312: &akov2generated.IPAccessListEntrySpecV20250312{
+				Entry: &akov2generated.IPAccessListEntrySpecV20250312Entry{CidrBlock: pointer.MakePtr("10.1.0.0/16")},
+			},
+		},
+	}
+	_, err = ipAccessListEntryValidator(reader).ValidateUpdate(context.Background(), entry, entry)
+	require.NoError(t, err)
+
+	_, err = clusterValidator(reader).ValidateCreate(context.Background(), &akov2generated.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "ns"},
+	})
+	require.NoError(t, err)
+}
+
+func TestDatabaseUserValidator(t *testing.T) {
+	user := func(database, externalProjectID string) *akov2.AtlasDatabaseUser {
+		return &akov2.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "ns"},
+			Spec: akov2.AtlasDatabaseUserSpec{
+				ProjectDualReference: akov2.ProjectDualReference{
+					ExternalProjectRef: &akov2.ExternalProjectReference{ID: externalProjectID},
+				},
+				DatabaseName: database,
+				Username:     "user",
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		oldObj  *akov2.AtlasDatabaseUser
+		obj     *akov2.AtlasDatabaseUser
+		wantErr string
+	}{
+		{
+			name: "valid create",
+			obj:  user("admin", "project-id"),
+		},
+		{
+			name: "invalid delete after date",
+			obj: func() *akov2.AtlasDatabaseUser {
+				u := user("admin", "project-id")
+				u.Spec.DeleteAfterDate = "tomorrow"
+				return u
+			}(),
+			wantErr: "deleteAfterDate is not a valid ISO 8601 date",
+		},
+		{
+			name:    "change database",
+			oldObj:  user("admin", "project-id"),
+			obj:     user("$external", "project-id"),
+			wantErr: "spec.databaseName",
+		},
+		{
+			name:    "change external project",
+			oldObj:  user("admin", "project-id"),
+			obj:     user("admin", "other-project-id"),
+			wantErr: "spec.externalProjectRef.id",
+		},
+		{
+			name:   "switch to a project reference",
+			oldObj: user("admin", "project-id"),
+			obj: func() *akov2.AtlasDatabaseUser {
+				u := user("admin", "")
+				u.Spec.ExternalProjectRef = nil
+				u.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "project"}
+				return u
+			}(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &databaseUserValidator{atlasProvider: testProvider(false, true)}
+
+			var err error
+			if tc.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.obj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.oldObj, tc.obj)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestPrivateEndpointValidator(t *testing.T) {
+	pe := func(provider, region string) *akov2.AtlasPrivateEndpoint {
+		return &akov2.AtlasPrivateEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "pe", Namespace: "ns"},
+			Spec: akov2.AtlasPrivateEndpointSpec{
+				Provider: provider,
+				Region:   region,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		oldObj  *akov2.AtlasPrivateEndpoint
+		obj     *akov2.AtlasPrivateEndpoint
+		wantErr string
+	}{
+		{
+			name: "valid create",
+			obj:  pe("AWS", "US_EAST_1"),
+		},
+		{
+			name: "azure configuration for an AWS endpoint",
+			obj: func() *akov2.AtlasPrivateEndpoint {
+				p := pe("AWS", "US_EAST_1")
+				p.Spec.AzureConfiguration = []akov2.AzurePrivateEndpointConfiguration{{ID: "id", IP: "10.0.0.1"}}
+				return p
+			}(),
+			wantErr: "azureConfiguration can be used only with Azure private endpoints",
+		},
+		{
+			name:    "change region",
+			oldObj:  pe("AWS", "US_EAST_1"),
+			obj:     pe("AWS", "US_WEST_2"),
+			wantErr: "spec.region: Invalid value: \"US_WEST_2\": field is immutable",
+		},
+		{
+			name:    "change provider",
+			oldObj:  pe("AWS", "US_EAST_1"),
+			obj:     pe("AZURE", "US_EAST_1"),
+			wantErr: "spec.provider",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &privateEndpointValidator{atlasProvider: testProvider(false, true)}
+
+			var err error
+			if tc.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.obj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.oldObj, tc.obj)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestApproverRecorder(t *testing.T) {
+	project := func(annotations map[string]string) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns", Annotations: annotations},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		oldObj *akov2.AtlasProject
+		obj    *akov2.AtlasProject
+		want   map[string]string
+	}{
+		{
+			name: "create without approval",
+			obj:  project(map[string]string{"foo": "bar"}),
+			want: map[string]string{"foo": "bar"},
+		},
+		{
+			name: "create with forged approver",
+			obj:  project(map[string]string{customresource.ApprovedByAnnotation: "admin"}),
+			want: map[string]string{},
+		},
+		{
+			name:   "approve",
+			oldObj: project(nil),
+			obj:    project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "admin"}),
+			want:   map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "approver"},
+		},
+		{
+			name:   "approve another token",
+			oldObj: project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone"}),
+			obj:    project(map[string]string{customresource.ApproveAnnotation: "other", customresource.ApprovedByAnnotation: "someone"}),
+			want:   map[string]string{customresource.ApproveAnnotation: "other", customresource.ApprovedByAnnotation: "approver"},
+		},
+		{
+			name:   "unrelated update keeps the approver",
+			oldObj: project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone"}),
+			obj:    project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone", "foo": "bar"}),
+			want:   map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone", "foo": "bar"},
+		},
+		{
+			name:   "forged approver is reverted",
+			oldObj: project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone"}),
+			obj:    project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "admin"}),
+			want:   map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone"},
+		},
+		{
+			name:   "approval removed",
+			oldObj: project(map[string]string{customresource.ApproveAnnotation: "token", customresource.ApprovedByAnnotation: "someone"}),
+			obj:    project(map[string]string{customresource.ApprovedByAnnotation: "someone"}),
+			want:   map[string]string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "approver"},
+			}}
+			if tc.oldObj != nil {
+				raw, err := json.Marshal(tc.oldObj)
+				require.NoError(t, err)
+				req.OldObject = runtime.RawExtension{Raw: raw}
+			}
+			ctx := admission.NewContextWithRequest(context.Background(), req)
+
+			require.NoError(t, approverRecorder[*akov2.AtlasProject]{}.Default(ctx, tc.obj))
+			assert.Equal(t, tc.want, tc.obj.GetAnnotations())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+)
+
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasproject,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasprojects,verbs=create;update,versions=v1,name=matlasproject.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasdeployment,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdeployments,verbs=create;update,versions=v1,name=matlasdeployment.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasdatabaseuser,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=create;update,versions=v1,name=matlasdatabaseuser.atlas.mongodb.com,admissionReviewVersions=v1
+
+// approverRecorder records the identity setting the approve annotation of a resource in its approved-by
+// annotation, which the reconciler copies to the approval in the status. The approved-by annotation can't be
+// set by hand: it is reset to its previous value unless the approve annotation changes.
+type approverRecorder[T client.Object] struct{}
+
+func (approverRecorder[T]) Default(ctx context.Context, obj T) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var oldAnnotations map[string]string
+	if len(req.OldObject.Raw) > 0 {
+		oldObj := metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(req.OldObject.Raw, &oldObj); err != nil {
+			return fmt.Errorf("failed to decode the previous version of %s: %w", obj.GetName(), err)
+		}
+		oldAnnotations = oldObj.GetAnnotations()
+	}
+
+	recordApprover(obj, oldAnnotations, req.UserInfo.Username)
+	return nil
+}
+
+func recordApprover(obj client.Object, oldAnnotations map[string]string, username string) {
+	annotations := obj.GetAnnotations()
+	token, approved := annotations[customresource.ApproveAnnotation]
+	switch {
+	case !approved:
+		delete(annotations, customresource.ApprovedByAnnotation)
+	case token != oldAnnotations[customresource.ApproveAnnotation]:
+		annotations[customresource.ApprovedByAnnotation] = username
+	default:
+		if approver, ok := oldAnnotations[customresource.ApprovedByAnnotation]; ok {
+			annotations[customresource.ApprovedByAnnotation] = approver
+		} else {
+			delete(annotations, customresource.ApprovedByAnnotation)
+		}
+	}
+	obj.SetAnnotations(annotations)
+}
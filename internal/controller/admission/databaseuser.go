@@ -0,0 +1,67 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+)
+
+const databaseUserKind = "AtlasDatabaseUser"
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasdatabaseuser,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=create;update,versions=v1,name=vatlasdatabaseuser.atlas.mongodb.com,admissionReviewVersions=v1
+
+type databaseUserValidator struct {
+	atlasProvider atlas.Provider
+}
+
+func (v *databaseUserValidator) ValidateCreate(_ context.Context, user *akov2.AtlasDatabaseUser) (admission.Warnings, error) {
+	return nil, toError(databaseUserKind, user, v.validate(user))
+}
+
+func (v *databaseUserValidator) ValidateUpdate(_ context.Context, oldUser, user *akov2.AtlasDatabaseUser) (admission.Warnings, error) {
+	if isBeingDeleted(user) {
+		return nil, nil
+	}
+
+	errs := v.validate(user)
+	errs = append(errs, immutableProjectReference(oldUser.Spec.ProjectDualReference, user.Spec.ProjectDualReference)...)
+	// the user is identified in Atlas by its database and username, only the username rename is handled by the reconciler
+	errs = append(errs, immutable(specPath.Child("databaseName"), oldUser.Spec.DatabaseName, user.Spec.DatabaseName)...)
+
+	return nil, toError(databaseUserKind, user, errs)
+}
+
+func (v *databaseUserValidator) ValidateDelete(_ context.Context, _ *akov2.AtlasDatabaseUser) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *databaseUserValidator) validate(user *akov2.AtlasDatabaseUser) field.ErrorList {
+	if !v.atlasProvider.IsResourceSupported(user) {
+		return field.ErrorList{unsupportedError(databaseUserKind)}
+	}
+
+	if err := validate.DatabaseUser(user); err != nil {
+		return field.ErrorList{specError(err)}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+)
+
+const deploymentKind = "AtlasDeployment"
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasdeployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdeployments,verbs=create;update,versions=v1,name=vatlasdeployment.atlas.mongodb.com,admissionReviewVersions=v1
+
+type deploymentValidator struct {
+	atlasProvider atlas.Provider
+	reader        client.Reader
+}
+
+func (v *deploymentValidator) ValidateCreate(ctx context.Context, deployment *akov2.AtlasDeployment) (admission.Warnings, error) {
+	return nil, toError(deploymentKind, deployment, v.validate(ctx, deployment))
+}
+
+func (v *deploymentValidator) ValidateUpdate(ctx context.Context, oldDeployment, deployment *akov2.AtlasDeployment) (admission.Warnings, error) {
+	if isBeingDeleted(deployment) {
+		return nil, nil
+	}
+
+	errs := v.validate(ctx, deployment)
+	errs = append(errs, immutableProjectReference(oldDeployment.Spec.ProjectDualReference, deployment.Spec.ProjectDualReference)...)
+	if oldName := oldDeployment.GetDeploymentName(); oldName != "" {
+		errs = append(errs, immutable(deploymentNamePath(deployment), oldName, deployment.GetDeploymentName())...)
+	}
+
+	return nil, toError(deploymentKind, deployment, errs)
+}
+
+func (v *deploymentValidator) ValidateDelete(_ context.Context, _ *akov2.AtlasDeployment) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *deploymentValidator) validate(ctx context.Context, deployment *akov2.AtlasDeployment) field.ErrorList {
+	if !v.atlasProvider.IsResourceSupported(deployment) {
+		return field.ErrorList{unsupportedError(deploymentKind)}
+	}
+
+	if err := validate.AtlasDeployment(deployment); err != nil {
+		return field.ErrorList{specError(err)}
+	}
+
+	if err := policy.CheckDeployment(ctx, v.reader, deployment); err != nil {
+		return field.ErrorList{policyError(err)}
+	}
+
+	return nil
+}
+
+func deploymentNamePath(deployment *akov2.AtlasDeployment) *field.Path {
+	switch {
+	case deployment.IsFlex():
+		return specPath.Child("flexSpec", "name")
+	case deployment.IsServerless():
+		return specPath.Child("serverlessSpec", "name")
+	default:
+		return specPath.Child("deploymentSpec", "name")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+)
+
+const (
+	clusterKind           = "Cluster"
+	flexClusterKind       = "FlexCluster"
+	ipAccessListEntryKind = "IPAccessListEntry"
+)
+
+// +kubebuilder:webhook:path=/validate-atlas-generated-mongodb-com-v1-cluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.generated.mongodb.com,resources=clusters,verbs=create;update,versions=v1,name=vcluster.atlas.generated.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-generated-mongodb-com-v1-flexcluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.generated.mongodb.com,resources=flexclusters,verbs=create;update,versions=v1,name=vflexcluster.atlas.generated.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-generated-mongodb-com-v1-ipaccesslistentry,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.generated.mongodb.com,resources=ipaccesslistentries,verbs=create;update,versions=v1,name=vipaccesslistentry.atlas.generated.mongodb.com,admissionReviewVersions=v1
+
+// generatedPolicyValidator rejects generated resources violating the policies of their namespace,
+// the same way the AtlasDeployment and AtlasIPAccessList webhooks do.
+type generatedPolicyValidator[T client.Object] struct {
+	kind   string
+	reader client.Reader
+	check  func(context.Context, client.Reader, T) error
+}
+
+func (v *generatedPolicyValidator[T]) ValidateCreate(ctx context.Context, obj T) (admission.Warnings, error) {
+	return nil, toGeneratedError(v.kind, obj, v.validate(ctx, obj))
+}
+
+func (v *generatedPolicyValidator[T]) ValidateUpdate(ctx context.Context, _, obj T) (admission.Warnings, error) {
+	if isBeingDeleted(obj) {
+		return nil, nil
+	}
+
+	return nil, toGeneratedError(v.kind, obj, v.validate(ctx, obj))
+}
+
+func (v *generatedPolicyValidator[T]) ValidateDelete(_ context.Context, _ T) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *generatedPolicyValidator[T]) validate(ctx context.Context, obj T) field.ErrorList {
+	if err := v.check(ctx, v.reader, obj); err != nil {
+		return field.ErrorList{policyError(err)}
+	}
+
+	return nil
+}
+
+func clusterValidator(reader client.Reader) *generatedPolicyValidator[*akov2generated.Cluster] {
+	return &generatedPolicyValidator[*akov2generated.Cluster]{kind: clusterKind, reader: reader, check: policy.CheckCluster}
+}
+
+func flexClusterValidator(reader client.Reader) *generatedPolicyValidator[*akov2generated.FlexCluster] {
+	return &generatedPolicyValidator[*akov2generated.FlexCluster]{kind: flexClusterKind, reader: reader, check: policy.CheckFlexCluster}
+}
+
+func ipAccessListEntryValidator(reader client.Reader) *generatedPolicyValidator[*akov2generated.IPAccessListEntry] {
+	return &generatedPolicyValidator[*akov2generated.IPAccessListEntry]{kind: ipAccessListEntryKind, reader: reader, check: policy.CheckIPAccessListEntry}
+}
+
+// toGeneratedError is toError for the kinds of the generated API group.
+func toGeneratedError(kind string, obj client.Object, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{Group: akov2generated.GroupVersion.Group, Kind: kind}, obj.GetName(), errs)
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+)
+
+const ipAccessListKind = "AtlasIPAccessList"
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasipaccesslist,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasipaccesslists,verbs=create;update,versions=v1,name=vatlasipaccesslist.atlas.mongodb.com,admissionReviewVersions=v1
+
+type ipAccessListValidator struct {
+	reader client.Reader
+}
+
+func (v *ipAccessListValidator) ValidateCreate(ctx context.Context, ipAccessList *akov2.AtlasIPAccessList) (admission.Warnings, error) {
+	return nil, toError(ipAccessListKind, ipAccessList, v.validate(ctx, ipAccessList))
+}
+
+func (v *ipAccessListValidator) ValidateUpdate(ctx context.Context, _, ipAccessList *akov2.AtlasIPAccessList) (admission.Warnings, error) {
+	if isBeingDeleted(ipAccessList) {
+		return nil, nil
+	}
+
+	return nil, toError(ipAccessListKind, ipAccessList, v.validate(ctx, ipAccessList))
+}
+
+func (v *ipAccessListValidator) ValidateDelete(_ context.Context, _ *akov2.AtlasIPAccessList) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ipAccessListValidator) validate(ctx context.Context, ipAccessList *akov2.AtlasIPAccessList) field.ErrorList {
+	if err := policy.CheckIPAccessList(ctx, v.reader, ipAccessList); err != nil {
+		return field.ErrorList{policyError(err)}
+	}
+
+	return nil
+}
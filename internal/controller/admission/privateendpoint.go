@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+)
+
+const privateEndpointKind = "AtlasPrivateEndpoint"
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasprivateendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasprivateendpoints,verbs=create;update,versions=v1,name=vatlasprivateendpoint.atlas.mongodb.com,admissionReviewVersions=v1
+
+type privateEndpointValidator struct {
+	atlasProvider atlas.Provider
+}
+
+func (v *privateEndpointValidator) ValidateCreate(_ context.Context, pe *akov2.AtlasPrivateEndpoint) (admission.Warnings, error) {
+	return nil, toError(privateEndpointKind, pe, v.validate(pe))
+}
+
+func (v *privateEndpointValidator) ValidateUpdate(_ context.Context, oldPE, pe *akov2.AtlasPrivateEndpoint) (admission.Warnings, error) {
+	if isBeingDeleted(pe) {
+		return nil, nil
+	}
+
+	errs := v.validate(pe)
+	errs = append(errs, immutableProjectReference(oldPE.Spec.ProjectDualReference, pe.Spec.ProjectDualReference)...)
+	errs = append(errs, immutable(specPath.Child("provider"), oldPE.Spec.Provider, pe.Spec.Provider)...)
+	errs = append(errs, immutable(specPath.Child("region"), oldPE.Spec.Region, pe.Spec.Region)...)
+	errs = append(errs, immutable(specPath.Child("portMappingEnabled"), oldPE.Spec.PortMappingEnabled, pe.Spec.PortMappingEnabled)...)
+
+	return nil, toError(privateEndpointKind, pe, errs)
+}
+
+func (v *privateEndpointValidator) ValidateDelete(_ context.Context, _ *akov2.AtlasPrivateEndpoint) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *privateEndpointValidator) validate(pe *akov2.AtlasPrivateEndpoint) field.ErrorList {
+	if !v.atlasProvider.IsResourceSupported(pe) {
+		return field.ErrorList{unsupportedError(privateEndpointKind)}
+	}
+
+	if err := validate.PrivateEndpoint(pe); err != nil {
+		return field.ErrorList{specError(err)}
+	}
+
+	return nil
+}
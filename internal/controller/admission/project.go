@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+)
+
+const (
+	projectKind = "AtlasProject"
+
+	noRegionUsageRestrictions = "NONE"
+)
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasproject,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasprojects,verbs=create;update,versions=v1,name=vatlasproject.atlas.mongodb.com,admissionReviewVersions=v1
+
+type projectValidator struct {
+	atlasProvider atlas.Provider
+	reader        client.Reader
+}
+
+func (v *projectValidator) ValidateCreate(ctx context.Context, project *akov2.AtlasProject) (admission.Warnings, error) {
+	return nil, toError(projectKind, project, v.validate(ctx, project))
+}
+
+func (v *projectValidator) ValidateUpdate(ctx context.Context, oldProject, project *akov2.AtlasProject) (admission.Warnings, error) {
+	if isBeingDeleted(project) {
+		return nil, nil
+	}
+
+	errs := v.validate(ctx, project)
+	errs = append(errs, immutable(specPath.Child("name"), oldProject.Spec.Name, project.Spec.Name)...)
+	errs = append(errs, immutable(
+		specPath.Child("regionUsageRestrictions"),
+		regionUsageRestrictions(oldProject),
+		regionUsageRestrictions(project),
+	)...)
+
+	return nil, toError(projectKind, project, errs)
+}
+
+func (v *projectValidator) ValidateDelete(_ context.Context, _ *akov2.AtlasProject) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *projectValidator) validate(ctx context.Context, project *akov2.AtlasProject) field.ErrorList {
+	if err := validate.Project(project, v.atlasProvider.IsCloudGov()); err != nil {
+		return field.ErrorList{specError(err)}
+	}
+
+	if err := policy.CheckProject(ctx, v.reader, project); err != nil {
+		return field.ErrorList{policyError(err)}
+	}
+
+	return nil
+}
+
+// regionUsageRestrictions treats an unset value the same as the NONE default.
+func regionUsageRestrictions(project *akov2.AtlasProject) string {
+	if project.Spec.RegionUsageRestrictions == "" {
+		return noRegionUsageRestrictions
+	}
+
+	return project.Spec.RegionUsageRestrictions
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package approval holds destructive operations, e.g. deleting a deployment from Atlas, until they are approved
+// with the token issued for them in the status of the resource.
+//
+// Only the AtlasProject, AtlasDeployment and AtlasDatabaseUser reconcilers use it. The status of the generated
+// Group, Cluster and DatabaseUser kinds has no field to record the approval in, so their operations are never held,
+// see docs/destructive-operation-approval.md.
+package approval
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// Check returns whether the destructive operation on the resource may proceed, and the approval to record in
+// the status of the resource.
+//
+// Operations proceed right away when approvals are not required for the resource, see
+// customresource.IsApprovalRequired. Otherwise, a token is issued for the operation, and the operation is
+// approved once the approve annotation is set to the token. A new token is issued when the operation changes,
+// e.g. when the spec is changed again, so that an approval never applies to another operation than the one
+// it was given for. An empty operation, i.e. no destructive operation, clears the approval.
+func Check(recorder record.EventRecorder, resource client.Object, required bool, current *status.OperationApproval, operation string) (*status.OperationApproval, bool) {
+	if operation == "" || !customresource.IsApprovalRequired(resource, required) {
+		return nil, true
+	}
+
+	var approval *status.OperationApproval
+	if current != nil && current.Operation == operation {
+		approval = current.DeepCopy()
+	} else {
+		approval = &status.OperationApproval{
+			Operation:   operation,
+			Token:       rand.Text(),
+			RequestedAt: now().UTC().Format(time.RFC3339),
+		}
+		recorder.Eventf(resource, corev1.EventTypeWarning, string(workflow.ApprovalRequired), "%s requires approval with token %s", operation, approval.Token)
+	}
+
+	annotations := resource.GetAnnotations()
+	if approval.ApprovedAt == "" && annotations[customresource.ApproveAnnotation] == approval.Token {
+		approval.ApprovedAt = now().UTC().Format(time.RFC3339)
+		approval.ApprovedBy = annotations[customresource.ApprovedByAnnotation]
+		recorder.Eventf(resource, corev1.EventTypeNormal, string(workflow.OperationApproved), "%s approved by %s", operation, approver(approval))
+	}
+
+	return approval, approval.ApprovedAt != ""
+}
+
+// Terminate returns the result of a reconciliation stopped until the operation is approved. The resource is
+// reconciled again when the approve annotation is set, there is no need to retry.
+func Terminate(approval *status.OperationApproval) workflow.DeprecatedResult {
+	msg := fmt.Sprintf("%s requires approval, set the %s annotation to %s", approval.Operation, customresource.ApproveAnnotation, approval.Token)
+	return workflow.Terminate(workflow.ApprovalRequired, errors.New(msg)).WithoutRetry()
+}
+
+func approver(approval *status.OperationApproval) string {
+	if approval.ApprovedBy == "" {
+		return "an unknown identity"
+	}
+	return approval.ApprovedBy
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+)
+
+func TestCheck(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	pending := &status.OperationApproval{
+		Operation:   "delete deployment cluster0",
+		Token:       "token",
+		RequestedAt: "2025-06-01T10:00:00Z",
+	}
+	approved := &status.OperationApproval{
+		Operation:   "delete deployment cluster0",
+		Token:       "token",
+		RequestedAt: "2025-06-01T10:00:00Z",
+		ApprovedAt:  "2025-06-01T11:00:00Z",
+		ApprovedBy:  "someone",
+	}
+
+	for _, tc := range []struct {
+		name         string
+		required     bool
+		annotations  map[string]string
+		current      *status.OperationApproval
+		operation    string
+		wantApproval *status.OperationApproval
+		wantApproved bool
+		wantEvent    string
+	}{
+		{
+			name:         "not required",
+			current:      pending,
+			operation:    "delete deployment cluster0",
+			wantApproved: true,
+		},
+		{
+			name:         "required by annotation",
+			annotations:  map[string]string{customresource.ApprovalPolicyAnnotation: customresource.ApprovalPolicyRequired},
+			current:      pending,
+			operation:    "delete deployment cluster0",
+			wantApproval: pending,
+		},
+		{
+			name:         "no operation",
+			required:     true,
+			current:      pending,
+			wantApproved: true,
+		},
+		{
+			name:         "pending",
+			required:     true,
+			current:      pending,
+			operation:    "delete deployment cluster0",
+			wantApproval: pending,
+		},
+		{
+			name:         "approved with another token",
+			required:     true,
+			annotations:  map[string]string{customresource.ApproveAnnotation: "other"},
+			current:      pending,
+			operation:    "delete deployment cluster0",
+			wantApproval: pending,
+		},
+		{
+			name:     "approved",
+			required: true,
+			annotations: map[string]string{
+				customresource.ApproveAnnotation:    "token",
+				customresource.ApprovedByAnnotation: "someone",
+			},
+			current:   pending,
+			operation: "delete deployment cluster0",
+			wantApproval: &status.OperationApproval{
+				Operation:   "delete deployment cluster0",
+				Token:       "token",
+				RequestedAt: "2025-06-01T10:00:00Z",
+				ApprovedAt:  "2025-06-01T12:00:00Z",
+				ApprovedBy:  "someone",
+			},
+			wantApproved: true,
+			wantEvent:    "Normal OperationApproved delete deployment cluster0 approved by someone",
+		},
+		{
+			name:         "already approved",
+			required:     true,
+			annotations:  map[string]string{customresource.ApproveAnnotation: "token"},
+			current:      approved,
+			operation:    "delete deployment cluster0",
+			wantApproval: approved,
+			wantApproved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			deployment := &akov2.AtlasDeployment{ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Annotations: tc.annotations}}
+
+			approval, ok := Check(recorder, deployment, tc.required, tc.current, tc.operation)
+			assert.Equal(t, tc.wantApproval, approval)
+			assert.Equal(t, tc.wantApproved, ok)
+			close(recorder.Events)
+			if tc.wantEvent == "" {
+				assert.Empty(t, recorder.Events)
+			} else {
+				assert.Equal(t, tc.wantEvent, <-recorder.Events)
+			}
+		})
+	}
+}
+
+func TestCheckIssuesTokenPerOperation(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	deployment := &akov2.AtlasDeployment{ObjectMeta: metav1.ObjectMeta{Name: "cluster0"}}
+
+	first, ok := Check(recorder, deployment, true, nil, "delete deployment cluster0")
+	require.False(t, ok)
+	assert.Equal(t, "delete deployment cluster0", first.Operation)
+	assert.NotEmpty(t, first.Token)
+	assert.NotEmpty(t, first.RequestedAt)
+	assert.Equal(t, "Warning ApprovalRequired delete deployment cluster0 requires approval with token "+first.Token, <-recorder.Events)
+
+	// an approval given for the previous operation doesn't apply to the new one
+	deployment.Annotations = map[string]string{customresource.ApproveAnnotation: first.Token}
+	second, ok := Check(recorder, deployment, true, first, "update deployment cluster0: reduce the disk size from 40 to 20 GB")
+	require.False(t, ok)
+	assert.NotEqual(t, first.Token, second.Token)
+	assert.Empty(t, second.ApprovedAt)
+}
+
+func TestTerminate(t *testing.T) {
+	result := Terminate(&status.OperationApproval{Operation: "delete project p", Token: "token"})
+
+	assert.False(t, result.IsOk())
+	assert.Equal(t, "delete project p requires approval, set the mongodb.com/atlas-approve annotation to token", result.GetMessage())
+	reconcileResult, err := result.ReconcileResult()
+	require.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, reconcileResult)
+}
@@ -33,6 +33,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/deprecation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
@@ -54,6 +56,17 @@ type ProductionProvider struct {
 	domain       string
 	dryRun       bool
 	isLogInDebug bool
+	throttling   *throttle.Registry
+}
+
+// ProviderOption configures optional behavior of the ProductionProvider.
+type ProviderOption func(*ProductionProvider)
+
+// WithRateLimit configures the per-organization Atlas API request budget and the retry behavior on HTTP 429 responses.
+func WithRateLimit(config throttle.Config) ProviderOption {
+	return func(p *ProductionProvider) {
+		p.throttling = throttle.NewRegistry(config)
+	}
 }
 
 // ConnectionConfig is the type that contains connection configuration to Atlas, including credentials.
@@ -68,6 +81,8 @@ type ConnectionConfig struct {
 type Credentials struct {
 	APIKeys        *APIKeys
 	ServiceAccount *ServiceAccountToken
+	// OrgID is the Atlas organization the credentials belong to, it is optional.
+	OrgID string
 }
 
 // APIKeys is the type that holds Public/Private API keys to authenticate against the Atlas API.
@@ -79,15 +94,39 @@ type APIKeys struct {
 // ServiceAccountToken holds a pre-fetched OAuth2 bearer token obtained
 // by the service-account controller via the client credentials flow.
 type ServiceAccountToken struct {
+	// ClientID identifies the service account the token was issued for.
+	ClientID    string
 	BearerToken string
 }
 
-func NewProductionProvider(atlasDomain string, dryRun, isLogInDebug bool) *ProductionProvider {
-	return &ProductionProvider{
+// rateLimitKey identifies the request budget shared by all clients of the same Atlas organization,
+// as Atlas rate limits the requests per organization. Credentials without organization share the
+// budget of their API key or service account, bearer tokens are rotated so they never identify one.
+func (c *Credentials) rateLimitKey() string {
+	switch {
+	case c.OrgID != "":
+		return "org:" + c.OrgID
+	case c.ServiceAccount != nil:
+		return "serviceaccount:" + c.ServiceAccount.ClientID
+	case c.APIKeys != nil:
+		return "apikey:" + c.APIKeys.PublicKey
+	}
+	return ""
+}
+
+func NewProductionProvider(atlasDomain string, dryRun, isLogInDebug bool, opts ...ProviderOption) *ProductionProvider {
+	p := &ProductionProvider{
 		domain:       atlasDomain,
 		dryRun:       dryRun,
 		isLogInDebug: isLogInDebug,
+		throttling:   throttle.NewRegistry(throttle.DefaultConfig()),
 	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
 
 func (p *ProductionProvider) IsCloudGov() bool {
@@ -109,7 +148,11 @@ func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResourc
 		*akov2.AtlasTeam,
 		*akov2.AtlasBackupSchedule,
 		*akov2.AtlasBackupPolicy,
+		*akov2.AtlasBackupRestoreJob,
+		*akov2.AtlasBackupSnapshot,
+		*akov2.AtlasOnlineArchive,
 		*akov2.AtlasDatabaseUser,
+		*akov2.AtlasSearchIndex,
 		*akov2.AtlasSearchIndexConfig,
 		*akov2.AtlasBackupCompliancePolicy,
 		*akov2.AtlasFederatedAuth,
@@ -119,7 +162,8 @@ func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResourc
 		return true
 	case *akov2.AtlasDataFederation,
 		*akov2.AtlasStreamInstance,
-		*akov2.AtlasStreamConnection:
+		*akov2.AtlasStreamConnection,
+		*akov2.AtlasStreamProcessor:
 		return false
 	case *akov2.AtlasDeployment:
 		hasSearchNodes := atlasResource.Spec.DeploymentSpec != nil && len(atlasResource.Spec.DeploymentSpec.SearchNodes) > 0
@@ -145,6 +189,7 @@ func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credential
 		return nil, fmt.Errorf("no credentials provided")
 	}
 
+	baseTransport = throttle.NewTransport(metrics.NewTransport(baseTransport), p.throttling, creds.rateLimitKey(), log)
 	transport := p.newTransport(baseTransport, log)
 	transport = httputil.NewLoggingTransport(log, false, transport)
 	if p.isLogInDebug {
@@ -158,9 +203,12 @@ func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credential
 }
 
 func (p *ProductionProvider) newTransport(delegate http.RoundTripper, log *zap.SugaredLogger) http.RoundTripper {
+	// deprecation and sunset headers are always counted but only logged on demand
+	deprecationLogger := zap.NewNop()
 	if os.Getenv("AKO_DEPRECATION_WARNINGS") != "" {
-		delegate = deprecation.NewLoggingTransport(delegate, log.Desugar())
+		deprecationLogger = log.Desugar()
 	}
+	delegate = deprecation.NewLoggingTransport(delegate, deprecationLogger)
 
 	if p.dryRun {
 		return dryrun.NewDryRunTransport(delegate)
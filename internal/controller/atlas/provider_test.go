@@ -207,3 +207,13 @@ func TestOperatorUserAgent(t *testing.T) {
 	require.Contains(t, userAgent, "MongoDBAtlasKubernetesOperator")
 	require.Contains(t, userAgent, version.Version)
 }
+
+func TestCredentialsRateLimitKey(t *testing.T) {
+	apiKeys := &APIKeys{PublicKey: "public", PrivateKey: "private"}
+	serviceAccount := &ServiceAccountToken{ClientID: "client", BearerToken: "token"}
+
+	assert.Equal(t, "org:org-id", (&Credentials{APIKeys: apiKeys, OrgID: "org-id"}).rateLimitKey())
+	assert.Equal(t, "org:org-id", (&Credentials{ServiceAccount: serviceAccount, OrgID: "org-id"}).rateLimitKey())
+	assert.Equal(t, "apikey:public", (&Credentials{APIKeys: apiKeys}).rateLimitKey())
+	assert.Equal(t, "serviceaccount:client", (&Credentials{ServiceAccount: serviceAccount}).rateLimitKey())
+}
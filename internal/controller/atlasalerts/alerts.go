@@ -0,0 +1,362 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package atlasalerts polls the open alerts of Atlas projects and reports them on the
+// resources they are about, as Warning events and with the AlertsOpen condition.
+package atlasalerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	connectionsecretindexer "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/alert"
+)
+
+const (
+	// PollIntervalAnnotation enables polling the open alerts of the project of an AtlasProject or Group,
+	// its value is the interval between two polls, e.g. "5m".
+	PollIntervalAnnotation = "mongodb.com/atlas-alerts-poll-interval"
+
+	// MinimumPollInterval is the shortest interval between two polls of the same project.
+	MinimumPollInterval = time.Minute
+
+	reasonInvalidPollInterval = "InvalidAlertsPollInterval"
+)
+
+// alertsReconciler holds what the AtlasProject and Group alert reconcilers share.
+type alertsReconciler struct {
+	reconciler.AtlasReconciler
+	EventRecorder           record.EventRecorder
+	GlobalPredicates        []predicate.Predicate
+	maxConcurrentReconciles int
+
+	// reported holds the alerts last reported on the resources of each project resource. The controllers of the
+	// resources may overwrite the AlertsOpen condition with a stale status, so the condition is only used to
+	// tell the alerts already reported once the operator restarted.
+	reportedMu sync.Mutex
+	reported   map[client.ObjectKey]reportedAlerts
+}
+
+// reportedAlerts holds the message of the AlertsOpen condition last set on each resource, see message.
+type reportedAlerts map[resourceKey]string
+
+type resourceKey struct {
+	kind string
+	client.ObjectKey
+}
+
+func keyOf(obj client.Object) resourceKey {
+	return resourceKey{kind: fmt.Sprintf("%T", obj), ObjectKey: client.ObjectKeyFromObject(obj)}
+}
+
+// reportedAlerts returns a copy of the alerts last reported on the resources of the given project resource.
+func (r *alertsReconciler) reportedAlerts(project client.ObjectKey) reportedAlerts {
+	r.reportedMu.Lock()
+	defer r.reportedMu.Unlock()
+	if reported, ok := r.reported[project]; ok {
+		return maps.Clone(reported)
+	}
+	return reportedAlerts{}
+}
+
+func (r *alertsReconciler) setReportedAlerts(project client.ObjectKey, reported reportedAlerts) {
+	r.reportedMu.Lock()
+	defer r.reportedMu.Unlock()
+	if r.reported == nil {
+		r.reported = map[client.ObjectKey]reportedAlerts{}
+	}
+	r.reported[project] = reported
+}
+
+// forget drops the alerts reported on the resources of the given project resource,
+// once polling is disabled or the project resource is gone.
+func (r *alertsReconciler) forget(project client.ObjectKey) {
+	r.reportedMu.Lock()
+	defer r.reportedMu.Unlock()
+	delete(r.reported, project)
+}
+
+// predicates only let through the projects polling alerts, and the removal of the annotation
+// to clear the conditions. The poll itself is driven by the requeue interval.
+func (r *alertsReconciler) predicates() builder.Predicates {
+	predicates := append([]predicate.Predicate{}, r.GlobalPredicates...)
+	predicates = append(predicates, predicate.Or[client.Object](
+		predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+	))
+	return builder.WithPredicates(predicates...)
+}
+
+// pollInterval returns the poll interval of the given project resource, and whether polling is enabled.
+func pollInterval(obj client.Object) (time.Duration, bool, error) {
+	value, ok := obj.GetAnnotations()[PollIntervalAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s annotation %q: %w", PollIntervalAnnotation, value, err)
+	}
+	if interval < MinimumPollInterval {
+		return 0, false, fmt.Errorf("invalid %s annotation %q: the interval must be at least %s", PollIntervalAnnotation, value, MinimumPollInterval)
+	}
+	return interval, true, nil
+}
+
+// invalid reports an unusable poll interval on the project resource. The resource is not requeued,
+// it is reconciled again once the annotation changes.
+func (r *alertsReconciler) invalid(project client.Object, err error) {
+	r.Log.Errorf("not polling the alerts of %s: %v", client.ObjectKeyFromObject(project), err)
+	r.EventRecorder.Event(project, corev1.EventTypeWarning, reasonInvalidPollInterval, err.Error())
+}
+
+// poll reports the open alerts of the project, read with the credentials of the given connection Secret,
+// and requeues the project resource for the next poll. Failures are logged and retried on the next poll.
+func (r *alertsReconciler) poll(ctx context.Context, project client.Object, projectID string, connectionSecretRef *client.ObjectKey, interval time.Duration) ctrl.Result {
+	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, connectionSecretRef, &r.GlobalSecretRef)
+	if err != nil {
+		r.Log.Errorf("failed to poll the alerts of project %s: %v", projectID, err)
+		return ctrl.Result{RequeueAfter: interval}
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		r.Log.Errorf("failed to poll the alerts of project %s: %v", projectID, err)
+		return ctrl.Result{RequeueAfter: interval}
+	}
+	if err := r.pollWith(ctx, project, projectID, alert.NewAlertServiceFromClientSet(sdkClientSet)); err != nil {
+		r.Log.Errorf("failed to poll the alerts of project %s: %v", projectID, err)
+	}
+	return ctrl.Result{RequeueAfter: interval}
+}
+
+func (r *alertsReconciler) pollWith(ctx context.Context, project client.Object, projectID string, service alert.AlertService) error {
+	alerts, err := service.ListOpen(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return r.report(ctx, project, projectID, alerts)
+}
+
+// report sets the AlertsOpen condition of the project resource and of the deployments of the project,
+// and emits events for the alerts opened or closed since the last poll.
+// Alerts about a cluster are reported on the AtlasDeployment and Cluster resources of the cluster,
+// alerts about the project on the project resource.
+func (r *alertsReconciler) report(ctx context.Context, project client.Object, projectID string, alerts []alert.Alert) error {
+	projectAlerts := []alert.Alert{}
+	clusterAlerts := map[string][]alert.Alert{}
+	for i := range alerts {
+		if alerts[i].ClusterName == "" {
+			projectAlerts = append(projectAlerts, alerts[i])
+			continue
+		}
+		clusterAlerts[alerts[i].ClusterName] = append(clusterAlerts[alerts[i].ClusterName], alerts[i])
+	}
+
+	projectKey := client.ObjectKeyFromObject(project)
+	reported := r.reportedAlerts(projectKey)
+	errs := []error{r.update(ctx, project, projectAlerts, reported)}
+	deployments, err := r.deployments(ctx, projectID)
+	if err != nil {
+		r.setReportedAlerts(projectKey, reported)
+		return errors.Join(append(errs, err)...)
+	}
+	current := map[resourceKey]bool{keyOf(project): true}
+	for _, deployment := range deployments {
+		current[keyOf(deployment)] = true
+		errs = append(errs, r.update(ctx, deployment, clusterAlerts[deploymentName(deployment)], reported))
+	}
+	// deleted deployments are forgotten
+	maps.DeleteFunc(reported, func(key resourceKey, _ string) bool {
+		return !current[key]
+	})
+	r.setReportedAlerts(projectKey, reported)
+	return errors.Join(errs...)
+}
+
+// clear removes the AlertsOpen condition of the project resource and of the deployments of the project,
+// once polling is disabled.
+func (r *alertsReconciler) clear(ctx context.Context, project client.Object, projectID string) error {
+	r.forget(client.ObjectKeyFromObject(project))
+	errs := []error{r.remove(ctx, project)}
+	if projectID != "" {
+		deployments, err := r.deployments(ctx, projectID)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		for _, deployment := range deployments {
+			errs = append(errs, r.remove(ctx, deployment))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deployments returns the AtlasDeployment and Cluster resources of the given Atlas project.
+func (r *alertsReconciler) deployments(ctx context.Context, projectID string) ([]client.Object, error) {
+	deployments := &akov2.AtlasDeploymentList{}
+	if err := r.Client.List(ctx, deployments, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasDeploymentByProject, projectID),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list deployments of project %s: %w", projectID, err)
+	}
+	clusters := &akov2generated.ClusterList{}
+	if err := r.Client.List(ctx, clusters, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(connectionsecretindexer.ClusterByGroupIdIndex, projectID),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list clusters of project %s: %w", projectID, err)
+	}
+
+	result := make([]client.Object, 0, len(deployments.Items)+len(clusters.Items))
+	for i := range deployments.Items {
+		result = append(result, &deployments.Items[i])
+	}
+	for i := range clusters.Items {
+		result = append(result, &clusters.Items[i])
+	}
+	return result, nil
+}
+
+func deploymentName(obj client.Object) string {
+	switch deployment := obj.(type) {
+	case *akov2.AtlasDeployment:
+		return deployment.GetDeploymentName()
+	case *akov2generated.Cluster:
+		if deployment.Spec.V20250312 != nil && deployment.Spec.V20250312.Entry != nil && deployment.Spec.V20250312.Entry.Name != nil {
+			return *deployment.Spec.V20250312.Entry.Name
+		}
+	}
+	return ""
+}
+
+// update sets the AlertsOpen condition of the resource, the status is patched only when the condition changed.
+// Events are emitted for the alerts opened or closed since the alerts last reported on the resource, or since
+// the condition was last set when none were reported yet.
+func (r *alertsReconciler) update(ctx context.Context, obj client.Object, alerts []alert.Alert, reported reportedAlerts) error {
+	key := keyOf(obj)
+	previous, known := reported[key]
+	var changes change
+	patched, err := r.patchStatus(ctx, obj, func(patched client.Object) (bool, error) {
+		switch resource := patched.(type) {
+		case *akov2.AtlasProject:
+			resource.Status.Conditions, changes = setLegacyCondition(resource.Status.Conditions, alerts)
+		case *akov2.AtlasDeployment:
+			resource.Status.Conditions, changes = setLegacyCondition(resource.Status.Conditions, alerts)
+		case *akov2generated.Group:
+			changes = setCondition(&resource.Status.Conditions, resource.GetGeneration(), alerts)
+		case *akov2generated.Cluster:
+			changes = setCondition(&resource.Status.Conditions, resource.GetGeneration(), alerts)
+		default:
+			return false, fmt.Errorf("unsupported resource %T", obj)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set the %s condition of %T %s: %w", api.AlertsOpenType, obj, client.ObjectKeyFromObject(obj), err)
+	}
+	reported[key] = message(alerts)
+	if patched == nil {
+		return nil
+	}
+	if known {
+		// the condition may have been overwritten by the controller of the resource since the last poll
+		changes = diff(previous, alerts)
+	}
+	for _, opened := range changes.opened {
+		r.EventRecorder.Eventf(patched, corev1.EventTypeWarning, ReasonAlertOpened, "Atlas alert opened: %s", opened.String())
+	}
+	for _, closed := range changes.closed {
+		r.EventRecorder.Eventf(patched, corev1.EventTypeNormal, ReasonAlertClosed, "Atlas alert %s closed", closed)
+	}
+	return nil
+}
+
+// remove removes the AlertsOpen condition of the resource.
+func (r *alertsReconciler) remove(ctx context.Context, obj client.Object) error {
+	_, err := r.patchStatus(ctx, obj, func(patched client.Object) (bool, error) {
+		switch resource := patched.(type) {
+		case *akov2.AtlasProject:
+			removed := api.HasConditionType(api.AlertsOpenType, resource.Status.Conditions)
+			resource.Status.Conditions = api.RemoveConditionIfExists(api.AlertsOpenType, resource.Status.Conditions)
+			return removed, nil
+		case *akov2.AtlasDeployment:
+			removed := api.HasConditionType(api.AlertsOpenType, resource.Status.Conditions)
+			resource.Status.Conditions = api.RemoveConditionIfExists(api.AlertsOpenType, resource.Status.Conditions)
+			return removed, nil
+		case *akov2generated.Group:
+			return resource.Status.Conditions != nil && meta.RemoveStatusCondition(resource.Status.Conditions, string(api.AlertsOpenType)), nil
+		case *akov2generated.Cluster:
+			return resource.Status.Conditions != nil && meta.RemoveStatusCondition(resource.Status.Conditions, string(api.AlertsOpenType)), nil
+		default:
+			return false, fmt.Errorf("unsupported resource %T", obj)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove the %s condition of %T %s: %w", api.AlertsOpenType, obj, client.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
+
+// patchStatus patches the status of the resource with the changes of mutate, if any, and returns the patched
+// resource, nil when nothing changed or the resource is gone. The patch holds the resource version, so that
+// the conditions set concurrently by the controller of the resource are never overwritten: on conflict, the
+// resource is fetched again and mutate is applied to the latest status.
+func (r *alertsReconciler) patchStatus(ctx context.Context, obj client.Object, mutate func(client.Object) (bool, error)) (client.Object, error) {
+	var patched client.Object
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if patched != nil {
+			if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+		}
+		copied, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			return fmt.Errorf("failed to copy %T", obj)
+		}
+		patched = copied
+		changed, err := mutate(patched)
+		if err != nil || !changed || equality.Semantic.DeepEqual(obj, patched) {
+			patched = nil
+			return err
+		}
+		return r.Client.Status().Patch(ctx, patched, client.MergeFromWithOptions(obj, client.MergeFromWithOptimisticLock{}))
+	})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
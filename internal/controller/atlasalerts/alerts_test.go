@@ -0,0 +1,349 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasalerts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	connectionsecretindexer "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/alert"
+)
+
+func TestPollInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		annotations      map[string]string
+		expectedInterval time.Duration
+		expectedEnabled  bool
+		expectedErr      string
+	}{
+		{
+			name: "should be disabled without the annotation",
+		},
+		{
+			name:             "should parse the interval",
+			annotations:      map[string]string{PollIntervalAnnotation: "5m"},
+			expectedInterval: 5 * time.Minute,
+			expectedEnabled:  true,
+		},
+		{
+			name:        "should reject an invalid duration",
+			annotations: map[string]string{PollIntervalAnnotation: "often"},
+			expectedErr: `invalid mongodb.com/atlas-alerts-poll-interval annotation "often"`,
+		},
+		{
+			name:        "should reject intervals shorter than the minimum",
+			annotations: map[string]string{PollIntervalAnnotation: "10s"},
+			expectedErr: "the interval must be at least 1m0s",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			project := &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			interval, enabled, err := pollInterval(project)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInterval, interval)
+			assert.Equal(t, tc.expectedEnabled, enabled)
+		})
+	}
+}
+
+func TestPollWith(t *testing.T) {
+	projectAlert := alert.Alert{ID: "alert-0", EventTypeName: "USERS_WITHOUT_MULTI_FACTOR_AUTH"}
+	otherClusterAlert := alert.Alert{ID: "alert-3", EventTypeName: "CLUSTER_MONGOS_IS_MISSING", ClusterName: "cluster1"}
+
+	kubeClient := testClient(t, testObjects()...)
+	recorder := record.NewFakeRecorder(10)
+	r := testReconciler(t, kubeClient, recorder)
+
+	service := translation.NewAlertServiceMock(t)
+	service.EXPECT().ListOpen(mock.Anything, "project-id").
+		Return([]alert.Alert{projectAlert, cpuAlert, otherClusterAlert}, nil).Once()
+	project := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasProject{}, "project", corev1.ConditionTrue, "alert-0")
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasDeployment{}, "cluster0", corev1.ConditionTrue, "alert-1")
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasDeployment{}, "cluster1", corev1.ConditionTrue, "alert-3")
+	assertCondition(t, kubeClient, metav1.ConditionTrue, "alert-1")
+	assert.ElementsMatch(t, []string{
+		"Warning AtlasAlertOpened Atlas alert opened: USERS_WITHOUT_MULTI_FACTOR_AUTH (alert alert-0)",
+		"Warning AtlasAlertOpened Atlas alert opened: OUTSIDE_METRIC_THRESHOLD NORMALIZED_SYSTEM_CPU_USER on cluster0-shard-00-00:27017 (alert alert-1)",
+		"Warning AtlasAlertOpened Atlas alert opened: OUTSIDE_METRIC_THRESHOLD NORMALIZED_SYSTEM_CPU_USER on cluster0-shard-00-00:27017 (alert alert-1)",
+		"Warning AtlasAlertOpened Atlas alert opened: CLUSTER_MONGOS_IS_MISSING (alert alert-3)",
+	}, drain(recorder))
+
+	// the same alerts are reported once
+	service.EXPECT().ListOpen(mock.Anything, "project-id").
+		Return([]alert.Alert{projectAlert, cpuAlert, otherClusterAlert}, nil).Once()
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+	assert.Empty(t, drain(recorder))
+
+	service.EXPECT().ListOpen(mock.Anything, "project-id").
+		Return([]alert.Alert{otherClusterAlert}, nil).Once()
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasProject{}, "project", corev1.ConditionFalse, "")
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasDeployment{}, "cluster0", corev1.ConditionFalse, "")
+	assertCondition(t, kubeClient, metav1.ConditionFalse, "")
+	assert.ElementsMatch(t, []string{
+		"Normal AtlasAlertClosed Atlas alert alert-0 closed",
+		"Normal AtlasAlertClosed Atlas alert alert-1 closed",
+		"Normal AtlasAlertClosed Atlas alert alert-1 closed",
+	}, drain(recorder))
+}
+
+func TestPollWithError(t *testing.T) {
+	kubeClient := testClient(t, testObjects()...)
+	r := testReconciler(t, kubeClient, record.NewFakeRecorder(10))
+
+	service := translation.NewAlertServiceMock(t)
+	service.EXPECT().ListOpen(mock.Anything, "project-id").Return(nil, errors.New("unauthorized"))
+	project := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.ErrorContains(t, r.pollWith(t.Context(), project, "project-id", service), "unauthorized")
+
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	assert.False(t, api.HasConditionType(api.AlertsOpenType, project.Status.Conditions))
+}
+
+func TestClear(t *testing.T) {
+	kubeClient := testClient(t, testObjects()...)
+	r := testReconciler(t, kubeClient, record.NewFakeRecorder(10))
+
+	service := translation.NewAlertServiceMock(t)
+	service.EXPECT().ListOpen(mock.Anything, "project-id").Return([]alert.Alert{cpuAlert}, nil)
+	project := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.clear(t.Context(), project, "project-id"))
+
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	assert.False(t, api.HasConditionType(api.AlertsOpenType, project.Status.Conditions))
+	deployment := &akov2.AtlasDeployment{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "cluster0"}, deployment))
+	assert.False(t, api.HasConditionType(api.AlertsOpenType, deployment.Status.Conditions))
+	cluster := &akov2generated.Cluster{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "generated-cluster0"}, cluster))
+	assert.Nil(t, meta.FindStatusCondition(*cluster.Status.Conditions, string(api.AlertsOpenType)))
+}
+
+func TestPollWithStatusOverwrittenBetweenPolls(t *testing.T) {
+	kubeClient := testClient(t, testObjects()...)
+	recorder := record.NewFakeRecorder(10)
+	r := testReconciler(t, kubeClient, recorder)
+
+	// the controllers of the resources read them before the first poll
+	staleProject := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, staleProject))
+	staleDeployment := &akov2.AtlasDeployment{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "cluster0"}, staleDeployment))
+
+	projectAlert := alert.Alert{ID: "alert-0", EventTypeName: "USERS_WITHOUT_MULTI_FACTOR_AUTH"}
+	service := translation.NewAlertServiceMock(t)
+	service.EXPECT().ListOpen(mock.Anything, "project-id").Return([]alert.Alert{projectAlert, cpuAlert}, nil).Twice()
+	project := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+	assert.Len(t, drain(recorder), 3)
+
+	// the controllers replace the whole status, without the AlertsOpen condition, like statushandler does
+	for _, stale := range []api.AtlasCustomResource{staleProject, staleDeployment} {
+		latest, ok := stale.DeepCopyObject().(api.AtlasCustomResource)
+		require.True(t, ok)
+		require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKeyFromObject(stale), latest))
+		stale.SetResourceVersion(latest.GetResourceVersion())
+		require.NoError(t, kubeClient.Status().Update(t.Context(), stale))
+	}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	require.False(t, api.HasConditionType(api.AlertsOpenType, project.Status.Conditions))
+
+	require.NoError(t, r.pollWith(t.Context(), project, "project-id", service))
+
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasProject{}, "project", corev1.ConditionTrue, "alert-0")
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasDeployment{}, "cluster0", corev1.ConditionTrue, "alert-1")
+	assert.Empty(t, drain(recorder), "the alerts still open must not be reported again")
+}
+
+func TestUpdateKeepsConcurrentStatusChanges(t *testing.T) {
+	kubeClient := testClient(t, testObjects()...)
+	r := testReconciler(t, kubeClient, record.NewFakeRecorder(10))
+
+	stale := &akov2.AtlasProject{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, stale))
+	// the project controller sets its conditions in the meantime
+	project := stale.DeepCopy()
+	project.Status.Conditions = append(project.Status.Conditions, api.TrueCondition(api.ReadyType))
+	require.NoError(t, kubeClient.Status().Update(t.Context(), project))
+
+	require.NoError(t, r.update(t.Context(), stale, []alert.Alert{{ID: "alert-0", EventTypeName: "USERS_WITHOUT_MULTI_FACTOR_AUTH"}}, reportedAlerts{}))
+
+	assertLegacyCondition(t, kubeClient, &akov2.AtlasProject{}, "project", corev1.ConditionTrue, "alert-0")
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "project"}, project))
+	assert.True(t, api.HasConditionType(api.ReadyType, project.Status.Conditions), "the conditions set concurrently must be kept")
+}
+
+func assertLegacyCondition(t *testing.T, kubeClient client.Client, obj api.AtlasCustomResource, name string, expectedStatus corev1.ConditionStatus, expectedID string) {
+	t.Helper()
+
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: name}, obj))
+	var condition *api.Condition
+	for _, c := range obj.GetStatus().GetConditions() {
+		if c.Type == api.AlertsOpenType {
+			condition = &c
+		}
+	}
+	require.NotNil(t, condition)
+	assert.Equal(t, expectedStatus, condition.Status)
+	if expectedID != "" {
+		assert.Contains(t, condition.Message, "(alert "+expectedID+")")
+	}
+}
+
+func assertCondition(t *testing.T, kubeClient client.Client, expectedStatus metav1.ConditionStatus, expectedID string) {
+	t.Helper()
+
+	cluster := &akov2generated.Cluster{}
+	require.NoError(t, kubeClient.Get(t.Context(), client.ObjectKey{Namespace: "ns", Name: "generated-cluster0"}, cluster))
+	require.NotNil(t, cluster.Status.Conditions)
+	condition := meta.FindStatusCondition(*cluster.Status.Conditions, string(api.AlertsOpenType))
+	require.NotNil(t, condition)
+	assert.Equal(t, expectedStatus, condition.Status)
+	if expectedID != "" {
+		assert.Contains(t, condition.Message, "(alert "+expectedID+")")
+	}
+}
+
+func testReconciler(t *testing.T, kubeClient client.Client, recorder record.EventRecorder) *alertsReconciler {
+	t.Helper()
+
+	return &alertsReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client: kubeClient,
+			Log:    zaptest.NewLogger(t).Sugar(),
+		},
+		EventRecorder: recorder,
+	}
+}
+
+func testObjects() []client.Object {
+	project := &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns"},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+	deployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "ns"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ProjectRef: &common.ResourceRefNamespaced{Name: "project"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+		},
+	}
+	otherDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: "ns"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ExternalProjectRef: &akov2.ExternalProjectReference{ID: "project-id"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster1"},
+		},
+	}
+	unrelatedDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "ns"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ExternalProjectRef: &akov2.ExternalProjectReference{ID: "other-project-id"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+		},
+	}
+	cluster := &akov2generated.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "generated-cluster0", Namespace: "ns"},
+		Spec: akov2generated.ClusterSpec{
+			V20250312: &akov2generated.ClusterSpecV20250312{
+				Entry: &akov2generated.V20250312Entry{Name: new("cluster0")},
+			},
+		},
+		Status: akov2generated.ClusterStatus{
+			V20250312: &akov2generated.ClusterStatusV20250312{GroupId: new("project-id")},
+		},
+	}
+	return []client.Object{project, deployment, otherDeployment, unrelatedDeployment, cluster}
+}
+
+func testClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	require.NoError(t, akov2generated.AddToScheme(scheme))
+
+	// the deployment indexer resolves project references, it needs a client holding the projects
+	projectClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	deploymentIndexer := indexer.NewAtlasDeploymentByProjectIndexer(t.Context(), projectClient, zaptest.NewLogger(t))
+	clusterIndexer := connectionsecretindexer.NewClusterByGroupIdIndexer(zaptest.NewLogger(t))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		WithIndex(deploymentIndexer.Object(), deploymentIndexer.Name(), deploymentIndexer.Keys).
+		WithIndex(clusterIndexer.Object(), clusterIndexer.Name(), clusterIndexer.Keys).
+		Build()
+}
+
+func drain(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasalerts
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/alert"
+)
+
+const (
+	// ReasonAlertsOpen is the reason of the AlertsOpen condition while alerts are open.
+	ReasonAlertsOpen = "AtlasAlertsOpen"
+	// ReasonNoAlertsOpen is the reason of the AlertsOpen condition once all alerts are closed.
+	ReasonNoAlertsOpen = "NoAtlasAlertsOpen"
+	// ReasonAlertOpened is the reason of the Warning event emitted for every new open alert.
+	ReasonAlertOpened = "AtlasAlertOpened"
+	// ReasonAlertClosed is the reason of the Normal event emitted for every closed alert.
+	ReasonAlertClosed = "AtlasAlertClosed"
+)
+
+// alertIDPattern matches the IDs of the alerts listed in the message of the condition,
+// see alert.Alert.String. The open alerts are recorded in the condition, so that
+// alerts are reported once across operator restarts.
+var alertIDPattern = regexp.MustCompile(`\(alert ([^)]+)\)`)
+
+// change holds the alerts opened and the IDs of the alerts closed since the condition was last set.
+type change struct {
+	opened []alert.Alert
+	closed []string
+}
+
+func (c *change) empty() bool {
+	return len(c.opened) == 0 && len(c.closed) == 0
+}
+
+// setLegacyCondition sets the AlertsOpen condition of resources using api.Condition.
+func setLegacyCondition(conditions []api.Condition, alerts []alert.Alert) ([]api.Condition, change) {
+	previous := ""
+	for i := range conditions {
+		if conditions[i].Type == api.AlertsOpenType {
+			previous = conditions[i].Message
+		}
+	}
+
+	condition := api.Condition{
+		Type:    api.AlertsOpenType,
+		Status:  corev1.ConditionFalse,
+		Reason:  ReasonNoAlertsOpen,
+		Message: message(alerts),
+	}
+	if len(alerts) > 0 {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = ReasonAlertsOpen
+	}
+	return api.EnsureConditionExists(condition, conditions), diff(previous, alerts)
+}
+
+// setCondition sets the AlertsOpen condition of resources using metav1.Condition, i.e. the generated API kinds.
+func setCondition(conditions **[]metav1.Condition, generation int64, alerts []alert.Alert) change {
+	if *conditions == nil {
+		*conditions = &[]metav1.Condition{}
+	}
+
+	previous := ""
+	if condition := meta.FindStatusCondition(**conditions, string(api.AlertsOpenType)); condition != nil {
+		previous = condition.Message
+	}
+
+	condition := metav1.Condition{
+		Type:               string(api.AlertsOpenType),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             ReasonNoAlertsOpen,
+		Message:            message(alerts),
+	}
+	if len(alerts) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonAlertsOpen
+	}
+	meta.SetStatusCondition(*conditions, condition)
+	return diff(previous, alerts)
+}
+
+// message lists the open alerts, oldest first.
+func message(alerts []alert.Alert) string {
+	if len(alerts) == 0 {
+		return "No Atlas alerts are open."
+	}
+	sorted := slices.Clone(alerts)
+	slices.SortFunc(sorted, func(a, b alert.Alert) int {
+		if c := a.Created.Compare(b.Created); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	descriptions := make([]string, 0, len(sorted))
+	for i := range sorted {
+		descriptions = append(descriptions, sorted[i].String())
+	}
+	return fmt.Sprintf("%d open Atlas alert(s): %s", len(sorted), strings.Join(descriptions, "; "))
+}
+
+// diff returns the alerts missing from the previous message of the condition and the IDs
+// of the alerts of the previous message that are no longer open.
+func diff(previous string, alerts []alert.Alert) change {
+	previousIDs := map[string]bool{}
+	for _, match := range alertIDPattern.FindAllStringSubmatch(previous, -1) {
+		previousIDs[match[1]] = true
+	}
+
+	result := change{}
+	for i := range alerts {
+		if !previousIDs[alerts[i].ID] {
+			result.opened = append(result.opened, alerts[i])
+		}
+		delete(previousIDs, alerts[i].ID)
+	}
+	for id := range previousIDs {
+		result.closed = append(result.closed, id)
+	}
+	slices.Sort(result.closed)
+	return result
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasalerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/alert"
+)
+
+var (
+	cpuAlert = alert.Alert{
+		ID:              "alert-1",
+		EventTypeName:   "OUTSIDE_METRIC_THRESHOLD",
+		ClusterName:     "cluster0",
+		HostnameAndPort: "cluster0-shard-00-00:27017",
+		MetricName:      "NORMALIZED_SYSTEM_CPU_USER",
+		Created:         time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	replicationAlert = alert.Alert{
+		ID:             "alert-2",
+		EventTypeName:  "NO_PRIMARY",
+		ClusterName:    "cluster0",
+		ReplicaSetName: "cluster0-shard-0",
+		Created:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+func TestMessage(t *testing.T) {
+	assert.Equal(t, "No Atlas alerts are open.", message(nil))
+	assert.Equal(t,
+		"2 open Atlas alert(s): NO_PRIMARY on cluster0-shard-0 (alert alert-2); "+
+			"OUTSIDE_METRIC_THRESHOLD NORMALIZED_SYSTEM_CPU_USER on cluster0-shard-00-00:27017 (alert alert-1)",
+		message([]alert.Alert{cpuAlert, replicationAlert}),
+	)
+}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		previous       []alert.Alert
+		alerts         []alert.Alert
+		expectedOpened []alert.Alert
+		expectedClosed []string
+	}{
+		{
+			name: "should report nothing without alerts",
+		},
+		{
+			name:           "should report the first alerts as opened",
+			alerts:         []alert.Alert{cpuAlert, replicationAlert},
+			expectedOpened: []alert.Alert{cpuAlert, replicationAlert},
+		},
+		{
+			name:     "should not report alerts still open",
+			previous: []alert.Alert{cpuAlert, replicationAlert},
+			alerts:   []alert.Alert{replicationAlert, cpuAlert},
+		},
+		{
+			name:           "should report new and closed alerts",
+			previous:       []alert.Alert{cpuAlert},
+			alerts:         []alert.Alert{replicationAlert},
+			expectedOpened: []alert.Alert{replicationAlert},
+			expectedClosed: []string{"alert-1"},
+		},
+		{
+			name:           "should report all alerts closed",
+			previous:       []alert.Alert{cpuAlert, replicationAlert},
+			expectedClosed: []string{"alert-1", "alert-2"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := diff(message(tc.previous), tc.alerts)
+			assert.Equal(t, tc.expectedOpened, result.opened)
+			assert.Equal(t, tc.expectedClosed, result.closed)
+		})
+	}
+}
+
+func TestSetLegacyCondition(t *testing.T) {
+	ready := api.TrueCondition(api.ReadyType)
+	conditions, changes := setLegacyCondition([]api.Condition{ready}, []alert.Alert{cpuAlert})
+	require.Len(t, conditions, 2)
+	assert.Equal(t, ready, conditions[0])
+	assert.Equal(t, api.AlertsOpenType, conditions[1].Type)
+	assert.Equal(t, corev1.ConditionTrue, conditions[1].Status)
+	assert.Equal(t, ReasonAlertsOpen, conditions[1].Reason)
+	assert.Equal(t, []alert.Alert{cpuAlert}, changes.opened)
+	assert.Empty(t, changes.closed)
+
+	conditions, changes = setLegacyCondition(conditions, nil)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, corev1.ConditionFalse, conditions[1].Status)
+	assert.Equal(t, ReasonNoAlertsOpen, conditions[1].Reason)
+	assert.Equal(t, "No Atlas alerts are open.", conditions[1].Message)
+	assert.Empty(t, changes.opened)
+	assert.Equal(t, []string{"alert-1"}, changes.closed)
+}
+
+func TestSetCondition(t *testing.T) {
+	var conditions *[]metav1.Condition
+	changes := setCondition(&conditions, 2, []alert.Alert{cpuAlert})
+	require.NotNil(t, conditions)
+	condition := meta.FindStatusCondition(*conditions, string(api.AlertsOpenType))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonAlertsOpen, condition.Reason)
+	assert.Equal(t, int64(2), condition.ObservedGeneration)
+	assert.Equal(t, []alert.Alert{cpuAlert}, changes.opened)
+
+	changes = setCondition(&conditions, 2, []alert.Alert{cpuAlert})
+	assert.True(t, changes.empty())
+
+	changes = setCondition(&conditions, 3, nil)
+	condition = meta.FindStatusCondition(*conditions, string(api.AlertsOpenType))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonNoAlertsOpen, condition.Reason)
+	assert.Equal(t, []string{"alert-1"}, changes.closed)
+}
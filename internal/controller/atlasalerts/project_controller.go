@@ -0,0 +1,104 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasalerts
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+// AtlasProjectAlertsReconciler polls the open alerts of the projects of AtlasProject resources
+// with the mongodb.com/atlas-alerts-poll-interval annotation.
+type AtlasProjectAlertsReconciler struct {
+	alertsReconciler
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.generated.mongodb.com,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.generated.mongodb.com,resources=clusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasProjectAlertsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	project := &akov2.AtlasProject{}
+	if err := r.Client.Get(ctx, req.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	interval, enabled, err := pollInterval(project)
+	if err != nil {
+		r.invalid(project, err)
+		return ctrl.Result{}, nil
+	}
+	if !enabled || !project.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, r.clear(ctx, project, project.ID())
+	}
+	if project.ID() == "" {
+		// the project is not created in Atlas yet
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	return r.poll(ctx, project, project.ID(), project.ConnectionSecretObjectKey(), interval), nil
+}
+
+func (r *AtlasProjectAlertsReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasProject{}, r.predicates()
+}
+
+func (r *AtlasProjectAlertsReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasProjectAlerts").
+		For(r.For()).
+		WithOptions(controller.TypedOptions[reconcile.Request]{
+			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
+			SkipNameValidation:      new(skipNameValidation),
+			MaxConcurrentReconciles: r.maxConcurrentReconciles}).
+		Complete(r)
+}
+
+func NewAtlasProjectAlertsReconciler(c cluster.Cluster, predicates []predicate.Predicate, atlasProvider atlas.Provider, logger *zap.Logger, globalSecretRef client.ObjectKey, maxConcurrentReconciles int) *AtlasProjectAlertsReconciler {
+	return &AtlasProjectAlertsReconciler{
+		alertsReconciler: alertsReconciler{
+			AtlasReconciler: reconciler.AtlasReconciler{
+				Client:          c.GetClient(),
+				Log:             logger.Named("controllers").Named("AtlasProjectAlerts").Sugar(),
+				GlobalSecretRef: globalSecretRef,
+				AtlasProvider:   atlasProvider,
+			},
+			EventRecorder:           c.GetEventRecorderFor("AtlasAlerts"),
+			GlobalPredicates:        predicates,
+			maxConcurrentReconciles: maxConcurrentReconciles,
+		},
+	}
+}
@@ -83,6 +83,10 @@ func (r *AtlasBackupCompliancePolicyReconciler) Reconcile(ctx context.Context, r
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, bcp, "AtlasBackupCompliancePolicy")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(bcp) {
 		return r.unsupport(workflowCtx)
@@ -0,0 +1,95 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackuprestorejob
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+// AtlasBackupRestoreJobReconciler reconciles a AtlasBackupRestoreJob object
+type AtlasBackupRestoreJobReconciler struct {
+	reconciler.AtlasReconciler
+	Scheme                   *runtime.Scheme
+	EventRecorder            record.EventRecorder
+	GlobalPredicates         []predicate.Predicate
+	ObjectDeletionProtection bool
+	maxConcurrentReconciles  int
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackuprestorejobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackuprestorejobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackuprestorejobs/finalizers,verbs=update
+
+// Reconcile Atlas Backup Restore Job resources
+func (r *AtlasBackupRestoreJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log.Infow("-> Starting AtlasBackupRestoreJob reconciliation")
+
+	restoreJob := akov2.AtlasBackupRestoreJob{}
+	result := customresource.PrepareResource(ctx, r.Client, req, &restoreJob, r.Log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+	return r.handleCustomResource(ctx, &restoreJob)
+}
+
+// For prepares the controller for its target Custom Resource; Backup Restore Jobs
+func (r *AtlasBackupRestoreJobReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasBackupRestoreJob{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Restore jobs in progress are polled, so no other resources are watched.
+func (r *AtlasBackupRestoreJobReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.For()).
+		WithOptions(controller.TypedOptions[reconcile.Request]{
+			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
+			SkipNameValidation:      new(skipNameValidation),
+			MaxConcurrentReconciles: r.maxConcurrentReconciles}).
+		Complete(r)
+}
+
+func NewAtlasBackupRestoreJobReconciler(c cluster.Cluster, predicates []predicate.Predicate, atlasProvider atlas.Provider, deletionProtection bool, logger *zap.Logger, globalSecretRef client.ObjectKey, maxConcurrentReconciles int) *AtlasBackupRestoreJobReconciler {
+	return &AtlasBackupRestoreJobReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			Log:             logger.Named("controllers").Named("AtlasBackupRestoreJob").Sugar(),
+			GlobalSecretRef: globalSecretRef,
+			AtlasProvider:   atlasProvider,
+		},
+		Scheme:                   c.GetScheme(),
+		EventRecorder:            c.GetEventRecorderFor("AtlasBackupRestoreJob"),
+		GlobalPredicates:         predicates,
+		ObjectDeletionProtection: deletionProtection,
+		maxConcurrentReconciles:  maxConcurrentReconciles,
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackuprestorejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backuprestore"
+)
+
+const (
+	typeName = "AtlasBackupRestoreJob"
+)
+
+type reconcileRequest struct {
+	projectID   string
+	clusterName string
+	specHash    string
+	restoreJob  *akov2.AtlasBackupRestoreJob
+	config      *backuprestore.RestoreJobConfig
+	service     backuprestore.RestoreJobService
+}
+
+func (r *AtlasBackupRestoreJobReconciler) handleCustomResource(ctx context.Context, restoreJob *akov2.AtlasBackupRestoreJob) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(restoreJob) {
+		return r.Skip(ctx, typeName, restoreJob, restoreJob.Spec)
+	}
+
+	conditions := api.InitCondition(restoreJob, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, restoreJob)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, restoreJob)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, restoreJob, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, restoreJob, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(restoreJob) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	specHash, err := backuprestore.SpecHash(&restoreJob.Spec)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.Internal, err)
+	}
+	deleted := restoreJob.DeletionTimestamp != nil
+	started := restoreJob.Status.SpecHash != ""
+	if started && restoreJob.Status.SpecHash != specHash {
+		err := fmt.Errorf("restore job %s was already started for a different spec, create a new %s to run another restore", restoreJob.Status.ID, typeName)
+		// retrying does not help, the spec must be reverted
+		result := workflow.Terminate(workflow.BackupRestoreJobSpecChanged, err).WithoutRetry()
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupRestoreJobReady, result)
+		return result.ReconcileResult()
+	}
+	if deleted && (!started || restoreJob.Status.Phase.IsFinal()) {
+		// nothing to cancel in Atlas
+		return r.unmanage(workflowCtx, restoreJob)
+	}
+	if started && restoreJob.Status.Phase.IsFinal() {
+		// a finished restore never runs again, no need to reach Atlas
+		return r.finished(workflowCtx, restoreJob, restoreJob.Status.ID, restoreJob.Status.Phase)
+	}
+
+	sourceDeployment, err := r.fetchDeployment(ctx, restoreJob, &restoreJob.Spec.DeploymentRef)
+	if deleted && apierrors.IsNotFound(err) {
+		return r.unmanage(workflowCtx, restoreJob)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, sourceDeployment)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	sourceProject, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312, sourceDeployment)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	req := &reconcileRequest{
+		projectID:   sourceProject.ID,
+		clusterName: sourceDeployment.GetDeploymentName(),
+		specHash:    specHash,
+		restoreJob:  restoreJob,
+		service:     backuprestore.NewRestoreJobServiceFromClientSet(sdkClientSet),
+	}
+	if deleted {
+		return r.delete(workflowCtx, req)
+	}
+
+	targetDeployment, err := r.fetchDeployment(ctx, restoreJob, &restoreJob.Spec.TargetDeploymentRef)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	targetProject, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312, targetDeployment)
+	if err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	req.config = backuprestore.NewRestoreJobConfig(restoreJob, targetProject.ID, targetDeployment.GetDeploymentName())
+	return r.handle(workflowCtx, req)
+}
+
+func (r *AtlasBackupRestoreJobReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	atlasRestoreJob, err := discover(workflowCtx.Context, req)
+	if err != nil {
+		return r.terminate(workflowCtx, req.restoreJob, workflow.BackupRestoreJobNotConfigured, err)
+	}
+	if atlasRestoreJob == nil {
+		return r.create(workflowCtx, req)
+	}
+	return r.track(workflowCtx, req, atlasRestoreJob)
+}
+
+// discover returns the restore job started for this resource, if any.
+// Restore jobs missing from the status, e.g. after a failed status update, are found by their config,
+// so that the same restore never runs twice.
+func discover(ctx context.Context, req *reconcileRequest) (*backuprestore.RestoreJob, error) {
+	if id := req.restoreJob.Status.ID; id != "" {
+		restoreJob, err := req.service.Get(ctx, req.projectID, req.clusterName, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get restore job %s for cluster %s: %w", id, req.clusterName, err)
+		}
+		return restoreJob, nil
+	}
+	restoreJob, err := req.service.Find(ctx, req.projectID, req.clusterName, req.config)
+	if err != nil && !errors.Is(err, backuprestore.ErrNotFound) {
+		return nil, fmt.Errorf("failed to find restore job for cluster %s: %w", req.clusterName, err)
+	}
+	return restoreJob, nil
+}
+
+func (r *AtlasBackupRestoreJobReconciler) fetchDeployment(ctx context.Context, restoreJob *akov2.AtlasBackupRestoreJob, ref *common.ResourceRefNamespaced) (*akov2.AtlasDeployment, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := ref.GetObject(restoreJob.Namespace)
+	if err := r.Client.Get(ctx, *key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasDeployment %s: %w", key, err)
+	}
+	return deployment, nil
+}
@@ -0,0 +1,339 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackuprestorejob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backuprestore"
+)
+
+const (
+	testProjectID = "project-id"
+
+	testClusterName = "source-cluster"
+
+	testRestoreJobID = "restore-job-id"
+
+	testSnapshotID = "5f4007f327a3bd7b6f4103c5"
+)
+
+var ErrTestFail = errors.New("failure")
+
+func TestHandleCustomResource(t *testing.T) {
+	spec := testSpec()
+	specHash, err := backuprestore.SpecHash(&spec)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		title          string
+		restoreJob     *akov2.AtlasBackupRestoreJob
+		wantResult     ctrl.Result
+		wantConditions []api.Condition
+	}{
+		{
+			title: "finished restore is not run again",
+			restoreJob: &akov2.AtlasBackupRestoreJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default"},
+				Spec:       spec,
+				Status: status.AtlasBackupRestoreJobStatus{
+					ID:       testRestoreJobID,
+					Phase:    status.BackupRestoreJobPhaseCompleted,
+					SpecHash: specHash,
+				},
+			},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.ReadyType),
+				api.TrueCondition(api.ResourceVersionStatus),
+				api.TrueCondition(api.BackupRestoreJobReady).WithMessageRegexp("Restore job restore-job-id completed"),
+			},
+		},
+		{
+			title: "changed spec is rejected",
+			restoreJob: &akov2.AtlasBackupRestoreJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default"},
+				Spec:       spec,
+				Status: status.AtlasBackupRestoreJobStatus{
+					ID:       testRestoreJobID,
+					Phase:    status.BackupRestoreJobPhaseInProgress,
+					SpecHash: "previous-spec-hash",
+				},
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.TrueCondition(api.ResourceVersionStatus),
+				api.FalseCondition(api.BackupRestoreJobReady).WithReason(string(workflow.BackupRestoreJobSpecChanged)).
+					WithMessageRegexp("restore job restore-job-id was already started for a different spec, create a new AtlasBackupRestoreJob to run another restore"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			k8sClient := testClient(t, tc.restoreJob)
+			// Atlas is never reached
+			provider := &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool { return true },
+			}
+			r := testReconciler(k8sClient, provider, zaptest.NewLogger(t))
+			result, err := r.handleCustomResource(context.Background(), tc.restoreJob)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+
+			restoreJob := getRestoreJob(t, k8sClient, client.ObjectKeyFromObject(tc.restoreJob))
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(restoreJob.Status.GetConditions()))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	finishedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tc := range []struct {
+		title          string
+		status         status.AtlasBackupRestoreJobStatus
+		service        func() backuprestore.RestoreJobService
+		wantResult     ctrl.Result
+		wantFinalizers []string
+		wantConditions []api.Condition
+	}{
+		{
+			title: "starts a restore job",
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, backuprestore.ErrNotFound)
+				rjs.EXPECT().Create(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(
+					&backuprestore.RestoreJob{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseInProgress}, nil,
+				)
+				return rjs
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupRestoreJobReady).WithReason(string(workflow.BackupRestoreJobInProgress)).
+					WithMessageRegexp("Restore job restore-job-id is in progress"),
+			},
+		},
+		{
+			title: "adopts a restore job missing from the status instead of starting it twice",
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(
+					&backuprestore.RestoreJob{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseInProgress}, nil,
+				)
+				return rjs
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupRestoreJobReady).WithReason(string(workflow.BackupRestoreJobInProgress)).
+					WithMessageRegexp("Restore job restore-job-id is in progress"),
+			},
+		},
+		{
+			title:  "completes a restore job",
+			status: status.AtlasBackupRestoreJobStatus{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseInProgress},
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testRestoreJobID).Return(
+					&backuprestore.RestoreJob{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseCompleted, FinishedAt: &finishedAt}, nil,
+				)
+				return rjs
+			},
+			wantResult: ctrl.Result{},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.BackupRestoreJobReady).WithMessageRegexp("Restore job restore-job-id completed"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:  "reports a failed restore job without retrying",
+			status: status.AtlasBackupRestoreJobStatus{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseInProgress},
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testRestoreJobID).Return(
+					&backuprestore.RestoreJob{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseFailed}, nil,
+				)
+				return rjs
+			},
+			wantResult: ctrl.Result{},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupRestoreJobReady).WithReason(string(workflow.BackupRestoreJobFailed)).
+					WithMessageRegexp("restore job restore-job-id finished with phase Failed"),
+			},
+		},
+		{
+			title: "fails to start a restore job",
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, backuprestore.ErrNotFound)
+				rjs.EXPECT().Create(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, ErrTestFail)
+				return rjs
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupRestoreJobNotConfigured)).
+					WithMessageRegexp("failed to create restore job: failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			restoreJob := &akov2.AtlasBackupRestoreJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default"},
+				Spec:       testSpec(),
+				Status:     tc.status,
+			}
+			k8sClient := testClient(t, restoreJob)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				restoreJob:  restoreJob,
+				config:      backuprestore.NewRestoreJobConfig(restoreJob, testProjectID, "target-cluster"),
+				service:     tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantFinalizers, getRestoreJob(t, k8sClient, client.ObjectKeyFromObject(restoreJob)).GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		annotations map[string]string
+		service     func() backuprestore.RestoreJobService
+	}{
+		{
+			title: "cancels a running restore job",
+			service: func() backuprestore.RestoreJobService {
+				rjs := akomock.NewRestoreJobServiceMock(t)
+				rjs.EXPECT().Cancel(mock.Anything, testProjectID, testClusterName, testRestoreJobID).Return(nil)
+				return rjs
+			},
+		},
+		{
+			title:       "keeps a running restore job",
+			annotations: map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep},
+			service: func() backuprestore.RestoreJobService {
+				return akomock.NewRestoreJobServiceMock(t)
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			restoreJob := &akov2.AtlasBackupRestoreJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "restore",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec:   testSpec(),
+				Status: status.AtlasBackupRestoreJobStatus{ID: testRestoreJobID, Phase: status.BackupRestoreJobPhaseInProgress},
+			}
+			k8sClient := testClient(t, restoreJob)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.delete(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				restoreJob:  restoreJob,
+				service:     tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getRestoreJob(t, k8sClient, client.ObjectKeyFromObject(restoreJob)).GetFinalizers())
+		})
+	}
+}
+
+func testSpec() akov2.AtlasBackupRestoreJobSpec {
+	return akov2.AtlasBackupRestoreJobSpec{
+		DeploymentRef:       common.ResourceRefNamespaced{Name: "source"},
+		SnapshotID:          testSnapshotID,
+		TargetDeploymentRef: common.ResourceRefNamespaced{Name: "target"},
+	}
+}
+
+func testClient(t *testing.T, restoreJob *akov2.AtlasBackupRestoreJob) client.Client {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(restoreJob).
+		WithStatusSubresource(restoreJob).
+		Build()
+}
+
+func getRestoreJob(t *testing.T, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasBackupRestoreJob {
+	restoreJob := &akov2.AtlasBackupRestoreJob{}
+	if err := k8sClient.Get(context.Background(), key, restoreJob); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return restoreJob
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasBackupRestoreJobReconciler {
+	return &AtlasBackupRestoreJobReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackuprestorejob
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backuprestore"
+)
+
+func (r *AtlasBackupRestoreJobReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	// the finalizer allows cancelling the restore if the resource is removed while running
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.restoreJob, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.restoreJob, workflow.AtlasFinalizerNotSet, err)
+	}
+	restoreJob, err := req.service.Create(workflowCtx.Context, req.projectID, req.clusterName, req.config)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to create restore job: %w", err)
+		return r.terminate(workflowCtx, req.restoreJob, workflow.BackupRestoreJobNotConfigured, wrappedErr)
+	}
+	r.EventRecorder.Eventf(req.restoreJob, corev1.EventTypeNormal, string(workflow.BackupRestoreJobInProgress),
+		"Started restore job %s of deployment %s to deployment %s", restoreJob.ID, req.clusterName, req.config.TargetClusterName)
+	return r.track(workflowCtx, req, restoreJob)
+}
+
+func (r *AtlasBackupRestoreJobReconciler) track(workflowCtx *workflow.Context, req *reconcileRequest, restoreJob *backuprestore.RestoreJob) (ctrl.Result, error) {
+	workflowCtx.EnsureStatusOption(updateRestoreJobStatusOption(req, restoreJob))
+	if !restoreJob.Phase.IsFinal() {
+		if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.restoreJob, customresource.SetFinalizer); err != nil {
+			return r.terminate(workflowCtx, req.restoreJob, workflow.AtlasFinalizerNotSet, err)
+		}
+		msg := fmt.Sprintf("Restore job %s is in progress", restoreJob.ID)
+		result := workflow.InProgress(workflow.BackupRestoreJobInProgress, msg)
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupRestoreJobReady, result)
+		return result.ReconcileResult()
+	}
+
+	if restoreJob.Phase == status.BackupRestoreJobPhaseCompleted {
+		r.EventRecorder.Eventf(req.restoreJob, corev1.EventTypeNormal, string(workflow.BackupRestoreJobCompleted),
+			"Restore job %s completed", restoreJob.ID)
+	} else {
+		r.EventRecorder.Eventf(req.restoreJob, corev1.EventTypeWarning, string(workflow.BackupRestoreJobFailed),
+			"Restore job %s finished with phase %s", restoreJob.ID, restoreJob.Phase)
+	}
+	return r.finished(workflowCtx, req.restoreJob, restoreJob.ID, restoreJob.Phase)
+}
+
+// finished reports a restore job that can no longer progress. The finalizer is removed
+// as there is nothing left to cancel in Atlas.
+func (r *AtlasBackupRestoreJobReconciler) finished(workflowCtx *workflow.Context, restoreJob *akov2.AtlasBackupRestoreJob, id string, phase status.AtlasBackupRestoreJobPhase) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, restoreJob, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	if phase != status.BackupRestoreJobPhaseCompleted {
+		err := fmt.Errorf("restore job %s finished with phase %s", id, phase)
+		// the same restore is never retried, a new resource must be created
+		result := workflow.Terminate(workflow.BackupRestoreJobFailed, err).WithoutRetry()
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupRestoreJobReady, result)
+		return result.ReconcileResult()
+	}
+
+	workflowCtx.SetConditionTrueMsg(api.BackupRestoreJobReady, fmt.Sprintf("Restore job %s completed", id)).
+		SetConditionTrue(api.ReadyType)
+	return workflow.OK().ReconcileResult()
+}
+
+func (r *AtlasBackupRestoreJobReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.restoreJob, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.restoreJob)
+	}
+	err := req.service.Cancel(workflowCtx.Context, req.projectID, req.clusterName, req.restoreJob.Status.ID)
+	if err != nil && !errors.Is(err, backuprestore.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to cancel restore job: %w", err)
+		return r.terminate(workflowCtx, req.restoreJob, workflow.BackupRestoreJobNotCancelled, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.restoreJob)
+}
+
+func (r *AtlasBackupRestoreJobReconciler) unmanage(workflowCtx *workflow.Context, restoreJob *akov2.AtlasBackupRestoreJob) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, restoreJob, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, restoreJob, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasBackupRestoreJobReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateRestoreJobStatusOption(req *reconcileRequest, restoreJob *backuprestore.RestoreJob) status.AtlasBackupRestoreJobStatusOption {
+	return func(restoreStatus *status.AtlasBackupRestoreJobStatus) {
+		restoreStatus.SpecHash = req.specHash
+		restoreStatus.ProjectID = req.projectID
+		restoreStatus.ClusterName = req.clusterName
+		backuprestore.ApplyRestoreJobStatus(restoreStatus, restoreJob)
+	}
+}
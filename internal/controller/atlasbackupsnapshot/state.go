@@ -0,0 +1,146 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
+)
+
+const (
+	typeName = "AtlasBackupSnapshot"
+)
+
+type reconcileRequest struct {
+	projectID   string
+	clusterName string
+	snapshot    *akov2.AtlasBackupSnapshot
+	service     backupsnapshot.SnapshotService
+}
+
+func (r *AtlasBackupSnapshotReconciler) handleCustomResource(ctx context.Context, snapshot *akov2.AtlasBackupSnapshot) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(snapshot) {
+		return r.Skip(ctx, typeName, snapshot, snapshot.Spec)
+	}
+
+	conditions := api.InitCondition(snapshot, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, snapshot)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, snapshot)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, snapshot, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, snapshot, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(snapshot) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	deleted := snapshot.DeletionTimestamp != nil
+	if deleted && snapshot.Status.ID == "" {
+		// the snapshot was never taken, nothing to delete in Atlas
+		return r.unmanage(workflowCtx, snapshot)
+	}
+
+	req, err := r.newReconcileRequest(ctx, snapshot)
+	if deleted && apierrors.IsNotFound(err) {
+		// the parent deployment is gone, along with the credentials to reach its snapshots
+		return r.unmanage(workflowCtx, snapshot)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, snapshot, workflow.BackupSnapshotNotConfigured, err)
+	}
+	if deleted {
+		return r.delete(workflowCtx, req)
+	}
+	return r.handle(workflowCtx, req)
+}
+
+// newReconcileRequest resolves the Atlas project and deployment of the snapshot,
+// either through the referenced AtlasDeployment or the external deployment reference.
+func (r *AtlasBackupSnapshotReconciler) newReconcileRequest(ctx context.Context, snapshot *akov2.AtlasBackupSnapshot) (*reconcileRequest, error) {
+	var referrer project.ProjectReferrerObject = snapshot
+	var clusterName string
+	if snapshot.Spec.DeploymentRef != nil {
+		deployment, err := r.fetchDeployment(ctx, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		referrer = deployment
+		clusterName = deployment.GetDeploymentName()
+	} else {
+		clusterName = snapshot.Spec.ExternalDeploymentRef.Name
+	}
+
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, referrer)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return nil, err
+	}
+	atlasProject, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312, referrer)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		projectID:   atlasProject.ID,
+		clusterName: clusterName,
+		snapshot:    snapshot,
+		service:     backupsnapshot.NewSnapshotServiceFromClientSet(sdkClientSet),
+	}, nil
+}
+
+func (r *AtlasBackupSnapshotReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	id := req.snapshot.Status.ID
+	if id == "" {
+		return r.create(workflowCtx, req)
+	}
+	atlasSnapshot, err := req.service.Get(workflowCtx.Context, req.projectID, req.clusterName, id)
+	if errors.Is(err, backupsnapshot.ErrNotFound) {
+		return r.gone(workflowCtx, req)
+	}
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to get snapshot %s of cluster %s: %w", id, req.clusterName, err)
+		return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotConfigured, wrappedErr)
+	}
+	return r.track(workflowCtx, req, atlasSnapshot)
+}
+
+func (r *AtlasBackupSnapshotReconciler) fetchDeployment(ctx context.Context, snapshot *akov2.AtlasBackupSnapshot) (*akov2.AtlasDeployment, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := snapshot.Spec.DeploymentRef.GetObject(snapshot.Namespace)
+	if err := r.Client.Get(ctx, *key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasDeployment %s: %w", key, err)
+	}
+	return deployment, nil
+}
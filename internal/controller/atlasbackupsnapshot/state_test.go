@@ -0,0 +1,433 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupsnapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
+)
+
+const (
+	testProjectID = "project-id"
+
+	testClusterName = "test-cluster"
+
+	testSnapshotID = "5f4007f327a3bd7b6f4103c5"
+)
+
+var ErrTestFail = errors.New("failure")
+
+func TestHandleCustomResourceDeletion(t *testing.T) {
+	for _, tc := range []struct {
+		title  string
+		status status.AtlasBackupSnapshotStatus
+	}{
+		{
+			title: "snapshot never taken",
+		},
+		{
+			title:  "deployment already removed",
+			status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseCompleted},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			snapshot := &akov2.AtlasBackupSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "snapshot",
+					Namespace:         "default",
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec:   testSpec(),
+				Status: tc.status,
+			}
+			k8sClient := testClient(t, snapshot)
+			// Atlas is never reached
+			provider := &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool { return true },
+			}
+			r := testReconciler(k8sClient, provider, zaptest.NewLogger(t))
+			result, err := r.handleCustomResource(context.Background(), snapshot)
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getSnapshot(t, k8sClient, client.ObjectKeyFromObject(snapshot)).GetFinalizers())
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+	requestedAt := createdAt.Add(-time.Minute)
+	wantConfig := &backupsnapshot.SnapshotConfig{
+		Description:     "before migration",
+		RetentionInDays: 1,
+		RequestedAt:     requestedAt,
+	}
+	for _, tc := range []struct {
+		title          string
+		status         status.AtlasBackupSnapshotStatus
+		service        func() backupsnapshot.SnapshotService
+		wantResult     ctrl.Result
+		wantFinalizers []string
+		wantStatus     status.AtlasBackupSnapshotStatus
+		wantConditions []api.Condition
+	}{
+		{
+			title: "takes a snapshot",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Find(mock.Anything, testProjectID, testClusterName, wantConfig).Return(nil, backupsnapshot.ErrNotFound)
+				bss.EXPECT().Create(mock.Anything, testProjectID, testClusterName, wantConfig).
+					Return(&backupsnapshot.Snapshot{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseQueued, CreatedAt: &createdAt}, nil)
+				return bss
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus: status.AtlasBackupSnapshotStatus{
+				ID:              testSnapshotID,
+				Phase:           status.BackupSnapshotPhaseQueued,
+				ProjectID:       testProjectID,
+				ClusterName:     testClusterName,
+				RetentionInDays: 1,
+				CreatedAt:       "2025-01-02T03:04:05Z",
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupSnapshotReady).WithReason(string(workflow.BackupSnapshotInProgress)).
+					WithMessageRegexp("Snapshot 5f4007f327a3bd7b6f4103c5 is Queued"),
+			},
+		},
+		{
+			title: "tracks a snapshot taken without recording it instead of taking another one",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Find(mock.Anything, testProjectID, testClusterName, wantConfig).
+					Return(&backupsnapshot.Snapshot{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseInProgress, CreatedAt: &createdAt}, nil)
+				return bss
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus: status.AtlasBackupSnapshotStatus{
+				ID:              testSnapshotID,
+				Phase:           status.BackupSnapshotPhaseInProgress,
+				ProjectID:       testProjectID,
+				ClusterName:     testClusterName,
+				RetentionInDays: 1,
+				CreatedAt:       "2025-01-02T03:04:05Z",
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupSnapshotReady).WithReason(string(workflow.BackupSnapshotInProgress)).
+					WithMessageRegexp("Snapshot 5f4007f327a3bd7b6f4103c5 is InProgress"),
+			},
+		},
+		{
+			title: "fails to look up a snapshot taken without recording it",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, ErrTestFail)
+				return bss
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupSnapshotNotConfigured)).
+					WithMessageRegexp("failed to look up snapshot of cluster test-cluster: failure"),
+			},
+		},
+		{
+			title:  "completes a snapshot",
+			status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseInProgress, RetentionInDays: 1},
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(
+					&backupsnapshot.Snapshot{
+						ID:               testSnapshotID,
+						Phase:            status.BackupSnapshotPhaseCompleted,
+						StorageSizeBytes: 1024,
+						CreatedAt:        &createdAt,
+						ExpiresAt:        &expiresAt,
+					}, nil,
+				)
+				return bss
+			},
+			wantResult: ctrl.Result{},
+			wantStatus: status.AtlasBackupSnapshotStatus{
+				ID:               testSnapshotID,
+				Phase:            status.BackupSnapshotPhaseCompleted,
+				ProjectID:        testProjectID,
+				ClusterName:      testClusterName,
+				StorageSizeBytes: 1024,
+				RetentionInDays:  1,
+				CreatedAt:        "2025-01-02T03:04:05Z",
+				ExpiresAt:        "2025-01-03T03:04:05Z",
+			},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.BackupSnapshotReady).WithMessageRegexp("Snapshot 5f4007f327a3bd7b6f4103c5 completed"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:  "updates the retention of a completed snapshot",
+			status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseCompleted, RetentionInDays: 7},
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(
+					&backupsnapshot.Snapshot{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseCompleted}, nil,
+				)
+				bss.EXPECT().UpdateRetention(mock.Anything, testProjectID, testClusterName, testSnapshotID, 1).Return(nil)
+				return bss
+			},
+			wantResult: ctrl.Result{},
+			wantStatus: status.AtlasBackupSnapshotStatus{
+				ID:              testSnapshotID,
+				Phase:           status.BackupSnapshotPhaseCompleted,
+				ProjectID:       testProjectID,
+				ClusterName:     testClusterName,
+				RetentionInDays: 1,
+			},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.BackupSnapshotReady).WithMessageRegexp("Snapshot 5f4007f327a3bd7b6f4103c5 completed"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:  "reports a failed snapshot without retrying",
+			status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseInProgress, RetentionInDays: 1},
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(
+					&backupsnapshot.Snapshot{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseFailed}, nil,
+				)
+				return bss
+			},
+			wantResult: ctrl.Result{},
+			wantStatus: status.AtlasBackupSnapshotStatus{
+				ID:              testSnapshotID,
+				Phase:           status.BackupSnapshotPhaseFailed,
+				ProjectID:       testProjectID,
+				ClusterName:     testClusterName,
+				RetentionInDays: 1,
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupSnapshotReady).WithReason(string(workflow.BackupSnapshotFailed)).
+					WithMessageRegexp("snapshot 5f4007f327a3bd7b6f4103c5 failed, create a new AtlasBackupSnapshot to take another snapshot"),
+			},
+		},
+		{
+			title:  "reports an expired snapshot without taking it again",
+			status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseCompleted, RetentionInDays: 1},
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(nil, backupsnapshot.ErrNotFound)
+				return bss
+			},
+			wantResult: ctrl.Result{},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupSnapshotReady).WithReason(string(workflow.BackupSnapshotNotFound)).
+					WithMessageRegexp("snapshot 5f4007f327a3bd7b6f4103c5 no longer exists in Atlas, it expired or was deleted"),
+			},
+		},
+		{
+			title: "fails to take a snapshot",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, backupsnapshot.ErrNotFound)
+				bss.EXPECT().Create(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, ErrTestFail)
+				return bss
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupSnapshotNotConfigured)).
+					WithMessageRegexp("failed to take snapshot: failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			snapshot := &akov2.AtlasBackupSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "snapshot",
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(requestedAt),
+				},
+				Spec:   testSpec(),
+				Status: tc.status,
+			}
+			k8sClient := testClient(t, snapshot)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				snapshot:    snapshot,
+				service:     tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantFinalizers, getSnapshot(t, k8sClient, client.ObjectKeyFromObject(snapshot)).GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			gotStatus := status.AtlasBackupSnapshotStatus{}
+			for _, option := range workflowCtx.StatusOptions() {
+				option.(status.AtlasBackupSnapshotStatusOption)(&gotStatus)
+			}
+			if gotStatus.Phase == status.BackupSnapshotPhaseCompleted {
+				assert.NotEmpty(t, gotStatus.CompletedAt)
+				gotStatus.CompletedAt = ""
+			}
+			assert.Equal(t, tc.wantStatus, gotStatus)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		annotations map[string]string
+		service     func() backupsnapshot.SnapshotService
+	}{
+		{
+			title: "deletes the snapshot",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Delete(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(nil)
+				return bss
+			},
+		},
+		{
+			title: "snapshot already gone",
+			service: func() backupsnapshot.SnapshotService {
+				bss := akomock.NewSnapshotServiceMock(t)
+				bss.EXPECT().Delete(mock.Anything, testProjectID, testClusterName, testSnapshotID).Return(backupsnapshot.ErrNotFound)
+				return bss
+			},
+		},
+		{
+			title:       "keeps the snapshot",
+			annotations: map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep},
+			service: func() backupsnapshot.SnapshotService {
+				return akomock.NewSnapshotServiceMock(t)
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			snapshot := &akov2.AtlasBackupSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "snapshot",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec:   testSpec(),
+				Status: status.AtlasBackupSnapshotStatus{ID: testSnapshotID, Phase: status.BackupSnapshotPhaseCompleted},
+			}
+			k8sClient := testClient(t, snapshot)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.delete(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				snapshot:    snapshot,
+				service:     tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getSnapshot(t, k8sClient, client.ObjectKeyFromObject(snapshot)).GetFinalizers())
+		})
+	}
+}
+
+func testSpec() akov2.AtlasBackupSnapshotSpec {
+	return akov2.AtlasBackupSnapshotSpec{
+		DeploymentDualReference: akov2.DeploymentDualReference{
+			DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+		},
+		Description:     "before migration",
+		RetentionInDays: 1,
+	}
+}
+
+func testClient(t *testing.T, snapshot *akov2.AtlasBackupSnapshot) client.Client {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(snapshot).
+		WithStatusSubresource(snapshot).
+		Build()
+}
+
+func getSnapshot(t *testing.T, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasBackupSnapshot {
+	snapshot := &akov2.AtlasBackupSnapshot{}
+	if err := k8sClient.Get(context.Background(), key, snapshot); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return snapshot
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasBackupSnapshotReconciler {
+	return &AtlasBackupSnapshotReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupsnapshot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
+)
+
+func (r *AtlasBackupSnapshotReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.snapshot, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.snapshot, workflow.AtlasFinalizerNotSet, err)
+	}
+	cfg := backupsnapshot.NewSnapshotConfig(req.snapshot)
+	// a snapshot taken by a reconciliation that failed to record it in the status is tracked, not taken twice
+	atlasSnapshot, err := req.service.Find(workflowCtx.Context, req.projectID, req.clusterName, cfg)
+	if err == nil {
+		return r.track(workflowCtx, req, atlasSnapshot)
+	}
+	if !errors.Is(err, backupsnapshot.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to look up snapshot of cluster %s: %w", req.clusterName, err)
+		return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotConfigured, wrappedErr)
+	}
+	atlasSnapshot, err = req.service.Create(workflowCtx.Context, req.projectID, req.clusterName, cfg)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to take snapshot: %w", err)
+		return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotConfigured, wrappedErr)
+	}
+	r.EventRecorder.Eventf(req.snapshot, corev1.EventTypeNormal, string(workflow.BackupSnapshotInProgress),
+		"Started snapshot %s of deployment %s", atlasSnapshot.ID, req.clusterName)
+	return r.track(workflowCtx, req, atlasSnapshot)
+}
+
+func (r *AtlasBackupSnapshotReconciler) track(workflowCtx *workflow.Context, req *reconcileRequest, atlasSnapshot *backupsnapshot.Snapshot) (ctrl.Result, error) {
+	retentionInDays := req.snapshot.Status.RetentionInDays
+	if retentionInDays == 0 {
+		// the snapshot was just taken with the retention of the spec
+		retentionInDays = req.snapshot.Spec.RetentionInDays
+	}
+	workflowCtx.EnsureStatusOption(updateSnapshotStatusOption(req, atlasSnapshot, retentionInDays))
+
+	switch atlasSnapshot.Phase {
+	case status.BackupSnapshotPhaseFailed:
+		if req.snapshot.Status.Phase != status.BackupSnapshotPhaseFailed {
+			r.EventRecorder.Eventf(req.snapshot, corev1.EventTypeWarning, string(workflow.BackupSnapshotFailed),
+				"Snapshot %s failed", atlasSnapshot.ID)
+		}
+		// taking the snapshot again would not honor the point in time it was requested at
+		err := fmt.Errorf("snapshot %s failed, create a new %s to take another snapshot", atlasSnapshot.ID, typeName)
+		result := workflow.Terminate(workflow.BackupSnapshotFailed, err).WithoutRetry()
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupSnapshotReady, result)
+		return result.ReconcileResult()
+	case status.BackupSnapshotPhaseCompleted:
+		return r.completed(workflowCtx, req, atlasSnapshot, retentionInDays)
+	default:
+		msg := fmt.Sprintf("Snapshot %s is %s", atlasSnapshot.ID, atlasSnapshot.Phase)
+		result := workflow.InProgress(workflow.BackupSnapshotInProgress, msg)
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupSnapshotReady, result)
+		return result.ReconcileResult()
+	}
+}
+
+func (r *AtlasBackupSnapshotReconciler) completed(workflowCtx *workflow.Context, req *reconcileRequest, atlasSnapshot *backupsnapshot.Snapshot, retentionInDays int) (ctrl.Result, error) {
+	if wantRetention := req.snapshot.Spec.RetentionInDays; wantRetention != retentionInDays {
+		err := req.service.UpdateRetention(workflowCtx.Context, req.projectID, req.clusterName, atlasSnapshot.ID, wantRetention)
+		if err != nil {
+			wrappedErr := fmt.Errorf("failed to update snapshot retention: %w", err)
+			return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotConfigured, wrappedErr)
+		}
+		updatedSnapshot, err := req.service.Get(workflowCtx.Context, req.projectID, req.clusterName, atlasSnapshot.ID)
+		if err != nil {
+			wrappedErr := fmt.Errorf("failed to get snapshot %s of cluster %s: %w", atlasSnapshot.ID, req.clusterName, err)
+			return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotConfigured, wrappedErr)
+		}
+		workflowCtx.EnsureStatusOption(updateSnapshotStatusOption(req, updatedSnapshot, wantRetention))
+	}
+
+	if req.snapshot.Status.Phase != status.BackupSnapshotPhaseCompleted {
+		r.EventRecorder.Eventf(req.snapshot, corev1.EventTypeNormal, string(workflow.BackupSnapshotCompleted),
+			"Snapshot %s completed", atlasSnapshot.ID)
+	}
+	workflowCtx.SetConditionTrueMsg(api.BackupSnapshotReady, fmt.Sprintf("Snapshot %s completed", atlasSnapshot.ID)).
+		SetConditionTrue(api.ReadyType)
+	return workflow.OK().ReconcileResult()
+}
+
+// gone reports a snapshot that no longer exists in Atlas. It is not taken again,
+// as a new snapshot would not reflect the point in time the original one was requested at.
+func (r *AtlasBackupSnapshotReconciler) gone(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.snapshot, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.snapshot, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	err := fmt.Errorf("snapshot %s no longer exists in Atlas, it expired or was deleted", req.snapshot.Status.ID)
+	result := workflow.Terminate(workflow.BackupSnapshotNotFound, err).WithoutRetry()
+	workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.BackupSnapshotReady, result)
+	return result.ReconcileResult()
+}
+
+func (r *AtlasBackupSnapshotReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.snapshot, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.snapshot)
+	}
+	err := req.service.Delete(workflowCtx.Context, req.projectID, req.clusterName, req.snapshot.Status.ID)
+	if err != nil && !errors.Is(err, backupsnapshot.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to delete snapshot: %w", err)
+		return r.terminate(workflowCtx, req.snapshot, workflow.BackupSnapshotNotDeleted, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.snapshot)
+}
+
+func (r *AtlasBackupSnapshotReconciler) unmanage(workflowCtx *workflow.Context, snapshot *akov2.AtlasBackupSnapshot) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, snapshot, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, snapshot, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasBackupSnapshotReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateSnapshotStatusOption(req *reconcileRequest, atlasSnapshot *backupsnapshot.Snapshot, retentionInDays int) status.AtlasBackupSnapshotStatusOption {
+	return func(snapshotStatus *status.AtlasBackupSnapshotStatus) {
+		snapshotStatus.ProjectID = req.projectID
+		snapshotStatus.ClusterName = req.clusterName
+		snapshotStatus.RetentionInDays = retentionInDays
+		backupsnapshot.ApplySnapshotStatus(snapshotStatus, atlasSnapshot, time.Now())
+	}
+}
@@ -120,6 +120,10 @@ func (r *AtlasCustomRoleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 	workflowCtx.SetConditionTrue(api.ResourceVersionStatus).SetConditionTrue(api.ValidationSucceeded)
 
+	if err := customresource.UnsupportedReconciliationPolicy(atlasCustomRole, "AtlasCustomRole"); err != nil {
+		return r.terminate(workflowCtx, atlasCustomRole, api.ReadyType, workflow.AtlasReconciliationPolicyUnsupported, true, err)
+	}
+
 	if !r.AtlasProvider.IsResourceSupported(atlasCustomRole) {
 		return r.terminate(workflowCtx, atlasCustomRole,
 			api.ProjectCustomRolesReadyType, workflow.AtlasGovUnsupported,
@@ -52,24 +52,27 @@ var ErrOIDCNotEnabled = fmt.Errorf("'OIDCAuthType' field is set but OIDC authent
 // AtlasDatabaseUserReconciler reconciles an AtlasDatabaseUser object
 type AtlasDatabaseUserReconciler struct {
 	reconciler.AtlasReconciler
-	Scheme                      *runtime.Scheme
-	EventRecorder               record.EventRecorder
-	GlobalPredicates            []predicate.Predicate
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
-	independentSyncPeriod       time.Duration
-	maxConcurrentReconciles     int
+	Scheme                       *runtime.Scheme
+	EventRecorder                record.EventRecorder
+	GlobalPredicates             []predicate.Predicate
+	ObjectDeletionProtection     bool
+	SubObjectDeletionProtection  bool
+	DestructiveOperationApproval bool
+	independentSyncPeriod        time.Duration
+	maxConcurrentReconciles      int
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasconnectionsecrettemplates,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasconnectionsecrettemplates,verbs=get;list;watch
 
 func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	atlasDatabaseUser := &akov2.AtlasDatabaseUser{}
@@ -90,6 +93,10 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, atlasDatabaseUser)
 	}()
 
+	if err := customresource.UnsupportedReconciliationPolicy(atlasDatabaseUser, "AtlasDatabaseUser"); err != nil {
+		return r.terminate(workflowCtx, atlasDatabaseUser, api.ReadyType, workflow.AtlasReconciliationPolicyUnsupported, true, err)
+	}
+
 	return r.handleDatabaseUser(workflowCtx, atlasDatabaseUser)
 }
 
@@ -175,6 +182,11 @@ func (r *AtlasDatabaseUserReconciler) ready(ctx *workflow.Context, atlasDatabase
 		EnsureStatusOption(status.AtlasDatabaseUserNameOption(atlasDatabaseUser.Spec.Username)).
 		EnsureStatusOption(status.AtlasDatabaseUserPasswordVersion(passwordVersion))
 
+	if untilRotation, scheduled := r.untilPasswordRotation(ctx.Context, atlasDatabaseUser); scheduled &&
+		(atlasDatabaseUser.Spec.ExternalProjectRef == nil || untilRotation < r.independentSyncPeriod) {
+		return workflow.Requeue(max(untilRotation, time.Second)).ReconcileResult()
+	}
+
 	if atlasDatabaseUser.Spec.ExternalProjectRef != nil {
 		return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
 	}
@@ -200,6 +212,11 @@ func (r *AtlasDatabaseUserReconciler) SetupWithManager(mgr ctrl.Manager, skipNam
 			handler.EnqueueRequestsFromMapFunc(r.databaseUsersForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
+		Watches(
+			&akov2.AtlasConnectionSecretTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.findAtlasDatabaseUsersForConnectionSecretTemplate),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation:      new(skipNameValidation),
@@ -244,6 +261,33 @@ func (r *AtlasDatabaseUserReconciler) findAtlasDatabaseUserForSecret(ctx context
 	return requests
 }
 
+func (r *AtlasDatabaseUserReconciler) findAtlasDatabaseUsersForConnectionSecretTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	template, ok := obj.(*akov2.AtlasConnectionSecretTemplate)
+	if !ok {
+		r.Log.Warnf("watching AtlasConnectionSecretTemplate but got %T", obj)
+		return nil
+	}
+
+	users := &akov2.AtlasDatabaseUserList{}
+	listOps := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasDatabaseUserByConnectionSecretTemplateIndex,
+			client.ObjectKeyFromObject(template).String(),
+		),
+	}
+	err := r.Client.List(ctx, users, listOps)
+	if err != nil {
+		r.Log.Errorf("failed to list AtlasDatabaseUser: %e", err)
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(users.Items))
+	for i := range users.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&users.Items[i])})
+	}
+	return requests
+}
+
 func (r *AtlasDatabaseUserReconciler) databaseUsersForCredentialMapFunc() handler.MapFunc {
 	return indexer.CredentialsIndexMapperFunc(
 		indexer.AtlasDatabaseUserCredentialsIndex,
@@ -533,3 +533,65 @@ func TestFindAtlasDatabaseUserForSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestFindAtlasDatabaseUsersForConnectionSecretTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		obj      client.Object
+		initObjs []client.Object
+		want     []reconcile.Request
+	}{
+		{
+			name: "wrong type",
+			obj:  &akov2.AtlasProject{},
+			want: nil,
+		},
+		{
+			name: "annotated users",
+			obj: &akov2.AtlasConnectionSecretTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "spring", Namespace: "ns"},
+			},
+			initObjs: []client.Object{
+				&akov2.AtlasDatabaseUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "user1",
+						Namespace:   "ns",
+						Annotations: map[string]string{akov2.ConnectionSecretTemplateAnnotation: "spring"},
+					},
+				},
+				&akov2.AtlasDatabaseUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "user2",
+						Namespace:   "other",
+						Annotations: map[string]string{akov2.ConnectionSecretTemplateAnnotation: "spring"},
+					},
+				},
+				&akov2.AtlasDatabaseUser{
+					ObjectMeta: metav1.ObjectMeta{Name: "user3", Namespace: "ns"},
+				},
+			},
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "user1", Namespace: "ns"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			assert.NoError(t, akov2.AddToScheme(testScheme))
+			templatesIndexer := indexer.NewAtlasDatabaseUserByConnectionSecretTemplateIndexer(zaptest.NewLogger(t))
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(tc.initObjs...).
+				WithIndex(templatesIndexer.Object(), templatesIndexer.Name(), templatesIndexer.Keys).
+				Build()
+			reconciler := &AtlasDatabaseUserReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Log:    zaptest.NewLogger(t).Sugar(),
+					Client: k8sClient,
+				},
+			}
+			got := reconciler.findAtlasDatabaseUsersForConnectionSecretTemplate(context.Background(), tc.obj)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
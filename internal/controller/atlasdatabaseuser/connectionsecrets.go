@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/secretservice"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
@@ -82,6 +83,10 @@ func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Cli
 func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project *project.Project, dbUser akov2.AtlasDatabaseUser, conns []deployment.Connection) workflow.DeprecatedResult {
 	requeue := false
 	secrets := make([]string, 0)
+	templates, err := dbUser.ReadConnectionSecretTemplates(ctx.Context, k8sClient)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err)
+	}
 
 	for _, di := range conns {
 		scopes := dbUser.GetScopes(akov2.DeploymentScopeType)
@@ -95,15 +100,18 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 			requeue = true
 			continue
 		}
-		password, err := dbUser.ReadPassword(ctx.Context, k8sClient)
+		atlasUserName, password, err := dbUser.ReadCredentials(ctx.Context, k8sClient)
 		if err != nil {
 			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err)
 		}
 		data := secretservice.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    di.ConnURL,
-			SrvConnURL: di.SrvConnURL,
+			DBUserName:    dbUser.Spec.Username,
+			AtlasUserName: atlasUserName,
+			Password:      password,
+			ConnURL:       di.ConnURL,
+			SrvConnURL:    di.SrvConnURL,
+			AuthDatabase:  dbUser.Spec.DatabaseName,
+			Templates:     templates,
 		}
 		FillPrivateConns(di, &data)
 
@@ -118,6 +126,7 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 	if len(secrets) > 0 {
 		recorder.Eventf(&dbUser, "Normal", ConnectionSecretsEnsuredEvent, "Connection Secrets were created/updated: %s", strings.Join(secrets, ", "))
 	}
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserBindingOption(bindingSecret(secrets, requeue)))
 
 	if err := cleanupStaleSecrets(ctx, k8sClient, project.ID, dbUser); err != nil {
 		return workflow.Terminate(workflow.DatabaseUserStaleConnectionSecrets, err)
@@ -129,6 +138,15 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 	return workflow.OK()
 }
 
+// bindingSecret returns the connection Secret exposed as the Service Binding of the user. The user is a
+// Provisioned Service only when it has a single connection Secret, there is no way to choose between several.
+func bindingSecret(secrets []string, requeue bool) string {
+	if requeue || len(secrets) != 1 {
+		return ""
+	}
+	return secrets[0]
+}
+
 func cleanupStaleSecrets(ctx *workflow.Context, k8sClient client.Client, projectID string, user akov2.AtlasDatabaseUser) error {
 	if err := removeStaleByScope(ctx, k8sClient, projectID, user); err != nil {
 		return err
@@ -163,3 +163,10 @@ func merge(objs ...[]client.Object) []client.Object {
 	}
 	return result
 }
+
+func TestBindingSecret(t *testing.T) {
+	assert.Equal(t, "project-cluster0-app", bindingSecret([]string{"project-cluster0-app"}, false))
+	assert.Empty(t, bindingSecret([]string{"project-cluster0-app"}, true), "deployments still being provisioned may add Secrets")
+	assert.Empty(t, bindingSecret([]string{"project-cluster0-app", "project-cluster1-app"}, false))
+	assert.Empty(t, bindingSecret(nil, false))
+}
@@ -26,7 +26,10 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/approval"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
@@ -50,10 +53,16 @@ func (r *AtlasDatabaseUserReconciler) handleDatabaseUser(ctx *workflow.Context,
 			fmt.Errorf("version of the resource '%s' is higher than the operator version '%s'", atlasDatabaseUser.GetName(), version.Version),
 		)
 	default:
-		ctx.SetConditionTrue(api.ResourceVersionStatus).
-			SetConditionTrue(api.ValidationSucceeded)
+		ctx.SetConditionTrue(api.ResourceVersionStatus)
 	}
 
+	if atlasDatabaseUser.GetDeletionTimestamp().IsZero() {
+		if err = validate.DatabaseUser(atlasDatabaseUser); err != nil {
+			return r.terminate(ctx, atlasDatabaseUser, api.ValidationSucceeded, workflow.DatabaseUserInvalidSpec, false, err)
+		}
+	}
+	ctx.SetConditionTrue(api.ValidationSucceeded)
+
 	if !r.AtlasProvider.IsResourceSupported(atlasDatabaseUser) {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasGovUnsupported, false, fmt.Errorf("the %T is not supported by Atlas for government", atlasDatabaseUser))
 	}
@@ -79,7 +88,11 @@ func (r *AtlasDatabaseUserReconciler) handleDatabaseUser(ctx *workflow.Context,
 func (r *AtlasDatabaseUserReconciler) dbuLifeCycle(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
 	deploymentService deployment.AtlasDeploymentsService, atlasDatabaseUser *akov2.AtlasDatabaseUser,
 	atlasProject *project.Project) (ctrl.Result, error) {
-	databaseUserInAtlas, err := dbUserService.Get(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, atlasProject.ID, atlasDatabaseUser.Spec.Username)
+	username, err := r.activeUsername(ctx.Context, atlasDatabaseUser)
+	if err != nil {
+		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
+	}
+	databaseUserInAtlas, err := dbUserService.Get(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, atlasProject.ID, username)
 	if err != nil && !errors.Is(err, dbuser.ErrorNotFound) {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
 	}
@@ -123,12 +136,12 @@ func (r *AtlasDatabaseUserReconciler) dbuLifeCycle(ctx *workflow.Context, dbUser
 
 func (r *AtlasDatabaseUserReconciler) create(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
 	projectID string, atlasDatabaseUser *akov2.AtlasDatabaseUser) (ctrl.Result, error) {
-	userPassword, passwordVersion, err := r.readPassword(ctx.Context, atlasDatabaseUser)
+	credentials, err := r.ensurePassword(ctx, atlasDatabaseUser)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
 	}
 
-	databaseUserInAKO, err := dbuser.NewUser(atlasDatabaseUser.Spec.DeepCopy(), projectID, userPassword)
+	databaseUserInAKO, err := newAtlasUser(atlasDatabaseUser, projectID, credentials.username, credentials.password)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
 	}
@@ -150,24 +163,37 @@ func (r *AtlasDatabaseUserReconciler) create(ctx *workflow.Context, dbUserServic
 		}
 	}
 
-	return r.inProgress(ctx, atlasDatabaseUser, passwordVersion, "Clusters are scheduled to handle database users updates")
+	return r.inProgress(ctx, atlasDatabaseUser, credentials.version, "Clusters are scheduled to handle database users updates")
 }
 
 func (r *AtlasDatabaseUserReconciler) update(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
 	deploymentService deployment.AtlasDeploymentsService, atlasProject *project.Project,
 	atlasDatabaseUser *akov2.AtlasDatabaseUser, databaseUserInAtlas *dbuser.User) (ctrl.Result, error) {
-	userPassword, passwordVersion, err := r.readPassword(ctx.Context, atlasDatabaseUser)
+	credentials, err := r.ensurePassword(ctx, atlasDatabaseUser)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
 	}
 
-	databaseUserInAKO, err := dbuser.NewUser(atlasDatabaseUser.Spec.DeepCopy(), atlasProject.ID, userPassword)
+	databaseUserInAKO, err := newAtlasUser(atlasDatabaseUser, atlasProject.ID, credentials.username, credentials.password)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
 	}
 
-	if !hasChanged(databaseUserInAKO, databaseUserInAtlas, atlasDatabaseUser.Status.PasswordVersion, passwordVersion) {
-		return r.readiness(ctx, deploymentService, atlasProject, atlasDatabaseUser, passwordVersion)
+	if !hasChanged(databaseUserInAKO, databaseUserInAtlas, atlasDatabaseUser.Status.PasswordVersion, credentials.version) {
+		if err = r.retireUser(ctx, dbUserService, atlasProject.ID, atlasDatabaseUser, credentials); err != nil {
+			return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserNotDeletedInAtlas, true, err)
+		}
+
+		if isPasswordRotationDue(atlasDatabaseUser.Spec.PasswordPolicy, credentials, time.Now()) {
+			passwordVersion, err := r.startPasswordRotation(ctx, dbUserService, atlasProject.ID, atlasDatabaseUser, credentials)
+			if err != nil {
+				return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserNotUpdatedInAtlas, true, err)
+			}
+
+			return r.inProgress(ctx, atlasDatabaseUser, passwordVersion, "Clusters are scheduled to handle the rotated password")
+		}
+
+		return r.readiness(ctx, deploymentService, atlasProject, atlasDatabaseUser, credentials)
 	}
 
 	r.Log.Debug(dbuser.DiffSpecs(databaseUserInAKO, databaseUserInAtlas))
@@ -176,7 +202,7 @@ func (r *AtlasDatabaseUserReconciler) update(ctx *workflow.Context, dbUserServic
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserNotUpdatedInAtlas, true, err)
 	}
 
-	return r.inProgress(ctx, atlasDatabaseUser, passwordVersion, "Clusters are scheduled to handle database users updates")
+	return r.inProgress(ctx, atlasDatabaseUser, credentials.version, "Clusters are scheduled to handle database users updates")
 }
 
 func (r *AtlasDatabaseUserReconciler) delete(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService, projectID string, atlasDatabaseUser *akov2.AtlasDatabaseUser) (ctrl.Result, error) {
@@ -186,7 +212,23 @@ func (r *AtlasDatabaseUserReconciler) delete(ctx *workflow.Context, dbUserServic
 		return r.unmanage(ctx, projectID, atlasDatabaseUser)
 	}
 
-	err := dbUserService.Delete(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, projectID, atlasDatabaseUser.Spec.Username)
+	operation := fmt.Sprintf("delete database user %s", atlasDatabaseUser.Spec.Username)
+	approvalStatus, approved := approval.Check(r.EventRecorder, atlasDatabaseUser, r.DestructiveOperationApproval, atlasDatabaseUser.Status.Approval, operation)
+	if approvalStatus != nil || atlasDatabaseUser.Status.Approval != nil {
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserApprovalOption(approvalStatus))
+	}
+	if !approved {
+		result := approval.Terminate(approvalStatus)
+		ctx.SetConditionFromResult(api.DatabaseUserReadyType, result)
+		return result.ReconcileResult()
+	}
+
+	username, err := r.activeUsername(ctx.Context, atlasDatabaseUser)
+	if err != nil {
+		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
+	}
+
+	err = dbUserService.Delete(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, projectID, username)
 	if err != nil {
 		if !errors.Is(err, dbuser.ErrorNotFound) {
 			return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserNotDeletedInAtlas, true, err)
@@ -195,11 +237,19 @@ func (r *AtlasDatabaseUserReconciler) delete(ctx *workflow.Context, dbUserServic
 		r.Log.Info("Database user doesn't exist or is already deleted")
 	}
 
+	// the standby user of a rotated password is deleted along
+	if rotatesPassword(atlasDatabaseUser.Spec.PasswordPolicy) || atlasDatabaseUser.Status.RetiringUserName != "" {
+		err = dbUserService.Delete(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, projectID, standbyUsername(atlasDatabaseUser, username))
+		if err != nil && !errors.Is(err, dbuser.ErrorNotFound) {
+			return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserNotDeletedInAtlas, true, err)
+		}
+	}
+
 	return r.unmanage(ctx, projectID, atlasDatabaseUser)
 }
 
 func (r *AtlasDatabaseUserReconciler) readiness(ctx *workflow.Context, deploymentService deployment.AtlasDeploymentsService,
-	atlasProject *project.Project, atlasDatabaseUser *akov2.AtlasDatabaseUser, passwordVersion string) (ctrl.Result, error) {
+	atlasProject *project.Project, atlasDatabaseUser *akov2.AtlasDatabaseUser, credentials *userCredentials) (ctrl.Result, error) {
 	allDeploymentNames, err := deploymentService.ListDeploymentNames(ctx.Context, atlasProject.ID)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
@@ -238,11 +288,19 @@ func (r *AtlasDatabaseUserReconciler) readiness(ctx *workflow.Context, deploymen
 		return r.inProgress(
 			ctx,
 			atlasDatabaseUser,
-			passwordVersion,
+			credentials.version,
 			fmt.Sprintf("%d out of %d deployments have applied database user changes", readyDeployments, len(deploymentsToCheck)),
 		)
 	}
 
+	passwordVersion := credentials.version
+	if credentials.pendingPassword != "" {
+		// every deployment accepts the rotated password of the standby user, the connection secrets switch to it
+		if passwordVersion, err = r.completePasswordRotation(ctx, atlasDatabaseUser, credentials); err != nil {
+			return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
+		}
+	}
+
 	// TODO refactor connectionsecret package to follow state machine approach
 	result := CreateOrUpdateConnectionSecrets(ctx, r.Client, deploymentService, r.EventRecorder, atlasProject, *atlasDatabaseUser)
 	if !result.IsOk() {
@@ -288,17 +346,33 @@ func (r *AtlasDatabaseUserReconciler) areDeploymentScopesValid(ctx *workflow.Con
 }
 
 func (r *AtlasDatabaseUserReconciler) removeOldUser(ctx context.Context, dbUserService dbuser.AtlasUsersService, projectID string, atlasDatabaseUser *akov2.AtlasDatabaseUser) error {
+	oldUserNames := []string{atlasDatabaseUser.Status.UserName}
+	if atlasDatabaseUser.Spec.PasswordPolicy != nil {
+		// the password may have been rotated to the standby user of the old name
+		oldUserNames = append(oldUserNames, atlasDatabaseUser.Status.UserName+akov2.StandbyUsernameSuffix)
+	}
+
+	for _, oldUserName := range oldUserNames {
+		if err := r.removeUser(ctx, dbUserService, projectID, atlasDatabaseUser.Spec.DatabaseName, oldUserName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AtlasDatabaseUserReconciler) removeUser(ctx context.Context, dbUserService dbuser.AtlasUsersService, projectID, databaseName, userName string) error {
 	deleteAttempts := 3
 	var err error
 	for i := 1; i <= deleteAttempts; i++ {
-		err = dbUserService.Delete(ctx, atlasDatabaseUser.Spec.DatabaseName, projectID, atlasDatabaseUser.Status.UserName)
+		err = dbUserService.Delete(ctx, databaseName, projectID, userName)
 		if err == nil || errors.Is(err, dbuser.ErrorNotFound) {
 			return nil
 		}
 
 		// There may be some rare errors due to the databaseName change or maybe the user has already been removed - this
 		// is not-critical (the stale connection secret has already been removed) and we shouldn't retry to avoid infinite retries
-		r.Log.Errorf("Failed to remove user %s from Atlas (attempt %d/%d): %s", atlasDatabaseUser.Status.UserName, i, deleteAttempts, err)
+		r.Log.Errorf("Failed to remove user %s from Atlas (attempt %d/%d): %s", userName, i, deleteAttempts, err)
 	}
 
 	return err
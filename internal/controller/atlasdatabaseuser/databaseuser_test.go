@@ -143,6 +143,33 @@ func TestHandleDatabaseUser(t *testing.T) {
 					WithMessageRegexp("the *v1.AtlasDatabaseUser is not supported by Atlas for government"),
 			},
 		},
+		"user spec fails validation": {
+			dbUserInAKO: &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user1",
+					Namespace: "default",
+					Labels: map[string]string{
+						"mongodb.com/atlas-resource-version": "2.4.1",
+					},
+				},
+				Spec: akov2.AtlasDatabaseUserSpec{
+					Username: "user1",
+					PasswordSecret: &common.ResourceRef{
+						Name: "user-pass",
+					},
+					DatabaseName: "admin",
+					X509Type:     "MANAGED",
+				},
+			},
+			atlasProvider:  &atlasmock.TestProvider{},
+			expectedResult: ctrl.Result{},
+			expectedConditions: []api.Condition{
+				api.TrueCondition(api.ResourceVersionStatus),
+				api.FalseCondition(api.ValidationSucceeded).
+					WithReason(string(workflow.DatabaseUserInvalidSpec)).
+					WithMessageRegexp("passwordSecretRef cannot be used together with x509Type, awsIamType or oidcAuthType"),
+			},
+		},
 		"manage user with independent configuration": {
 			dbUserInAKO: &akov2.AtlasDatabaseUser{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1757,7 +1784,7 @@ func TestReadiness(t *testing.T) {
 				Log:     logger,
 			}
 
-			result, err := r.readiness(ctx, tt.dService(), &project.Project{}, tt.dbUser, "999")
+			result, err := r.readiness(ctx, tt.dService(), &project.Project{}, tt.dbUser, &userCredentials{version: "999"})
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -0,0 +1,343 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdatabaseuser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sethvargo/go-password/password"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
+)
+
+const (
+	PasswordGeneratedEvent       = "PasswordGenerated"
+	PasswordRotationStartedEvent = "PasswordRotationStarted"
+	PasswordRotatedEvent         = "PasswordRotated"
+	DatabaseUserRetiredEvent     = "DatabaseUserRetired"
+
+	// PasswordRotatedAtAnnotation records when the operator generated the password held by a Secret
+	PasswordRotatedAtAnnotation = "atlas.mongodb.com/password-rotated-at"
+
+	passwordKey        = "password"
+	pendingPasswordKey = "pendingPassword"
+
+	defaultPasswordLength      = 32
+	defaultRotationGracePeriod = time.Hour
+)
+
+// userCredentials are the credentials read from the password Secret of the user.
+type userCredentials struct {
+	// username is the Atlas user authenticating with the password
+	username string
+	password string
+	// version is the resource version of the password Secret
+	version   string
+	rotatedAt time.Time
+	// pendingPassword is the password sent to the standby user by a rotation in progress
+	pendingPassword string
+}
+
+// ensurePassword returns the credentials of the user read from its password Secret.
+// With a password policy, the password is generated when the Secret doesn't exist yet or holds no password.
+// Rotations don't replace the password of the Secret in place, see startPasswordRotation.
+func (r *AtlasDatabaseUserReconciler) ensurePassword(ctx *workflow.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) (*userCredentials, error) {
+	policy := atlasDatabaseUser.Spec.PasswordPolicy
+	if policy == nil {
+		userPassword, passwordVersion, err := r.readPassword(ctx.Context, atlasDatabaseUser)
+		if err != nil {
+			return nil, err
+		}
+		username, err := r.activeUsername(ctx.Context, atlasDatabaseUser)
+		if err != nil {
+			return nil, err
+		}
+		return &userCredentials{username: username, password: userPassword, version: passwordVersion}, nil
+	}
+
+	key := *atlasDatabaseUser.PasswordSecretObjectKey()
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx.Context, key, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch {
+	case apierrors.IsNotFound(err):
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		if err = setGeneratedPassword(secret, atlasDatabaseUser.Spec.Username, policy, now); err != nil {
+			return nil, err
+		}
+		if err = r.Client.Create(ctx.Context, secret); err != nil {
+			return nil, fmt.Errorf("failed to create password secret %s: %w", key, err)
+		}
+		r.EventRecorder.Eventf(atlasDatabaseUser, corev1.EventTypeNormal, PasswordGeneratedEvent, "Generated the password into Secret %s", key.Name)
+	case len(secret.Data[passwordKey]) == 0:
+		if err = setGeneratedPassword(secret, atlasDatabaseUser.Spec.Username, policy, now); err != nil {
+			return nil, err
+		}
+		if err = r.Client.Update(ctx.Context, secret); err != nil {
+			return nil, fmt.Errorf("failed to generate password in secret %s: %w", key, err)
+		}
+		r.EventRecorder.Eventf(atlasDatabaseUser, corev1.EventTypeNormal, PasswordGeneratedEvent, "Generated the password into Secret %s", key.Name)
+	case string(secret.Data[akov2.PasswordSecretUsernameKey]) != atlasDatabaseUser.PasswordUsername(secret):
+		// adopted Secrets and Secrets of renamed users record the user the password belongs to,
+		// a rotation started for the previous username is abandoned
+		secret.Data[akov2.PasswordSecretUsernameKey] = []byte(atlasDatabaseUser.PasswordUsername(secret))
+		delete(secret.Data, pendingPasswordKey)
+		if err = r.Client.Update(ctx.Context, secret); err != nil {
+			return nil, fmt.Errorf("failed to update password secret %s: %w", key, err)
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotatedAt(passwordRotatedAt(secret).Format(time.RFC3339)))
+
+	return credentialsFromSecret(atlasDatabaseUser, secret), nil
+}
+
+// activeUsername returns the Atlas user authenticating with the password of the password Secret.
+func (r *AtlasDatabaseUserReconciler) activeUsername(ctx context.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) (string, error) {
+	if atlasDatabaseUser.Spec.PasswordSecret == nil {
+		return atlasDatabaseUser.Spec.Username, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, *atlasDatabaseUser.PasswordSecretObjectKey(), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return atlasDatabaseUser.Spec.Username, nil
+		}
+		return "", err
+	}
+
+	return atlasDatabaseUser.PasswordUsername(secret), nil
+}
+
+// startPasswordRotation sends a new password to the standby user, created if needed, and keeps it in the password
+// Secret as the pending password. The active user and the connection Secrets keep the current password until every
+// deployment applied the new one, see completePasswordRotation. Returns the version of the password Secret.
+func (r *AtlasDatabaseUserReconciler) startPasswordRotation(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
+	projectID string, atlasDatabaseUser *akov2.AtlasDatabaseUser, credentials *userCredentials) (string, error) {
+	pendingPassword, err := generatePassword(atlasDatabaseUser.Spec.PasswordPolicy)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+
+	standbyUsername := standbyUsername(atlasDatabaseUser, credentials.username)
+	standbyUser, err := newAtlasUser(atlasDatabaseUser, projectID, standbyUsername, pendingPassword)
+	if err != nil {
+		return "", err
+	}
+	existing, err := dbUserService.Get(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, projectID, standbyUsername)
+	if err != nil && !errors.Is(err, dbuser.ErrorNotFound) {
+		return "", err
+	}
+	if existing == nil {
+		err = dbUserService.Create(ctx.Context, standbyUser)
+	} else {
+		err = dbUserService.Update(ctx.Context, standbyUser)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to set the rotated password of database user %s: %w", standbyUsername, err)
+	}
+
+	// the pending password is only recorded once Atlas has it, a failed attempt is started over with a new password
+	secret := &corev1.Secret{}
+	if err = r.Client.Get(ctx.Context, *atlasDatabaseUser.PasswordSecretObjectKey(), secret); err != nil {
+		return "", err
+	}
+	secret.Data[pendingPasswordKey] = []byte(pendingPassword)
+	if err = r.Client.Update(ctx.Context, secret); err != nil {
+		return "", fmt.Errorf("failed to record the rotated password in secret %s: %w", secret.Name, err)
+	}
+	r.EventRecorder.Eventf(atlasDatabaseUser, corev1.EventTypeNormal, PasswordRotationStartedEvent,
+		"Sent the rotated password to database user %s, %s is used until deployments apply it", standbyUsername, credentials.username)
+
+	return secret.ResourceVersion, nil
+}
+
+// completePasswordRotation switches the password Secret to the standby user, once every deployment applied its
+// password. The user holding the replaced password is retired after the grace period, see retireUser.
+// Returns the version of the password Secret.
+func (r *AtlasDatabaseUserReconciler) completePasswordRotation(ctx *workflow.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser,
+	credentials *userCredentials) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx.Context, *atlasDatabaseUser.PasswordSecretObjectKey(), secret); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	standbyUsername := standbyUsername(atlasDatabaseUser, credentials.username)
+	secret.Data[akov2.PasswordSecretUsernameKey] = []byte(standbyUsername)
+	secret.Data[passwordKey] = []byte(credentials.pendingPassword)
+	delete(secret.Data, pendingPasswordKey)
+	setPasswordRotatedAt(secret, now)
+	if err := r.Client.Update(ctx.Context, secret); err != nil {
+		return "", fmt.Errorf("failed to rotate password in secret %s: %w", secret.Name, err)
+	}
+	r.EventRecorder.Eventf(atlasDatabaseUser, corev1.EventTypeNormal, PasswordRotatedEvent,
+		"Rotated the password in Secret %s: database user %s replaces %s", secret.Name, standbyUsername, credentials.username)
+
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotatedAt(now.UTC().Format(time.RFC3339))).
+		EnsureStatusOption(status.AtlasDatabaseUserRetiringNameOption(credentials.username))
+
+	return secret.ResourceVersion, nil
+}
+
+// retireUser deletes the Atlas user holding the password replaced by the last rotation, once the grace period elapsed.
+func (r *AtlasDatabaseUserReconciler) retireUser(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
+	projectID string, atlasDatabaseUser *akov2.AtlasDatabaseUser, credentials *userCredentials) error {
+	retiring := atlasDatabaseUser.Status.RetiringUserName
+	if retiring == "" || retiring == credentials.username || time.Now().Before(credentials.rotatedAt.Add(rotationGracePeriod(atlasDatabaseUser.Spec.PasswordPolicy))) {
+		return nil
+	}
+
+	err := dbUserService.Delete(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, projectID, retiring)
+	if err != nil && !errors.Is(err, dbuser.ErrorNotFound) {
+		return fmt.Errorf("failed to retire database user %s: %w", retiring, err)
+	}
+	r.EventRecorder.Eventf(atlasDatabaseUser, corev1.EventTypeNormal, DatabaseUserRetiredEvent,
+		"Deleted database user %s holding the password replaced by the last rotation", retiring)
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserRetiringNameOption(""))
+
+	return nil
+}
+
+// untilPasswordRotation returns the time left before the next step of the password rotation:
+// the retirement of the user holding the replaced password, or the next rotation.
+// Returns false when the password is never rotated.
+func (r *AtlasDatabaseUserReconciler) untilPasswordRotation(ctx context.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) (time.Duration, bool) {
+	policy := atlasDatabaseUser.Spec.PasswordPolicy
+	if !rotatesPassword(policy) {
+		return 0, false
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, *atlasDatabaseUser.PasswordSecretObjectKey(), secret); err != nil {
+		r.Log.Debugw("Unable to schedule the password rotation", "error", err)
+		return 0, false
+	}
+
+	rotatedAt := passwordRotatedAt(secret)
+	if untilRetirement := time.Until(rotatedAt.Add(rotationGracePeriod(policy))); untilRetirement > 0 {
+		return untilRetirement, true
+	}
+	return time.Until(rotatedAt.Add(policy.RotationInterval.Duration)), true
+}
+
+func rotatesPassword(policy *akov2.PasswordPolicy) bool {
+	return policy != nil && policy.RotationInterval != nil && policy.RotationInterval.Duration > 0
+}
+
+func isPasswordRotationDue(policy *akov2.PasswordPolicy, credentials *userCredentials, now time.Time) bool {
+	if !rotatesPassword(policy) || credentials.pendingPassword != "" {
+		return false
+	}
+
+	return !now.Before(credentials.rotatedAt.Add(policy.RotationInterval.Duration))
+}
+
+func rotationGracePeriod(policy *akov2.PasswordPolicy) time.Duration {
+	if policy == nil || policy.RotationGracePeriod == nil {
+		return defaultRotationGracePeriod
+	}
+
+	return policy.RotationGracePeriod.Duration
+}
+
+// standbyUsername returns the Atlas user alternating with the given active user on password rotation.
+func standbyUsername(atlasDatabaseUser *akov2.AtlasDatabaseUser, activeUsername string) string {
+	if activeUsername == atlasDatabaseUser.StandbyUsername() {
+		return atlasDatabaseUser.Spec.Username
+	}
+
+	return atlasDatabaseUser.StandbyUsername()
+}
+
+// newAtlasUser returns the Atlas user with the given name, configured according to the spec.
+func newAtlasUser(atlasDatabaseUser *akov2.AtlasDatabaseUser, projectID, username, userPassword string) (*dbuser.User, error) {
+	spec := atlasDatabaseUser.Spec.DeepCopy()
+	spec.Username = username
+
+	return dbuser.NewUser(spec, projectID, userPassword)
+}
+
+func credentialsFromSecret(atlasDatabaseUser *akov2.AtlasDatabaseUser, secret *corev1.Secret) *userCredentials {
+	return &userCredentials{
+		username:        atlasDatabaseUser.PasswordUsername(secret),
+		password:        string(secret.Data[passwordKey]),
+		version:         secret.ResourceVersion,
+		rotatedAt:       passwordRotatedAt(secret),
+		pendingPassword: string(secret.Data[pendingPasswordKey]),
+	}
+}
+
+// passwordRotatedAt returns when the password of the Secret was generated.
+// Secrets created by users are considered generated when they were created.
+func passwordRotatedAt(secret *corev1.Secret) time.Time {
+	if rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[PasswordRotatedAtAnnotation]); err == nil {
+		return rotatedAt
+	}
+
+	return secret.CreationTimestamp.Time
+}
+
+// setGeneratedPassword sets a new password of the given Atlas user to the Secret.
+func setGeneratedPassword(secret *corev1.Secret, username string, policy *akov2.PasswordPolicy, now time.Time) error {
+	generated, err := generatePassword(policy)
+	if err != nil {
+		return fmt.Errorf("failed to generate password: %w", err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[akov2.PasswordSecretUsernameKey] = []byte(username)
+	secret.Data[passwordKey] = []byte(generated)
+	delete(secret.Data, pendingPasswordKey)
+	setPasswordRotatedAt(secret, now)
+
+	return nil
+}
+
+func setPasswordRotatedAt(secret *corev1.Secret, now time.Time) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[PasswordRotatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+}
+
+func generatePassword(policy *akov2.PasswordPolicy) (string, error) {
+	length := policy.Length
+	if length == 0 {
+		length = defaultPasswordLength
+	}
+
+	return password.Generate(length, policy.Digits, policy.Symbols, policy.NoUpper, true)
+}
@@ -69,7 +69,7 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(ctx.Context, r.Client)
+		atlasUserName, password, err := dbUser.ReadCredentials(ctx.Context, r.Client)
 		if err != nil {
 			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err)
 		}
@@ -77,7 +77,7 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 		var connURLs []string
 		for _, host := range connectionHosts {
 			baseURL := fmt.Sprintf("mongodb://%s?ssl=true", host)
-			connURL, err := secretservice.AddCredentialsToConnectionURL(baseURL, dbUser.Spec.Username, password)
+			connURL, err := secretservice.AddCredentialsToConnectionURL(baseURL, atlasUserName, password)
 			if err != nil {
 				ctx.Log.Debugw("Failed to construct connection URL", "host", host, "error", err)
 				continue
@@ -86,10 +86,18 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 			ctx.Log.Debugw("Connection URL created", "host", host)
 		}
 
+		templates, err := dbUser.ReadConnectionSecretTemplates(ctx.Context, r.Client)
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err)
+		}
+
 		data := secretservice.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    strings.Join(connURLs, ","),
+			DBUserName:    dbUser.Spec.Username,
+			AtlasUserName: atlasUserName,
+			Password:      password,
+			ConnURL:       strings.Join(connURLs, ","),
+			AuthDatabase:  dbUser.Spec.DatabaseName,
+			Templates:     templates,
 		}
 
 		ctx.Log.Debugw("Creating a connection Secret", "dbUser", data.DBUserName)
@@ -100,6 +100,11 @@ func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req c
 		r.Log.Debugf("AtlasDataFederation validation result: %v", resourceVersionIsValid)
 		return resourceVersionIsValid.ReconcileResult()
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(ctx, dataFederation, "AtlasDataFederation")
+	if !policyIsValid.IsOk() {
+		r.Log.Debugf("AtlasDataFederation reconciliation policy validation result: %v", policyIsValid)
+		return policyIsValid.ReconcileResult()
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(dataFederation) {
 		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasDataFederation is not supported by Atlas for government")).
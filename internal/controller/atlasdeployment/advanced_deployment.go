@@ -39,8 +39,9 @@ import (
 
 const FreeTier = "M0"
 
-func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Context, projectService project.ProjectService, deploymentService deployment.AtlasDeploymentsService, akoDeployment, atlasDeployment deployment.Deployment) (ctrl.Result, error) {
-	if akoDeployment.GetCustomResource().Spec.UpgradeToDedicated && !atlasDeployment.IsDedicated() {
+func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Context, projectService project.ProjectService, deploymentService deployment.AtlasDeploymentsService, akoDeployment, atlasDeployment deployment.Deployment, window *changeWindowState) (ctrl.Result, error) {
+	detectOnly := customresource.ReconciliationIsDetectOnly(akoDeployment.GetCustomResource())
+	if akoDeployment.GetCustomResource().Spec.UpgradeToDedicated && !atlasDeployment.IsDedicated() && !detectOnly {
 		if atlasDeployment.GetState() == status.StateUPDATING {
 			return r.inProgress(ctx, akoDeployment.GetCustomResource(), atlasDeployment, workflow.DeploymentUpdating, "deployment is updating")
 		}
@@ -65,6 +66,9 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Conte
 	}
 
 	if atlasCluster == nil {
+		if detectOnly {
+			return r.detectOnlyNotFound(ctx, akoCluster)
+		}
 		ctx.Log.Infof("Advanced Deployment %s doesn't exist in Atlas - creating", akoCluster.GetName())
 		newDeployment, err := deploymentService.CreateDeployment(ctx.Context, akoCluster)
 		if err != nil {
@@ -76,7 +80,19 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Conte
 
 	switch atlasCluster.GetState() {
 	case status.StateIDLE:
-		if changes, occurred := deployment.ComputeChanges(akoCluster, atlasCluster); occurred {
+		if detectOnly {
+			if _, occurred := deployment.ComputeChanges(akoCluster, atlasCluster); occurred {
+				return r.detectOnly(ctx, projectService, akoCluster, atlasCluster, akoCluster.AdvancedDeploymentSpec, atlasCluster.AdvancedDeploymentSpec)
+			}
+			return r.detectOnly(ctx, projectService, akoCluster, atlasCluster, nil, nil)
+		}
+
+		if result, approved := r.approve(ctx, akoCluster.GetCustomResource(), updateOperation(akoCluster, atlasCluster)); !approved {
+			return result.ReconcileResult()
+		}
+
+		desiredCluster := r.holdDisruptiveChanges(ctx, window, akoCluster, atlasCluster)
+		if changes, occurred := deployment.ComputeChanges(desiredCluster, atlasCluster); occurred {
 			updatedDeployment, err := deploymentService.UpdateDeployment(ctx.Context, changes)
 			if err != nil {
 				return r.terminate(ctx, workflow.DeploymentNotUpdatedInAtlas, err)
@@ -181,16 +197,24 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(ctx.Context, r.Client)
+		atlasUserName, password, err := dbUser.ReadCredentials(ctx.Context, r.Client)
+		if err != nil {
+			return err
+		}
+
+		templates, err := dbUser.ReadConnectionSecretTemplates(ctx.Context, r.Client)
 		if err != nil {
 			return err
 		}
 
 		data := secretservice.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    connection.Standard,
-			SrvConnURL: connection.StandardSrv,
+			DBUserName:    dbUser.Spec.Username,
+			AtlasUserName: atlasUserName,
+			Password:      password,
+			ConnURL:       connection.Standard,
+			SrvConnURL:    connection.StandardSrv,
+			AuthDatabase:  dbUser.Spec.DatabaseName,
+			Templates:     templates,
 		}
 		if connection.Private != "" {
 			data.PrivateConnURLs = append(data.PrivateConnURLs, secretservice.PrivateLinkConnURLs{
@@ -224,6 +248,13 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 		r.EventRecorder.Eventf(deploymentInAKO.GetCustomResource(), "Normal", "ConnectionSecretsEnsured", "Connection Secrets were created/updated: %s", strings.Join(secrets, ", "))
 	}
 
+	// the deployment is a Provisioned Service only when a single database user connects to it
+	binding := ""
+	if len(secrets) == 1 {
+		binding = secrets[0]
+	}
+	ctx.EnsureStatusOption(status.AtlasDeploymentBindingOption(binding))
+
 	return nil
 }
 
@@ -975,7 +975,7 @@ func TestHandleAdvancedDeployment(t *testing.T) {
 
 			deploymentInAKO := deployment.NewDeployment("project-id", tt.atlasDeployment).(*deployment.Cluster)
 			var projectService project.ProjectService // nil projetc service
-			result, err := reconciler.handleAdvancedDeployment(ctx, projectService, tt.deploymentService(), deploymentInAKO, tt.deploymentInAtlas)
+			result, err := reconciler.handleAdvancedDeployment(ctx, projectService, tt.deploymentService(), deploymentInAKO, tt.deploymentInAtlas, nil)
 			//require.NoError(t, err)
 			assert.Equal(t, tt.expectedResult, workflowRes{
 				res: result,
@@ -0,0 +1,56 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/approval"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+// approve records the approval of the destructive operation in the status and returns whether it may proceed.
+// Otherwise, the returned result stops the reconciliation until the operation is approved.
+func (r *AtlasDeploymentReconciler) approve(ctx *workflow.Context, atlasDeployment *akov2.AtlasDeployment, operation string) (workflow.DeprecatedResult, bool) {
+	approvalStatus, approved := approval.Check(r.EventRecorder, atlasDeployment, r.DestructiveOperationApproval, atlasDeployment.Status.Approval, operation)
+	if approvalStatus != nil || atlasDeployment.Status.Approval != nil {
+		ctx.EnsureStatusOption(status.AtlasDeploymentApprovalOption(approvalStatus))
+	}
+	if approved {
+		return workflow.OK(), true
+	}
+
+	result := approval.Terminate(approvalStatus)
+	ctx.SetConditionFromResult(api.DeploymentReadyType, result)
+	return result, false
+}
+
+func deleteOperation(name string) string {
+	return fmt.Sprintf("delete deployment %s", name)
+}
+
+// updateOperation describes the destructive changes of the update, it is empty if there are none.
+func updateOperation(desired, current *deployment.Cluster) string {
+	changes := deployment.DestructiveChanges(desired, current)
+	if len(changes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("update deployment %s: %s", desired.GetName(), strings.Join(changes, ", "))
+}
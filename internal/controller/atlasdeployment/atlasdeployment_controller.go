@@ -33,15 +33,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/secretservice"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
@@ -49,6 +52,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
@@ -57,13 +61,17 @@ import (
 // AtlasDeploymentReconciler reconciles an AtlasDeployment object
 type AtlasDeploymentReconciler struct {
 	reconciler.AtlasReconciler
-	Scheme                      *runtime.Scheme
-	GlobalPredicates            []predicate.Predicate
-	EventRecorder               record.EventRecorder
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
-	independentSyncPeriod       time.Duration
-	maxConcurrentReconciles     int
+	Scheme                       *runtime.Scheme
+	GlobalPredicates             []predicate.Predicate
+	EventRecorder                record.EventRecorder
+	ObjectDeletionProtection     bool
+	SubObjectDeletionProtection  bool
+	DestructiveOperationApproval bool
+	DeletionGracePeriod          time.Duration
+	FinalSnapshotRetentionDays   int
+	AtlasNotifications           <-chan event.GenericEvent
+	independentSyncPeriod        time.Duration
+	maxConcurrentReconciles      int
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -117,6 +125,10 @@ func (r *AtlasDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, atlasDeployment)
 	}()
 
+	if !customresource.ReconciliationIsDetectOnly(atlasDeployment) {
+		r.clearDrift(workflowCtx, atlasDeployment)
+	}
+
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, atlasDeployment, r.Log)
 	if !resourceVersionIsValid.IsOk() {
 		r.Log.Debugf("deployment validation result: %v", resourceVersionIsValid)
@@ -151,6 +163,13 @@ func (r *AtlasDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		workflowCtx.SetConditionFromResult(api.ValidationSucceeded, result)
 		return result.ReconcileResult()
 	}
+	if atlasDeployment.GetDeletionTimestamp().IsZero() {
+		if err := policy.CheckDeployment(workflowCtx.Context, r.Client, atlasDeployment); err != nil {
+			result = policy.Terminate(err)
+			workflowCtx.SetConditionFromResult(api.ValidationSucceeded, result)
+			return result.ReconcileResult()
+		}
+	}
 	workflowCtx.SetConditionTrue(api.ValidationSucceeded)
 
 	deploymentInAKO := deployment.NewDeployment(atlasProject.ID, atlasDeployment)
@@ -179,7 +198,15 @@ func (r *AtlasDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return r.handleFlexInstance(workflowCtx, projectService, deploymentService, deploymentInAKO, deploymentInAtlas)
 
 	case atlasDeployment.IsAdvancedDeployment():
-		return r.handleAdvancedDeployment(workflowCtx, projectService, deploymentService, deploymentInAKO, deploymentInAtlas)
+		schedule, err := r.applySchedules(workflowCtx, deploymentInAKO, time.Now())
+		if err != nil {
+			return r.terminate(workflowCtx, workflow.Internal, err)
+		}
+		window, err := r.evaluateChangeWindows(workflowCtx.Context, atlasDeployment, schedule, time.Now())
+		if err != nil {
+			return r.terminate(workflowCtx, workflow.Internal, err)
+		}
+		return window.requeue(schedule.requeue(r.handleAdvancedDeployment(workflowCtx, projectService, deploymentService, deploymentInAKO, deploymentInAtlas, window)))
 	}
 
 	return workflow.OK().ReconcileResult()
@@ -191,25 +218,36 @@ func (r *AtlasDeploymentReconciler) delete(
 	deploymentInAKO deployment.Deployment, // this must be the original non converted deployment
 	deploymentInAtlas deployment.Deployment, // this must be the original non converted deployment
 ) (ctrl.Result, error) {
-	if err := r.cleanupBindings(ctx.Context, deploymentInAKO); err != nil {
-		return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to cleanup deployment bindings (backups): %w", err))
-	}
-
 	switch {
 	case customresource.IsResourcePolicyKeepOrDefault(deploymentInAKO.GetCustomResource(), r.ObjectDeletionProtection):
 		ctx.Log.Info("Not removing Atlas deployment from Atlas as per configuration")
 	case customresource.IsResourcePolicyKeep(deploymentInAKO.GetCustomResource()):
 		ctx.Log.Infof("Not removing Atlas deployment from Atlas as the '%s' annotation is set", customresource.ResourcePolicyAnnotation)
+	case customresource.ReconciliationIsDetectOnly(deploymentInAKO.GetCustomResource()):
+		ctx.Log.Infof("Not removing Atlas deployment from Atlas as the '%s' annotation is set to %s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicyDetectOnly)
 	case isTerminationProtectionEnabled(deploymentInAKO.GetCustomResource()):
 		msg := fmt.Sprintf("Termination protection for %s deployment enabled. Deployment in Atlas won't be removed", deploymentInAKO.GetName())
 		ctx.Log.Info(msg)
 		r.EventRecorder.Event(deploymentInAKO.GetCustomResource(), "Warning", "AtlasDeploymentTermination", msg)
 	default:
+		snapshotService := backupsnapshot.NewSnapshotServiceFromClientSet(ctx.SdkClientSet)
+		if result, proceed := r.scheduleDeletion(ctx, snapshotService, deploymentInAKO, time.Now()); !proceed {
+			return result.ReconcileResult()
+		}
+		if result, approved := r.approve(ctx, deploymentInAKO.GetCustomResource(), deleteOperation(deploymentInAKO.GetName())); !approved {
+			return result.ReconcileResult()
+		}
 		if err := r.deleteDeploymentFromAtlas(ctx, deploymentService, deploymentInAKO, deploymentInAtlas); err != nil {
 			return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to remove deployment from Atlas: %w", err))
 		}
 	}
 
+	// The backup bindings are only released once the deletion is no longer held, so that cancelling a scheduled
+	// or unapproved deletion leaves the backup schedule of the deployment in place.
+	if err := r.cleanupBindings(ctx.Context, deploymentInAKO); err != nil {
+		return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to cleanup deployment bindings (backups): %w", err))
+	}
+
 	if err := customresource.ManageFinalizer(ctx.Context, r.Client, deploymentInAKO.GetCustomResource(), customresource.UnsetFinalizer); err != nil {
 		return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to remove finalizer: %w", err))
 	}
@@ -378,7 +416,7 @@ func (r *AtlasDeploymentReconciler) For() (client.Object, builder.Predicates) {
 }
 
 func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasDeployment").
 		For(r.For()).
 		Watches(
@@ -400,7 +438,13 @@ func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager, skipNameV
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.deploymentsForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
-		).
+		)
+
+	if r.AtlasNotifications != nil {
+		b = b.WatchesRawSource(source.Channel(r.AtlasNotifications, &handler.EnqueueRequestForObject{}))
+	}
+
+	return b.
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation:      new(skipNameValidation),
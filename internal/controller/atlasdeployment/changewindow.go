@@ -0,0 +1,197 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+// namedChangeWindow is a change window and where it is defined, e.g. spec.changeWindow.
+type namedChangeWindow struct {
+	name   string
+	window *akov2.ChangeWindow
+}
+
+// changeWindowState is the state of the change windows of a deployment at a given time.
+type changeWindowState struct {
+	// open is true when all the change windows are open, or when there are none.
+	open bool
+	// nextOpening is the earliest time all the change windows may be open, zero when they are open
+	// or when one of them never opens.
+	nextOpening time.Time
+	// allowed lists the disruptive changes applied regardless of the change windows.
+	allowed []string
+	// scheduled is the active schedule entry, its instance sizes are applied regardless of the change windows.
+	scheduled *akov2.DeploymentSchedule
+	// pending lists the disruptive changes held until the change windows open.
+	pending []string
+}
+
+// evaluateChangeWindows evaluates the change window of the deployment and those of the policies of its namespace.
+// The instance sizes of the active schedule entry, if any, aren't held by the change windows.
+func (r *AtlasDeploymentReconciler) evaluateChangeWindows(ctx context.Context, atlasDeployment *akov2.AtlasDeployment, schedule *scheduleState, now time.Time) (*changeWindowState, error) {
+	var windows []namedChangeWindow
+	if atlasDeployment.Spec.ChangeWindow != nil {
+		windows = append(windows, namedChangeWindow{name: "spec.changeWindow", window: atlasDeployment.Spec.ChangeWindow})
+	}
+	policies, err := policy.Applicable(ctx, r.Client, atlasDeployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if p.Spec.Deployments != nil && p.Spec.Deployments.ChangeWindow != nil {
+			windows = append(windows, namedChangeWindow{name: p.Name, window: p.Spec.Deployments.ChangeWindow})
+		}
+	}
+
+	state, err := evaluateChangeWindows(windows, now)
+	if err != nil {
+		return nil, err
+	}
+	if schedule != nil {
+		state.scheduled = schedule.active
+	}
+	return state, nil
+}
+
+// evaluateChangeWindows returns whether all the change windows are open at the given time and, if not,
+// the earliest time they may all be open: when the last of the closed windows opens.
+func evaluateChangeWindows(windows []namedChangeWindow, now time.Time) (*changeWindowState, error) {
+	state := &changeWindowState{open: true}
+	neverOpens := false
+	for _, w := range windows {
+		open, transition, err := evaluateWindow(w.window.Start, w.window.End, w.window.TimeZone, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate the change window of %s: %w", w.name, err)
+		}
+		if open {
+			continue
+		}
+		state.open = false
+		if transition.IsZero() {
+			neverOpens = true
+		}
+		if transition.After(state.nextOpening) {
+			state.nextOpening = transition
+		}
+	}
+	if neverOpens {
+		state.nextOpening = time.Time{}
+	}
+	return state, nil
+}
+
+// scheduledScaling returns whether all the instance sizes changing from current to desired are set to the sizes
+// of the active schedule entry. The schedule then decides when the instance sizes change, the scaling isn't held
+// until the change windows open. Any other change of the instance sizes, e.g. in the spec, is held.
+func scheduledScaling(schedule *akov2.DeploymentSchedule, desired, current *deployment.Cluster) bool {
+	if schedule == nil || (schedule.InstanceSize == "" && schedule.AnalyticsInstanceSize == "") {
+		return false
+	}
+	for ix, desiredReplicationSpec := range desired.ReplicationSpecs {
+		if ix >= len(current.ReplicationSpecs) {
+			break
+		}
+		currentReplicationSpec := current.ReplicationSpecs[ix]
+		if desiredReplicationSpec == nil || currentReplicationSpec == nil ||
+			len(desiredReplicationSpec.RegionConfigs) != len(currentReplicationSpec.RegionConfigs) {
+			continue
+		}
+		for regIx, regionConfig := range desiredReplicationSpec.RegionConfigs {
+			currentRegionConfig := currentReplicationSpec.RegionConfigs[regIx]
+			if regionConfig == nil || currentRegionConfig == nil {
+				continue
+			}
+			if !scheduledSize(regionConfig.ElectableSpecs, currentRegionConfig.ElectableSpecs, schedule.InstanceSize) ||
+				!scheduledSize(regionConfig.ReadOnlySpecs, currentRegionConfig.ReadOnlySpecs, schedule.InstanceSize) ||
+				!scheduledSize(regionConfig.AnalyticsSpecs, currentRegionConfig.AnalyticsSpecs, schedule.AnalyticsInstanceSize) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// scheduledSize returns whether the instance size is unchanged or changes to the size of the schedule entry.
+func scheduledSize(desired, current *akov2.Specs, scheduled string) bool {
+	if desired == nil || current == nil || desired.InstanceSize == current.InstanceSize {
+		return true
+	}
+	return scheduled != "" && desired.InstanceSize == scheduled
+}
+
+// holdDisruptiveChanges returns the desired deployment without its disruptive changes while the change windows
+// are closed, and reports the held changes in the PendingChangeWindow condition. An event is emitted when the
+// held changes change.
+func (r *AtlasDeploymentReconciler) holdDisruptiveChanges(ctx *workflow.Context, window *changeWindowState, desired, current *deployment.Cluster) *deployment.Cluster {
+	if window == nil || window.open {
+		ctx.UnsetCondition(api.PendingChangeWindowType)
+		return desired
+	}
+
+	allowed := window.allowed
+	if scheduledScaling(window.scheduled, desired, current) {
+		allowed = append(slices.Clone(allowed), deployment.ChangeInstanceSize)
+	}
+	window.pending = slices.DeleteFunc(deployment.DisruptiveChanges(desired, current), func(change string) bool {
+		return slices.Contains(allowed, change)
+	})
+	if len(window.pending) == 0 {
+		ctx.UnsetCondition(api.PendingChangeWindowType)
+		return desired
+	}
+
+	msg := window.message()
+	if previous, ok := ctx.GetCondition(api.PendingChangeWindowType); !ok || previous.Message != msg {
+		ctx.Log.Infow("Holding disruptive deployment changes until the change window opens", "changes", window.pending)
+		r.EventRecorder.Event(desired.GetCustomResource(), corev1.EventTypeNormal, string(workflow.DeploymentChangesHeld), msg)
+	}
+	ctx.EnsureCondition(api.Condition{
+		Type:    api.PendingChangeWindowType,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(workflow.DeploymentChangesHeld),
+		Message: msg,
+	})
+	return deployment.WithoutDisruptiveChanges(desired, current, allowed...)
+}
+
+func (s *changeWindowState) message() string {
+	changes := strings.Join(s.pending, ", ")
+	if s.nextOpening.IsZero() {
+		return fmt.Sprintf("changes of the %s are held, the change window never opens", changes)
+	}
+	return fmt.Sprintf("changes of the %s are held until the change window opens at %s", changes, s.nextOpening.UTC().Format(time.RFC3339))
+}
+
+// requeue makes sure the deployment is reconciled again when the change windows open, if changes are held.
+func (s *changeWindowState) requeue(result ctrl.Result, err error) (ctrl.Result, error) {
+	if s == nil || len(s.pending) == 0 {
+		return result, err
+	}
+	return requeueAt(s.nextOpening, result, err)
+}
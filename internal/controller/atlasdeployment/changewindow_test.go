@@ -0,0 +1,266 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+func TestEvaluateChangeWindows(t *testing.T) {
+	saturday := namedChangeWindow{name: "spec.changeWindow", window: &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT"}}
+	nights := namedChangeWindow{name: "AtlasPolicy ns/nights", window: &akov2.ChangeWindow{Start: "0 0 * * *", End: "0 4 * * *"}}
+
+	for _, tc := range []struct {
+		name                string
+		windows             []namedChangeWindow
+		now                 time.Time
+		expectedOpen        bool
+		expectedNextOpening time.Time
+	}{
+		{
+			name:         "no change windows",
+			now:          time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:                "closed",
+			windows:             []namedChangeWindow{saturday},
+			now:                 time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC), // Monday
+			expectedNextOpening: time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "open",
+			windows:      []namedChangeWindow{saturday},
+			now:          time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:         "all open",
+			windows:      []namedChangeWindow{saturday, nights},
+			now:          time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:                "last closed window opening",
+			windows:             []namedChangeWindow{saturday, nights},
+			now:                 time.Date(2025, 3, 15, 5, 0, 0, 0, time.UTC),
+			expectedNextOpening: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := evaluateChangeWindows(tc.windows, tc.now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOpen, state.open)
+			assert.True(t, tc.expectedNextOpening.Equal(state.nextOpening), "expected %v, got %v", tc.expectedNextOpening, state.nextOpening)
+		})
+	}
+
+	_, err := evaluateChangeWindows([]namedChangeWindow{{name: "spec.changeWindow", window: &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT", TimeZone: "Mars/Olympus"}}}, time.Now())
+	require.ErrorContains(t, err, "failed to evaluate the change window of spec.changeWindow")
+}
+
+func TestReconcilerEvaluateChangeWindows(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	require.NoError(t, corev1.AddToScheme(testScheme))
+	atlasPolicy := &akov2.AtlasPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "windows", Namespace: "test"},
+		Spec: akov2.AtlasPolicySpec{
+			Deployments: &akov2.DeploymentPolicy{
+				ChangeWindow: &akov2.ChangeWindow{Start: "0 0 * * *", End: "0 4 * * *"},
+			},
+		},
+	}
+	r := &AtlasDeploymentReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client: fake.NewClientBuilder().WithScheme(testScheme).WithObjects(atlasPolicy).Build(),
+		},
+	}
+	atlasDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "test"},
+		Spec: akov2.AtlasDeploymentSpec{
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+			ChangeWindow:   &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT"},
+		},
+	}
+
+	state, err := r.evaluateChangeWindows(context.Background(), atlasDeployment, nil, time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, state.open)
+	assert.Empty(t, state.allowed)
+
+	state, err = r.evaluateChangeWindows(context.Background(), atlasDeployment, nil, time.Date(2025, 3, 15, 5, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, state.open, "the window of the policy must be open too")
+	assert.True(t, time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC).Equal(state.nextOpening))
+
+	peak := &akov2.DeploymentSchedule{Name: "peak", Start: "0 8 * * *", End: "0 20 * * *", InstanceSize: "M40"}
+	state, err = r.evaluateChangeWindows(context.Background(), atlasDeployment, &scheduleState{active: peak}, time.Date(2025, 3, 15, 5, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, state.allowed)
+	assert.Same(t, peak, state.scheduled)
+}
+
+func TestHoldDisruptiveChanges(t *testing.T) {
+	atlasDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "test"},
+		Spec: akov2.AtlasDeploymentSpec{
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				Name:                "cluster0",
+				ClusterType:         "REPLICASET",
+				MongoDBMajorVersion: "8.0",
+				BackupEnabled:       new(true),
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+					{
+						RegionConfigs: []*akov2.AdvancedRegionConfig{
+							{
+								ProviderName:   "AWS",
+								RegionName:     "US_EAST_1",
+								Priority:       new(7),
+								ElectableSpecs: &akov2.Specs{InstanceSize: "M30", NodeCount: new(3)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	current := deployment.NewDeployment("project-id", atlasDeployment.DeepCopy()).(*deployment.Cluster)
+	current.MongoDBMajorVersion = "7.0"
+	current.BackupEnabled = new(false)
+	current.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M10"
+	nextOpening := time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)
+
+	newReconciler := func() (*AtlasDeploymentReconciler, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(10)
+		return &AtlasDeploymentReconciler{EventRecorder: recorder}, recorder
+	}
+	newContext := func() *workflow.Context {
+		return &workflow.Context{Context: context.Background(), Log: zaptest.NewLogger(t).Sugar()}
+	}
+
+	t.Run("holds disruptive changes while the window is closed", func(t *testing.T) {
+		r, recorder := newReconciler()
+		ctx := newContext()
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+		window := &changeWindowState{nextOpening: nextOpening}
+
+		held := r.holdDisruptiveChanges(ctx, window, desired, current)
+
+		assert.Equal(t, "7.0", held.MongoDBMajorVersion)
+		assert.Equal(t, "M10", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Equal(t, new(true), held.BackupEnabled)
+		assert.Equal(t, "8.0", desired.MongoDBMajorVersion)
+		assert.Equal(t, []string{deployment.ChangeMongoDBMajorVersion, deployment.ChangeInstanceSize}, window.pending)
+		condition, ok := ctx.GetCondition(api.PendingChangeWindowType)
+		require.True(t, ok)
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "changes of the MongoDB major version, instance size are held until the change window opens at 2025-03-15T02:00:00Z", condition.Message)
+		assert.Len(t, recorder.Events, 1)
+
+		_, changed := deployment.ComputeChanges(held, current)
+		assert.True(t, changed, "non-disruptive changes must still be applied")
+	})
+
+	t.Run("keeps allowed changes", func(t *testing.T) {
+		r, _ := newReconciler()
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+		window := &changeWindowState{nextOpening: nextOpening, allowed: []string{deployment.ChangeInstanceSize}}
+
+		held := r.holdDisruptiveChanges(newContext(), window, desired, current)
+
+		assert.Equal(t, "7.0", held.MongoDBMajorVersion)
+		assert.Equal(t, "M30", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Equal(t, []string{deployment.ChangeMongoDBMajorVersion}, window.pending)
+	})
+
+	t.Run("applies the instance sizes of the active schedule entry", func(t *testing.T) {
+		r, _ := newReconciler()
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+		window := &changeWindowState{nextOpening: nextOpening, scheduled: &akov2.DeploymentSchedule{Name: "peak", InstanceSize: "M30"}}
+
+		held := r.holdDisruptiveChanges(newContext(), window, desired, current)
+
+		assert.Equal(t, "7.0", held.MongoDBMajorVersion)
+		assert.Equal(t, "M30", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Equal(t, []string{deployment.ChangeMongoDBMajorVersion}, window.pending)
+	})
+
+	t.Run("holds instance sizes other than those of the active schedule entry", func(t *testing.T) {
+		r, _ := newReconciler()
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+		window := &changeWindowState{nextOpening: nextOpening, scheduled: &akov2.DeploymentSchedule{Name: "peak", InstanceSize: "M40"}}
+
+		held := r.holdDisruptiveChanges(newContext(), window, desired, current)
+
+		assert.Equal(t, "M10", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Equal(t, []string{deployment.ChangeMongoDBMajorVersion, deployment.ChangeInstanceSize}, window.pending)
+	})
+
+	t.Run("applies all changes while the window is open", func(t *testing.T) {
+		r, recorder := newReconciler()
+		ctx := newContext()
+		ctx.EnsureCondition(api.TrueCondition(api.PendingChangeWindowType))
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+		window := &changeWindowState{open: true}
+
+		assert.Same(t, desired, r.holdDisruptiveChanges(ctx, window, desired, current))
+		assert.Empty(t, window.pending)
+		_, ok := ctx.GetCondition(api.PendingChangeWindowType)
+		assert.False(t, ok)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("applies all changes without change windows", func(t *testing.T) {
+		r, _ := newReconciler()
+		desired := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+
+		assert.Same(t, desired, r.holdDisruptiveChanges(newContext(), nil, desired, current))
+	})
+}
+
+func TestChangeWindowRequeue(t *testing.T) {
+	state := &changeWindowState{nextOpening: time.Now().Add(time.Hour)}
+	result, err := state.requeue(ctrl.Result{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result, "no requeue without held changes")
+
+	state.pending = []string{deployment.ChangeInstanceSize}
+	result, err = state.requeue(ctrl.Result{}, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour, result.RequeueAfter, float64(time.Minute))
+
+	result, err = (*changeWindowState)(nil).requeue(ctrl.Result{RequeueAfter: time.Minute}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: time.Minute}, result)
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+const (
+	reasonDeletionScheduled      = "DeploymentDeletionScheduled"
+	reasonFinalSnapshotRequested = "DeploymentFinalSnapshotRequested"
+	reasonFinalSnapshotCompleted = "DeploymentFinalSnapshotCompleted"
+
+	finalSnapshotDescription = "final snapshot before deleting the deployment"
+)
+
+// scheduleDeletion holds the deletion of the deployment from Atlas until the deletion grace period following the
+// deletion of the resource ends and the final snapshot, if any, completes. It returns whether the deletion may
+// proceed, otherwise the returned result requeues the reconciliation.
+func (r *AtlasDeploymentReconciler) scheduleDeletion(ctx *workflow.Context, snapshotService backupsnapshot.SnapshotService, deploymentInAKO deployment.Deployment, now time.Time) (workflow.DeprecatedResult, bool) {
+	atlasDeployment := deploymentInAKO.GetCustomResource()
+	gracePeriod, err := deletionGracePeriod(atlasDeployment, r.DeletionGracePeriod)
+	if err != nil {
+		return r.holdDeletion(ctx, workflow.Terminate(workflow.Internal, err))
+	}
+	retentionInDays, err := finalSnapshotRetention(atlasDeployment, r.FinalSnapshotRetentionDays)
+	if err != nil {
+		return r.holdDeletion(ctx, workflow.Terminate(workflow.Internal, err))
+	}
+	if !atlasDeployment.IsAdvancedDeployment() {
+		retentionInDays = 0 // on-demand snapshots are only supported by dedicated deployments
+	}
+	if gracePeriod == 0 && retentionInDays == 0 {
+		return workflow.OK(), true
+	}
+
+	deletion := &status.DeploymentDeletionStatus{}
+	if atlasDeployment.Status.Deletion != nil {
+		*deletion = *atlasDeployment.Status.Deletion
+	}
+	scheduledAt := atlasDeployment.GetDeletionTimestamp().Add(gracePeriod)
+	deletion.ScheduledAt = scheduledAt.UTC().Format(time.RFC3339)
+	ctx.EnsureStatusOption(status.AtlasDeploymentDeletionOption(deletion))
+
+	if now.Before(scheduledAt) {
+		msg := fmt.Sprintf("Deployment %s will be deleted from Atlas at %s, set the %s=%s annotation to keep it",
+			deploymentInAKO.GetName(), deletion.ScheduledAt, customresource.ResourcePolicyAnnotation, customresource.ResourcePolicyKeep)
+		if atlasDeployment.Status.Deletion == nil {
+			r.EventRecorder.Event(atlasDeployment, corev1.EventTypeWarning, reasonDeletionScheduled, msg)
+		}
+		return r.holdDeletion(ctx, workflow.InProgress(workflow.DeploymentDeletionScheduled, msg).WithRetry(scheduledAt.Sub(now)))
+	}
+	if retentionInDays == 0 {
+		return workflow.OK(), true
+	}
+
+	return r.takeFinalSnapshot(ctx, snapshotService, deploymentInAKO, deletion, retentionInDays)
+}
+
+// takeFinalSnapshot takes the final snapshot of the deployment once and returns whether it completed.
+// A final snapshot taken since the deletion of the resource, whose ID failed to be recorded in the status,
+// is adopted instead of taking another one.
+func (r *AtlasDeploymentReconciler) takeFinalSnapshot(ctx *workflow.Context, snapshotService backupsnapshot.SnapshotService, deploymentInAKO deployment.Deployment, deletion *status.DeploymentDeletionStatus, retentionInDays int) (workflow.DeprecatedResult, bool) {
+	atlasDeployment := deploymentInAKO.GetCustomResource()
+	if deletion.FinalSnapshotID == "" {
+		cfg := &backupsnapshot.SnapshotConfig{
+			Description:     finalSnapshotDescription,
+			RetentionInDays: retentionInDays,
+			RequestedAt:     atlasDeployment.GetDeletionTimestamp().Time,
+		}
+		snapshot, err := snapshotService.Find(ctx.Context, deploymentInAKO.GetProjectID(), deploymentInAKO.GetName(), cfg)
+		if errors.Is(err, backupsnapshot.ErrNotFound) {
+			snapshot, err = snapshotService.Create(ctx.Context, deploymentInAKO.GetProjectID(), deploymentInAKO.GetName(), cfg)
+		}
+		if err != nil {
+			return r.holdDeletion(ctx, workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, fmt.Errorf("failed to take the final snapshot: %w", err)))
+		}
+		deletion.FinalSnapshotID = snapshot.ID
+		deletion.FinalSnapshotPhase = snapshot.Phase
+		r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, reasonFinalSnapshotRequested,
+			"Final snapshot %s requested, retained for %d days", snapshot.ID, retentionInDays)
+		return r.holdDeletion(ctx, workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, fmt.Sprintf("Waiting for the final snapshot %s", snapshot.ID)))
+	}
+
+	snapshot, err := snapshotService.Get(ctx.Context, deploymentInAKO.GetProjectID(), deploymentInAKO.GetName(), deletion.FinalSnapshotID)
+	switch {
+	case errors.Is(err, backupsnapshot.ErrNotFound):
+		err = fmt.Errorf("final snapshot %s not found, taking a new one", deletion.FinalSnapshotID)
+		deletion.FinalSnapshotID, deletion.FinalSnapshotPhase = "", ""
+		return r.holdDeletion(ctx, workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, err))
+	case err != nil:
+		return r.holdDeletion(ctx, workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, err))
+	}
+
+	deletion.FinalSnapshotPhase = snapshot.Phase
+	switch snapshot.Phase {
+	case status.BackupSnapshotPhaseCompleted:
+		r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, reasonFinalSnapshotCompleted, "Final snapshot %s completed", snapshot.ID)
+		return workflow.OK(), true
+	case status.BackupSnapshotPhaseFailed:
+		err = fmt.Errorf("final snapshot %s failed, taking a new one", deletion.FinalSnapshotID)
+		deletion.FinalSnapshotID, deletion.FinalSnapshotPhase = "", ""
+		return r.holdDeletion(ctx, workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, err))
+	default:
+		return r.holdDeletion(ctx, workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, fmt.Sprintf("Waiting for the final snapshot %s", snapshot.ID)))
+	}
+}
+
+func (r *AtlasDeploymentReconciler) holdDeletion(ctx *workflow.Context, result workflow.DeprecatedResult) (workflow.DeprecatedResult, bool) {
+	ctx.SetConditionFromResult(api.DeploymentReadyType, result)
+	return result, false
+}
+
+// deletionGracePeriod returns the deletion grace period of the deployment, the annotation overriding the
+// operator-wide default.
+func deletionGracePeriod(atlasDeployment *akov2.AtlasDeployment, defaultPeriod time.Duration) (time.Duration, error) {
+	value, ok := atlasDeployment.GetAnnotations()[customresource.DeletionGracePeriodAnnotation]
+	if !ok {
+		return defaultPeriod, nil
+	}
+	period, err := time.ParseDuration(value)
+	if err != nil || period < 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q: expected a non-negative duration, e.g. 24h", customresource.DeletionGracePeriodAnnotation, value)
+	}
+	return period, nil
+}
+
+// finalSnapshotRetention returns the retention in days of the final snapshot of the deployment, the annotation
+// overriding the operator-wide default. Zero disables the final snapshot.
+func finalSnapshotRetention(atlasDeployment *akov2.AtlasDeployment, defaultDays int) (int, error) {
+	value, ok := atlasDeployment.GetAnnotations()[customresource.FinalSnapshotRetentionAnnotation]
+	if !ok {
+		return defaultDays, nil
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q: expected a non-negative number of days", customresource.FinalSnapshotRetentionAnnotation, value)
+	}
+	return days, nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/backupsnapshot"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+func TestScheduleDeletion(t *testing.T) {
+	deletedAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	newDeployment := func(annotations map[string]string, deletion *status.DeploymentDeletionStatus) *akov2.AtlasDeployment {
+		return &akov2.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "cluster0",
+				Namespace:         "test",
+				Annotations:       annotations,
+				DeletionTimestamp: &metav1.Time{Time: deletedAt},
+			},
+			Spec: akov2.AtlasDeploymentSpec{
+				DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+			},
+			Status: status.AtlasDeploymentStatus{Deletion: deletion},
+		}
+	}
+
+	for _, tc := range []struct {
+		name               string
+		gracePeriod        time.Duration
+		retentionInDays    int
+		atlasDeployment    *akov2.AtlasDeployment
+		snapshotService    func(t *testing.T) backupsnapshot.SnapshotService
+		now                time.Time
+		expectedProceed    bool
+		expectedResult     workflow.DeprecatedResult
+		expectedDeletion   *status.DeploymentDeletionStatus
+		expectedEventCount int
+	}{
+		{
+			name:            "proceeds without grace period nor final snapshot",
+			atlasDeployment: newDeployment(nil, nil),
+			now:             deletedAt,
+			expectedProceed: true,
+			expectedResult:  workflow.OK(),
+		},
+		{
+			name:            "holds the deletion during the grace period",
+			gracePeriod:     24 * time.Hour,
+			atlasDeployment: newDeployment(nil, nil),
+			now:             deletedAt.Add(time.Hour),
+			expectedResult: workflow.InProgress(workflow.DeploymentDeletionScheduled,
+				"Deployment cluster0 will be deleted from Atlas at 2025-03-11T12:00:00Z, set the mongodb.com/atlas-resource-policy=keep annotation to keep it").
+				WithRetry(23 * time.Hour),
+			expectedDeletion:   &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-11T12:00:00Z"},
+			expectedEventCount: 1,
+		},
+		{
+			name:            "annotation overrides the grace period",
+			gracePeriod:     24 * time.Hour,
+			atlasDeployment: newDeployment(map[string]string{customresource.DeletionGracePeriodAnnotation: "0s"}, nil),
+			now:             deletedAt,
+			expectedProceed: true,
+			expectedResult:  workflow.OK(),
+		},
+		{
+			name:            "proceeds after the grace period",
+			gracePeriod:     time.Hour,
+			atlasDeployment: newDeployment(nil, &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-10T13:00:00Z"}),
+			now:             deletedAt.Add(time.Hour),
+			expectedProceed: true,
+			expectedResult:  workflow.OK(),
+			expectedDeletion: &status.DeploymentDeletionStatus{
+				ScheduledAt: "2025-03-10T13:00:00Z",
+			},
+		},
+		{
+			name:            "takes the final snapshot",
+			retentionInDays: 7,
+			atlasDeployment: newDeployment(nil, nil),
+			snapshotService: func(t *testing.T) backupsnapshot.SnapshotService {
+				s := akomock.NewSnapshotServiceMock(t)
+				cfg := &backupsnapshot.SnapshotConfig{
+					Description:     finalSnapshotDescription,
+					RetentionInDays: 7,
+					RequestedAt:     deletedAt,
+				}
+				s.EXPECT().Find(mock.Anything, "project-id", "cluster0", cfg).Return(nil, backupsnapshot.ErrNotFound)
+				s.EXPECT().Create(mock.Anything, "project-id", "cluster0", cfg).
+					Return(&backupsnapshot.Snapshot{ID: "snapshot-id", Phase: status.BackupSnapshotPhaseQueued}, nil)
+				return s
+			},
+			now:            deletedAt,
+			expectedResult: workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, "Waiting for the final snapshot snapshot-id"),
+			expectedDeletion: &status.DeploymentDeletionStatus{
+				ScheduledAt:        "2025-03-10T12:00:00Z",
+				FinalSnapshotID:    "snapshot-id",
+				FinalSnapshotPhase: status.BackupSnapshotPhaseQueued,
+			},
+			expectedEventCount: 1,
+		},
+		{
+			name:            "adopts a final snapshot that was not recorded",
+			retentionInDays: 7,
+			atlasDeployment: newDeployment(nil, nil),
+			snapshotService: func(t *testing.T) backupsnapshot.SnapshotService {
+				s := akomock.NewSnapshotServiceMock(t)
+				s.EXPECT().Find(mock.Anything, "project-id", "cluster0", mock.Anything).
+					Return(&backupsnapshot.Snapshot{ID: "snapshot-id", Phase: status.BackupSnapshotPhaseInProgress}, nil)
+				return s
+			},
+			now:            deletedAt.Add(time.Minute),
+			expectedResult: workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, "Waiting for the final snapshot snapshot-id"),
+			expectedDeletion: &status.DeploymentDeletionStatus{
+				ScheduledAt:        "2025-03-10T12:00:00Z",
+				FinalSnapshotID:    "snapshot-id",
+				FinalSnapshotPhase: status.BackupSnapshotPhaseInProgress,
+			},
+			expectedEventCount: 1,
+		},
+		{
+			name:            "waits for the final snapshot",
+			retentionInDays: 7,
+			atlasDeployment: newDeployment(nil, &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-10T12:00:00Z", FinalSnapshotID: "snapshot-id"}),
+			snapshotService: func(t *testing.T) backupsnapshot.SnapshotService {
+				s := akomock.NewSnapshotServiceMock(t)
+				s.EXPECT().Get(mock.Anything, "project-id", "cluster0", "snapshot-id").
+					Return(&backupsnapshot.Snapshot{ID: "snapshot-id", Phase: status.BackupSnapshotPhaseInProgress}, nil)
+				return s
+			},
+			now:            deletedAt.Add(time.Minute),
+			expectedResult: workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, "Waiting for the final snapshot snapshot-id"),
+			expectedDeletion: &status.DeploymentDeletionStatus{
+				ScheduledAt:        "2025-03-10T12:00:00Z",
+				FinalSnapshotID:    "snapshot-id",
+				FinalSnapshotPhase: status.BackupSnapshotPhaseInProgress,
+			},
+		},
+		{
+			name:            "proceeds once the final snapshot completed",
+			atlasDeployment: newDeployment(map[string]string{customresource.FinalSnapshotRetentionAnnotation: "30"}, &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-10T12:00:00Z", FinalSnapshotID: "snapshot-id"}),
+			snapshotService: func(t *testing.T) backupsnapshot.SnapshotService {
+				s := akomock.NewSnapshotServiceMock(t)
+				s.EXPECT().Get(mock.Anything, "project-id", "cluster0", "snapshot-id").
+					Return(&backupsnapshot.Snapshot{ID: "snapshot-id", Phase: status.BackupSnapshotPhaseCompleted}, nil)
+				return s
+			},
+			now:             deletedAt.Add(time.Minute),
+			expectedProceed: true,
+			expectedResult:  workflow.OK(),
+			expectedDeletion: &status.DeploymentDeletionStatus{
+				ScheduledAt:        "2025-03-10T12:00:00Z",
+				FinalSnapshotID:    "snapshot-id",
+				FinalSnapshotPhase: status.BackupSnapshotPhaseCompleted,
+			},
+			expectedEventCount: 1,
+		},
+		{
+			name:            "takes a new final snapshot when it failed",
+			retentionInDays: 7,
+			atlasDeployment: newDeployment(nil, &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-10T12:00:00Z", FinalSnapshotID: "snapshot-id"}),
+			snapshotService: func(t *testing.T) backupsnapshot.SnapshotService {
+				s := akomock.NewSnapshotServiceMock(t)
+				s.EXPECT().Get(mock.Anything, "project-id", "cluster0", "snapshot-id").
+					Return(&backupsnapshot.Snapshot{ID: "snapshot-id", Phase: status.BackupSnapshotPhaseFailed}, nil)
+				return s
+			},
+			now:              deletedAt.Add(time.Minute),
+			expectedResult:   workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, errors.New("final snapshot snapshot-id failed, taking a new one")),
+			expectedDeletion: &status.DeploymentDeletionStatus{ScheduledAt: "2025-03-10T12:00:00Z"},
+		},
+		{
+			name:            "invalid grace period annotation",
+			atlasDeployment: newDeployment(map[string]string{customresource.DeletionGracePeriodAnnotation: "tomorrow"}, nil),
+			now:             deletedAt,
+			expectedResult: workflow.Terminate(workflow.Internal,
+				errors.New(`invalid mongodb.com/atlas-deletion-grace-period annotation "tomorrow": expected a non-negative duration, e.g. 24h`)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			r := &AtlasDeploymentReconciler{
+				EventRecorder:              recorder,
+				DeletionGracePeriod:        tc.gracePeriod,
+				FinalSnapshotRetentionDays: tc.retentionInDays,
+			}
+			ctx := &workflow.Context{Context: context.Background(), Log: zaptest.NewLogger(t).Sugar()}
+			var snapshotService backupsnapshot.SnapshotService
+			if tc.snapshotService != nil {
+				snapshotService = tc.snapshotService(t)
+			}
+
+			result, proceed := r.scheduleDeletion(ctx, snapshotService, deployment.NewDeployment("project-id", tc.atlasDeployment), tc.now)
+
+			assert.Equal(t, tc.expectedProceed, proceed)
+			assert.Equal(t, tc.expectedResult, result)
+			tc.atlasDeployment.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+			assert.Equal(t, tc.expectedDeletion, tc.atlasDeployment.Status.Deletion)
+			require.Len(t, recorder.Events, tc.expectedEventCount)
+		})
+	}
+}
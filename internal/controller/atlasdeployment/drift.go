@@ -0,0 +1,90 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/drift"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
+)
+
+const driftMetricKind = "AtlasDeployment"
+
+// detectOnly reports the differences between the deployment spec and Atlas for deployments
+// reconciled with the detect-only policy. Atlas is never updated, only the connection secrets
+// are kept up to date in Kubernetes.
+func (r *AtlasDeploymentReconciler) detectOnly(ctx *workflow.Context, projectService project.ProjectService, akoDeployment, atlasDeployment deployment.Deployment, desired, observed any) (ctrl.Result, error) {
+	report, err := drift.Compute(desired, observed)
+	if err != nil {
+		return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to compute drift: %w", err))
+	}
+	r.reportDrift(ctx, akoDeployment.GetCustomResource(), len(report.Operations), report.Summary())
+
+	if err := r.ensureConnectionSecrets(ctx, projectService, akoDeployment, atlasDeployment.GetConnection()); err != nil {
+		return r.terminate(ctx, workflow.DeploymentConnectionSecretsNotCreated, err)
+	}
+
+	return r.ready(ctx, akoDeployment, atlasDeployment)
+}
+
+// detectOnlyNotFound reports a deployment that does not exist in Atlas without creating it.
+func (r *AtlasDeploymentReconciler) detectOnlyNotFound(ctx *workflow.Context, akoDeployment deployment.Deployment) (ctrl.Result, error) {
+	msg := fmt.Sprintf("deployment %s does not exist in Atlas", akoDeployment.GetName())
+	r.reportDrift(ctx, akoDeployment.GetCustomResource(), 1, msg)
+
+	return r.terminate(ctx, workflow.AtlasResourceDrifted, fmt.Errorf("%s and is not created with the %s reconciliation policy", msg, customresource.ReconciliationPolicyDetectOnly))
+}
+
+// reportDrift sets the Drifted condition and the drift metric. An event is emitted when the drift changes.
+func (r *AtlasDeploymentReconciler) reportDrift(ctx *workflow.Context, atlasDeployment *akov2.AtlasDeployment, fields int, summary string) {
+	metrics.ObserveDrift(driftMetricKind, client.ObjectKeyFromObject(atlasDeployment), fields)
+
+	if fields == 0 {
+		ctx.EnsureCondition(api.Condition{
+			Type:   api.DriftedType,
+			Status: corev1.ConditionFalse,
+			Reason: string(workflow.AtlasResourceInSync),
+		})
+		return
+	}
+
+	if previous, ok := ctx.GetCondition(api.DriftedType); !ok || previous.Message != summary {
+		ctx.Log.Infow("Deployment drifted from Atlas", "drift", summary)
+		r.EventRecorder.Event(atlasDeployment, corev1.EventTypeWarning, string(workflow.AtlasResourceDrifted), summary)
+	}
+	ctx.EnsureCondition(api.Condition{
+		Type:    api.DriftedType,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(workflow.AtlasResourceDrifted),
+		Message: summary,
+	})
+}
+
+// clearDrift removes the Drifted condition and metric once the detect-only policy is removed.
+func (r *AtlasDeploymentReconciler) clearDrift(ctx *workflow.Context, atlasDeployment *akov2.AtlasDeployment) {
+	metrics.ObserveDrift(driftMetricKind, client.ObjectKeyFromObject(atlasDeployment), 0)
+	ctx.UnsetCondition(api.DriftedType)
+}
@@ -40,7 +40,11 @@ func (r *AtlasDeploymentReconciler) handleFlexInstance(ctx *workflow.Context, pr
 		return r.terminate(ctx, workflow.Internal, errors.New("deployment in Atlas is not a flex cluster"))
 	}
 
+	detectOnly := customresource.ReconciliationIsDetectOnly(akoFlex.GetCustomResource())
 	if atlasFlex == nil {
+		if detectOnly {
+			return r.detectOnlyNotFound(ctx, akoFlex)
+		}
 		ctx.Log.Infof("Flex Instance %s doesn't exist in Atlas - creating", akoFlex.GetName())
 		newFlexDeployment, err := deploymentService.CreateDeployment(ctx.Context, akoFlex)
 		if err != nil {
@@ -52,6 +56,10 @@ func (r *AtlasDeploymentReconciler) handleFlexInstance(ctx *workflow.Context, pr
 
 	switch atlasFlex.GetState() {
 	case status.StateIDLE:
+		if detectOnly {
+			return r.detectOnly(ctx, projectService, akoFlex, atlasFlex, akoFlex.FlexSpec, atlasFlex.FlexSpec)
+		}
+
 		if !reflect.DeepEqual(akoFlex.FlexSpec, atlasFlex.FlexSpec) {
 			_, err := deploymentService.UpdateDeployment(ctx.Context, akoFlex)
 			if err != nil {
@@ -0,0 +1,200 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/cron"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+const (
+	reasonScheduleStarted = "DeploymentScheduleStarted"
+	reasonScheduleEnded   = "DeploymentScheduleEnded"
+)
+
+// scheduleState is the state of the schedules of a deployment at a given time.
+type scheduleState struct {
+	// active is the schedule entry applied to the deployment, nil when none is active.
+	active *akov2.DeploymentSchedule
+	// nextTransition is the earliest time a schedule entry starts or ends, zero without schedules.
+	nextTransition time.Time
+}
+
+// applySchedules overrides the desired deployment with the active schedule entry, if any,
+// and reports the state of the schedules in the status.
+func (r *AtlasDeploymentReconciler) applySchedules(ctx *workflow.Context, deploymentInAKO deployment.Deployment, now time.Time) (*scheduleState, error) {
+	atlasDeployment := deploymentInAKO.GetCustomResource()
+	state, err := evaluateSchedules(atlasDeployment.Spec.Schedules, now)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := ""
+	if atlasDeployment.Status.Schedule != nil {
+		previous = atlasDeployment.Status.Schedule.ActiveEntry
+	}
+	current := ""
+	if state.active != nil {
+		current = state.active.Name
+	}
+	if previous != current {
+		if previous != "" {
+			r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, reasonScheduleEnded, "Schedule %q ended", previous)
+		}
+		if current != "" {
+			r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, reasonScheduleStarted, "Schedule %q started", current)
+		}
+	}
+
+	if cluster, ok := deploymentInAKO.(*deployment.Cluster); ok && state.active != nil {
+		applySchedule(cluster, state.active)
+	}
+	ctx.EnsureStatusOption(status.AtlasDeploymentScheduleOption(state.status()))
+
+	return state, nil
+}
+
+// requeue makes sure the deployment is reconciled again when the next schedule entry starts or ends.
+func (s *scheduleState) requeue(result ctrl.Result, err error) (ctrl.Result, error) {
+	return requeueAt(s.nextTransition, result, err)
+}
+
+// requeueAt makes sure the deployment is reconciled again at the given time, unless it is zero.
+func requeueAt(at time.Time, result ctrl.Result, err error) (ctrl.Result, error) {
+	if err != nil || at.IsZero() {
+		return result, err
+	}
+	untilNext := time.Until(at)
+	if result.RequeueAfter == 0 || result.RequeueAfter > untilNext {
+		result.RequeueAfter = untilNext
+	}
+	return result, nil
+}
+
+func (s *scheduleState) status() *status.DeploymentScheduleStatus {
+	if s.active == nil && s.nextTransition.IsZero() {
+		return nil
+	}
+	scheduleStatus := &status.DeploymentScheduleStatus{}
+	if s.active != nil {
+		scheduleStatus.ActiveEntry = s.active.Name
+	}
+	if !s.nextTransition.IsZero() {
+		scheduleStatus.NextTransition = s.nextTransition.UTC().Format(time.RFC3339)
+	}
+	return scheduleStatus
+}
+
+// evaluateSchedules returns the schedule entry active at the given time, the first one of the list
+// when several are active, and the next time any of the entries starts or ends.
+func evaluateSchedules(schedules []akov2.DeploymentSchedule, now time.Time) (*scheduleState, error) {
+	state := &scheduleState{}
+	for i := range schedules {
+		active, transition, err := evaluateWindow(schedules[i].Start, schedules[i].End, schedules[i].TimeZone, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate schedule %q: %w", schedules[i].Name, err)
+		}
+		if active && state.active == nil {
+			state.active = &schedules[i]
+		}
+		if !transition.IsZero() && (state.nextTransition.IsZero() || transition.Before(state.nextTransition)) {
+			state.nextTransition = transition
+		}
+	}
+	return state, nil
+}
+
+// evaluateWindow returns whether the window from every activation of the start cron expression until the
+// following activation of the end one is open at the given time, and when it next opens or closes. A window
+// is open when it closes before it opens again, so that a window is also open when the deployment is created
+// after it opened. Schedule entries and change windows are such windows.
+func evaluateWindow(startExpr, endExpr, timeZone string, now time.Time) (bool, time.Time, error) {
+	location := time.UTC
+	if timeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(timeZone); err != nil {
+			return false, time.Time{}, err
+		}
+	}
+	start, err := cron.Parse(startExpr)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	end, err := cron.Parse(endExpr)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	nextStart := start.Next(now.In(location))
+	nextEnd := end.Next(now.In(location))
+	switch {
+	case nextEnd.IsZero():
+		return false, nextStart, nil
+	case nextStart.IsZero() || nextEnd.Before(nextStart):
+		return true, nextEnd, nil
+	default:
+		return false, nextStart, nil
+	}
+}
+
+// applySchedule overrides the desired deployment with the schedule entry. With compute auto-scaling, the
+// scheduled instance size is also the minimum instance size, so that Atlas scales the deployment up to it
+// and auto-scaling doesn't scale it down while the entry is active.
+func applySchedule(cluster *deployment.Cluster, schedule *akov2.DeploymentSchedule) {
+	if schedule.Paused != nil {
+		cluster.Paused = new(*schedule.Paused)
+	}
+
+	for _, replicationSpec := range cluster.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig == nil {
+				continue
+			}
+			if schedule.InstanceSize != "" {
+				scheduleInstanceSize(regionConfig.ElectableSpecs, schedule.InstanceSize)
+				scheduleInstanceSize(regionConfig.ReadOnlySpecs, schedule.InstanceSize)
+				scheduleMinInstanceSize(regionConfig.AutoScaling, schedule.InstanceSize)
+			}
+			if schedule.AnalyticsInstanceSize != "" {
+				scheduleInstanceSize(regionConfig.AnalyticsSpecs, schedule.AnalyticsInstanceSize)
+				scheduleMinInstanceSize(regionConfig.AnalyticsAutoScaling, schedule.AnalyticsInstanceSize)
+			}
+		}
+	}
+}
+
+func scheduleInstanceSize(specs *akov2.Specs, instanceSize string) {
+	if specs != nil {
+		specs.InstanceSize = instanceSize
+	}
+}
+
+func scheduleMinInstanceSize(autoscaling *akov2.AdvancedAutoScalingSpec, instanceSize string) {
+	if autoscaling != nil && autoscaling.Compute != nil && autoscaling.Compute.Enabled != nil && *autoscaling.Compute.Enabled {
+		autoscaling.Compute.MinInstanceSize = instanceSize
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+func TestEvaluateSchedules(t *testing.T) {
+	nightly := akov2.DeploymentSchedule{Name: "nightly", Start: "0 20 * * MON-FRI", End: "0 7 * * MON-FRI", TimeZone: "Europe/Berlin", Paused: new(true)}
+	peak := akov2.DeploymentSchedule{Name: "peak", Start: "0 8 1 * *", End: "0 20 1 * *", InstanceSize: "M40"}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name                   string
+		schedules              []akov2.DeploymentSchedule
+		now                    time.Time
+		expectedActive         string
+		expectedNextTransition time.Time
+		expectedStatus         *status.DeploymentScheduleStatus
+	}{
+		{
+			name: "no schedules",
+			now:  time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                   "inactive during the day",
+			schedules:              []akov2.DeploymentSchedule{nightly},
+			now:                    time.Date(2025, 3, 10, 12, 0, 0, 0, berlin), // Monday
+			expectedNextTransition: time.Date(2025, 3, 10, 20, 0, 0, 0, berlin),
+			expectedStatus:         &status.DeploymentScheduleStatus{NextTransition: "2025-03-10T19:00:00Z"},
+		},
+		{
+			name:                   "active at night",
+			schedules:              []akov2.DeploymentSchedule{nightly},
+			now:                    time.Date(2025, 3, 10, 23, 0, 0, 0, berlin),
+			expectedActive:         "nightly",
+			expectedNextTransition: time.Date(2025, 3, 11, 7, 0, 0, 0, berlin),
+			expectedStatus:         &status.DeploymentScheduleStatus{ActiveEntry: "nightly", NextTransition: "2025-03-11T06:00:00Z"},
+		},
+		{
+			name:                   "active over the weekend",
+			schedules:              []akov2.DeploymentSchedule{nightly},
+			now:                    time.Date(2025, 3, 15, 12, 0, 0, 0, berlin), // Saturday
+			expectedActive:         "nightly",
+			expectedNextTransition: time.Date(2025, 3, 17, 7, 0, 0, 0, berlin),
+			expectedStatus:         &status.DeploymentScheduleStatus{ActiveEntry: "nightly", NextTransition: "2025-03-17T06:00:00Z"},
+		},
+		{
+			name:                   "active from the start time",
+			schedules:              []akov2.DeploymentSchedule{peak},
+			now:                    time.Date(2025, 4, 1, 8, 0, 0, 0, time.UTC),
+			expectedActive:         "peak",
+			expectedNextTransition: time.Date(2025, 4, 1, 20, 0, 0, 0, time.UTC),
+			expectedStatus:         &status.DeploymentScheduleStatus{ActiveEntry: "peak", NextTransition: "2025-04-01T20:00:00Z"},
+		},
+		{
+			name:                   "first active entry applies",
+			schedules:              []akov2.DeploymentSchedule{peak, nightly},
+			now:                    time.Date(2025, 4, 1, 18, 30, 0, 0, time.UTC), // 20:30 in Berlin
+			expectedActive:         "peak",
+			expectedNextTransition: time.Date(2025, 4, 1, 20, 0, 0, 0, time.UTC),
+			expectedStatus:         &status.DeploymentScheduleStatus{ActiveEntry: "peak", NextTransition: "2025-04-01T20:00:00Z"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := evaluateSchedules(tc.schedules, tc.now)
+			require.NoError(t, err)
+			if tc.expectedActive == "" {
+				assert.Nil(t, state.active)
+			} else {
+				require.NotNil(t, state.active)
+				assert.Equal(t, tc.expectedActive, state.active.Name)
+			}
+			assert.True(t, tc.expectedNextTransition.Equal(state.nextTransition), "expected %v, got %v", tc.expectedNextTransition, state.nextTransition)
+			assert.Equal(t, tc.expectedStatus, state.status())
+		})
+	}
+}
+
+func TestEvaluateSchedulesError(t *testing.T) {
+	_, err := evaluateSchedules([]akov2.DeploymentSchedule{{Name: "invalid", Start: "0 20 * * *", End: "0 7 * * *", TimeZone: "Mars/Olympus"}}, time.Now())
+	require.ErrorContains(t, err, `failed to evaluate schedule "invalid"`)
+}
+
+func TestApplySchedule(t *testing.T) {
+	cluster := func() *deployment.Cluster {
+		return &deployment.Cluster{
+			AdvancedDeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				Paused: new(false),
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+					{
+						RegionConfigs: []*akov2.AdvancedRegionConfig{
+							{
+								ElectableSpecs: &akov2.Specs{InstanceSize: "M10"},
+								ReadOnlySpecs:  &akov2.Specs{InstanceSize: "M10"},
+								AnalyticsSpecs: &akov2.Specs{InstanceSize: "M10"},
+								AutoScaling: &akov2.AdvancedAutoScalingSpec{
+									Compute: &akov2.ComputeSpec{Enabled: new(true), ScaleDownEnabled: new(true), MinInstanceSize: "M10", MaxInstanceSize: "M50"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	paused := cluster()
+	applySchedule(paused, &akov2.DeploymentSchedule{Paused: new(true)})
+	assert.Equal(t, new(true), paused.Paused)
+	assert.Equal(t, cluster().ReplicationSpecs, paused.ReplicationSpecs)
+
+	scaled := cluster()
+	applySchedule(scaled, &akov2.DeploymentSchedule{InstanceSize: "M30", AnalyticsInstanceSize: "M20"})
+	assert.Equal(t, new(false), scaled.Paused)
+	regionConfig := scaled.ReplicationSpecs[0].RegionConfigs[0]
+	assert.Equal(t, "M30", regionConfig.ElectableSpecs.InstanceSize)
+	assert.Equal(t, "M30", regionConfig.ReadOnlySpecs.InstanceSize)
+	assert.Equal(t, "M20", regionConfig.AnalyticsSpecs.InstanceSize)
+	assert.Equal(t, "M30", regionConfig.AutoScaling.Compute.MinInstanceSize)
+	assert.Equal(t, "M50", regionConfig.AutoScaling.Compute.MaxInstanceSize)
+}
+
+func TestScheduleRequeue(t *testing.T) {
+	state := &scheduleState{nextTransition: time.Now().Add(time.Hour)}
+
+	result, err := state.requeue(ctrl.Result{}, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour, result.RequeueAfter, float64(time.Minute))
+
+	result, err = state.requeue(ctrl.Result{RequeueAfter: 10 * time.Second}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, result.RequeueAfter)
+
+	result, err = state.requeue(ctrl.Result{RequeueAfter: 2 * time.Hour}, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour, result.RequeueAfter, float64(time.Minute))
+
+	_, err = state.requeue(ctrl.Result{}, errors.New("failed"))
+	require.Error(t, err)
+
+	result, err = (&scheduleState{}).requeue(ctrl.Result{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+}
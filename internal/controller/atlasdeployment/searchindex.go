@@ -18,12 +18,14 @@ import (
 	"errors"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
 )
 
@@ -178,6 +180,15 @@ func (sr *searchIndexReconcileRequest) delete(index *searchindex.SearchIndex) wo
 		return workflow.OK()
 	}
 
+	handedOver, err := sr.handedOver(index)
+	if err != nil {
+		return sr.terminate(index, err)
+	}
+	if handedOver {
+		sr.ctx.Log.Infof("index %s is managed by an AtlasSearchIndex, leaving it in Atlas", index.Name)
+		return sr.deleted(index.Name)
+	}
+
 	if err := sr.searchService.DeleteIndex(
 		sr.ctx.Context, sr.projectID, sr.deployment.GetDeploymentName(), index.GetID()); err != nil {
 		return sr.terminate(index, err)
@@ -186,6 +197,36 @@ func (sr *searchIndexReconcileRequest) delete(index *searchindex.SearchIndex) wo
 	return sr.deleted(index.Name)
 }
 
+// handedOver tells whether an AtlasSearchIndex takes over the index removed from the spec,
+// the index is then kept in Atlas for the AtlasSearchIndex to adopt.
+func (sr *searchIndexReconcileRequest) handedOver(index *searchindex.SearchIndex) (bool, error) {
+	indexes := &akov2.AtlasSearchIndexList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasSearchIndexByDeploymentNameIndex, sr.deployment.GetDeploymentName()),
+	}
+	if err := sr.k8sClient.List(sr.ctx.Context, indexes, listOpts); err != nil {
+		return false, fmt.Errorf("failed to list AtlasSearchIndexes of deployment %s: %w", sr.deployment.GetDeploymentName(), err)
+	}
+	for i := range indexes.Items {
+		searchIndex := &indexes.Items[i]
+		if !searchIndex.DeletionTimestamp.IsZero() || searchIndexProjectID(searchIndex) != sr.projectID {
+			continue
+		}
+		if searchIndex.Spec.Name == index.Name && searchIndex.Spec.DBName == index.DBName &&
+			searchIndex.Spec.CollectionName == index.CollectionName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func searchIndexProjectID(searchIndex *akov2.AtlasSearchIndex) string {
+	if searchIndex.Spec.ExternalDeploymentRef != nil {
+		return searchIndex.Spec.ExternalDeploymentRef.ProjectID
+	}
+	return searchIndex.Status.ProjectID
+}
+
 func (sr *searchIndexReconcileRequest) deleted(indexName string) workflow.DeprecatedResult {
 	sr.ctx.EnsureStatusOption(status.AtlasDeploymentUnsetSearchIndexStatus(status.NewDeploymentSearchIndexStatus("",
 		status.WithName(indexName))))
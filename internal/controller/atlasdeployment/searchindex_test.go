@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
@@ -310,7 +311,7 @@ func Test_searchIndexReconcileRequest(t *testing.T) {
 				Context: context.Background(),
 			},
 			deployment:    testCluster,
-			k8sClient:     nil,
+			k8sClient:     searchIndexTestClient(t),
 			projectID:     "",
 			indexName:     "testIndexName",
 			searchService: fakeAtlasSearch,
@@ -368,7 +369,7 @@ func Test_searchIndexReconcileRequest(t *testing.T) {
 				Context: context.Background(),
 			},
 			deployment:    testCluster,
-			k8sClient:     nil,
+			k8sClient:     searchIndexTestClient(t),
 			projectID:     "",
 			indexName:     "testIndexName",
 			searchService: fakeAtlasSearch,
@@ -379,6 +380,72 @@ func Test_searchIndexReconcileRequest(t *testing.T) {
 		assert.True(t, reconciler.ctx.HasReason(status.SearchIndexStatusError))
 	})
 
+	t.Run("delete: must leave an index managed by an AtlasSearchIndex in Atlas", func(t *testing.T) {
+		indexToTest := &searchindex.SearchIndex{
+			SearchIndex: akov2.SearchIndex{
+				Name:           "test",
+				DBName:         "testDB",
+				CollectionName: "testCollection",
+				Type:           "search",
+			},
+			ID:     new("testID"),
+			Status: new(IndexStatusActive),
+		}
+
+		testCluster := &akov2.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testDeployment",
+				Namespace: "testNamespace",
+			},
+			Spec: akov2.AtlasDeploymentSpec{
+				DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+					Name: "testDeploymentName",
+				},
+			},
+			Status: status.AtlasDeploymentStatus{
+				SearchIndexes: []status.DeploymentSearchIndexStatus{{Name: "test", ID: "testID"}},
+			},
+		}
+
+		atlasSearchIndex := &akov2.AtlasSearchIndex{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "testNamespace",
+			},
+			Spec: akov2.AtlasSearchIndexSpec{
+				DeploymentDualReference: akov2.DeploymentDualReference{
+					DeploymentRef: &common.ResourceRefNamespaced{Name: "testDeployment"},
+				},
+				SearchIndex: akov2.SearchIndex{
+					Name:           "test",
+					DBName:         "testDB",
+					CollectionName: "testCollection",
+					Type:           "search",
+				},
+			},
+			Status: status.AtlasSearchIndexStatus{ProjectID: "testProjectID", ClusterName: "testDeploymentName"},
+		}
+
+		reconciler := &searchIndexReconcileRequest{
+			ctx: &workflow.Context{
+				Log:     zap.S(),
+				OrgID:   "testOrgID",
+				Context: context.Background(),
+			},
+			deployment: testCluster,
+			k8sClient:  searchIndexTestClient(t, atlasSearchIndex),
+			projectID:  "testProjectID",
+			indexName:  "test",
+			// a nil DeleteIndexFunc panics if the index is deleted
+			searchService: &fake.FakeAtlasSearch{},
+		}
+
+		result := reconciler.reconcileInternal("test", nil, indexToTest)
+		assert.True(t, result.IsDeleted())
+		testCluster.UpdateStatus(reconciler.ctx.Conditions(), reconciler.ctx.StatusOptions()...)
+		assert.Empty(t, testCluster.Status.SearchIndexes)
+	})
+
 	t.Run("delete: must reconcile if AKO index ID is nil", func(t *testing.T) {
 		sch := runtime.NewScheme()
 		assert.Nil(t, akov2.AddToScheme(sch))
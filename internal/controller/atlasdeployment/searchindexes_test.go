@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -32,6 +33,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
 	searchfake "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex/fake"
 )
@@ -740,14 +742,7 @@ func Test_SearchIndexesReconcile(t *testing.T) {
 			},
 		}
 
-		sch := runtime.NewScheme()
-		assert.Nil(t, akov2.AddToScheme(sch))
-		assert.Nil(t, corev1.AddToScheme(sch))
-
-		k8sClient := fake.NewClientBuilder().
-			WithScheme(sch).
-			WithObjects(cluster, searchIndexConfig).
-			Build()
+		k8sClient := searchIndexTestClient(t, cluster, searchIndexConfig)
 
 		reconciler := searchIndexesReconcileRequest{
 			ctx: &workflow.Context{
@@ -766,3 +761,15 @@ func Test_SearchIndexesReconcile(t *testing.T) {
 		assert.True(t, result.IsOk())
 	})
 }
+
+func searchIndexTestClient(t *testing.T, objects ...client.Object) client.Client {
+	sch := runtime.NewScheme()
+	assert.Nil(t, akov2.AddToScheme(sch))
+	deploymentNameIndexer := indexer.NewAtlasSearchIndexByDeploymentNameIndexer(zap.NewNop())
+
+	return fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objects...).
+		WithIndex(deploymentNameIndexer.Object(), deploymentNameIndexer.Name(), deploymentNameIndexer.Keys).
+		Build()
+}
@@ -98,6 +98,11 @@ func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.R
 		r.Log.Debugf("federated auth validation result: %v", resourceVersionIsValid)
 		return resourceVersionIsValid.ReconcileResult()
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, fedauth, "AtlasFederatedAuth")
+	if !policyIsValid.IsOk() {
+		r.Log.Debugf("federated auth reconciliation policy validation result: %v", policyIsValid)
+		return policyIsValid.ReconcileResult()
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(fedauth) {
 		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasFederatedAuth is not supported by Atlas for government")).
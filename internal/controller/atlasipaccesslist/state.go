@@ -24,6 +24,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/collection"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/ipaccesslist"
@@ -42,11 +43,21 @@ func (r *AtlasIPAccessListReconciler) handleCustomResource(ctx context.Context,
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, ipAccessList, "AtlasIPAccessList")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(ipAccessList) {
 		return r.unsupport(workflowCtx)
 	}
 
+	if ipAccessList.GetDeletionTimestamp().IsZero() {
+		if err := policy.CheckIPAccessList(ctx, r.Client, ipAccessList); err != nil {
+			return r.violate(workflowCtx, ipAccessList, err)
+		}
+	}
+
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, ipAccessList)
 	if err != nil {
 		return r.terminate(workflowCtx, ipAccessList, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
@@ -56,6 +56,7 @@ func TestHandleCustomResource(t *testing.T) {
 	}
 	tests := map[string]struct {
 		ipAccessList       akov2.AtlasIPAccessList
+		objects            []client.Object
 		provider           atlas.Provider
 		expectedResult     workflowRes
 		expectedFinalizers []string
@@ -142,6 +143,47 @@ func TestHandleCustomResource(t *testing.T) {
 				api.TrueCondition(api.ResourceVersionStatus),
 			},
 		},
+		"should fail on policy violation": {
+			ipAccessList: akov2.AtlasIPAccessList{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ip-access-list",
+					Namespace: "default",
+				},
+				Spec: akov2.AtlasIPAccessListSpec{
+					Entries: []akov2.IPAccessEntry{
+						{
+							CIDRBlock: "192.168.0.0/24",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&akov2.AtlasPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "network",
+						Namespace: "default",
+					},
+					Spec: akov2.AtlasPolicySpec{
+						IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+					},
+				},
+			},
+			provider: &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool {
+					return true
+				},
+			},
+			expectedResult: workflowRes{
+				result: ctrl.Result{RequeueAfter: time.Minute},
+			},
+			expectedConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.TrueCondition(api.ResourceVersionStatus),
+				api.FalseCondition(api.IPAccessListReady).
+					WithReason(string(workflow.PolicyViolation)).
+					WithMessageRegexp("AtlasPolicy default/network violated: 192.168.0.0/24 is not within the allowed CIDRs [10.0.0.0/8]"),
+			},
+		},
 		"should fail to resolve credentials": {
 			ipAccessList: akov2.AtlasIPAccessList{
 				ObjectMeta: metav1.ObjectMeta{
@@ -360,6 +402,7 @@ func TestHandleCustomResource(t *testing.T) {
 						"privateApiKey": []byte("privateApiKey"),
 					},
 				}).
+				WithObjects(tt.objects...).
 				WithStatusSubresource(&tt.ipAccessList).
 				Build()
 			logger := zaptest.NewLogger(t).Sugar()
@@ -25,6 +25,7 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/ipaccesslist"
 )
@@ -159,6 +160,16 @@ func (r *AtlasIPAccessListReconciler) ready(ctx *workflow.Context, ipAccessList
 	return workflow.OK().ReconcileResult()
 }
 
+// violate reports entries that don't comply with the policies of the namespace, nothing is changed in Atlas.
+func (r *AtlasIPAccessListReconciler) violate(ctx *workflow.Context, ipAccessList *akov2.AtlasIPAccessList, err error) (ctrl.Result, error) {
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s", ipAccessList, ipAccessList.GetNamespace(), ipAccessList.GetName(), api.IPAccessListReady, err)
+	result := policy.Terminate(err)
+	ctx.SetConditionFalse(api.ReadyType).
+		SetConditionFromResult(api.IPAccessListReady, result)
+
+	return result.ReconcileResult()
+}
+
 func (r *AtlasIPAccessListReconciler) terminate(
 	ctx *workflow.Context,
 	ipAccessList *akov2.AtlasIPAccessList,
@@ -52,6 +52,10 @@ func (r *AtlasNetworkContainerReconciler) handleCustomResource(ctx context.Conte
 	if !isValid.IsOk() {
 		return r.Invalidate(typeName, isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, networkContainer, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(networkContainer) {
 		return r.Unsupport(workflowCtx, typeName)
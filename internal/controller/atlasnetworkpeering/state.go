@@ -55,6 +55,10 @@ func (r *AtlasNetworkPeeringReconciler) handleCustomResource(ctx context.Context
 	if !isValid.IsOk() {
 		return r.Invalidate(typeName, isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, networkPeering, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(networkPeering) {
 		return r.Unsupport(workflowCtx, typeName)
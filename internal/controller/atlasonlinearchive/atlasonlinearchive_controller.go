@@ -0,0 +1,98 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasonlinearchive
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+// AtlasOnlineArchiveReconciler reconciles a AtlasOnlineArchive object
+type AtlasOnlineArchiveReconciler struct {
+	reconciler.AtlasReconciler
+	Scheme                   *runtime.Scheme
+	EventRecorder            record.EventRecorder
+	GlobalPredicates         []predicate.Predicate
+	ObjectDeletionProtection bool
+	independentSyncPeriod    time.Duration
+	maxConcurrentReconciles  int
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasonlinearchives,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasonlinearchives/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasonlinearchives/finalizers,verbs=update
+
+// Reconcile Atlas Online Archive resources
+func (r *AtlasOnlineArchiveReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log.Infow("-> Starting AtlasOnlineArchive reconciliation")
+
+	onlineArchive := akov2.AtlasOnlineArchive{}
+	result := customresource.PrepareResource(ctx, r.Client, req, &onlineArchive, r.Log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+	return r.handleCustomResource(ctx, &onlineArchive)
+}
+
+// For prepares the controller for its target Custom Resource; Online Archives
+func (r *AtlasOnlineArchiveReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasOnlineArchive{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Archive states are polled, so no other resources are watched.
+func (r *AtlasOnlineArchiveReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.For()).
+		WithOptions(controller.TypedOptions[reconcile.Request]{
+			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
+			SkipNameValidation:      new(skipNameValidation),
+			MaxConcurrentReconciles: r.maxConcurrentReconciles}).
+		Complete(r)
+}
+
+func NewAtlasOnlineArchiveReconciler(c cluster.Cluster, predicates []predicate.Predicate, atlasProvider atlas.Provider, deletionProtection bool, logger *zap.Logger, independentSyncPeriod time.Duration, globalSecretRef client.ObjectKey, maxConcurrentReconciles int) *AtlasOnlineArchiveReconciler {
+	return &AtlasOnlineArchiveReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			Log:             logger.Named("controllers").Named("AtlasOnlineArchive").Sugar(),
+			GlobalSecretRef: globalSecretRef,
+			AtlasProvider:   atlasProvider,
+		},
+		Scheme:                   c.GetScheme(),
+		EventRecorder:            c.GetEventRecorderFor("AtlasOnlineArchive"),
+		GlobalPredicates:         predicates,
+		ObjectDeletionProtection: deletionProtection,
+		independentSyncPeriod:    independentSyncPeriod,
+		maxConcurrentReconciles:  maxConcurrentReconciles,
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasonlinearchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/onlinearchive"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
+)
+
+const (
+	typeName = "AtlasOnlineArchive"
+)
+
+type reconcileRequest struct {
+	projectID     string
+	clusterName   string
+	onlineArchive *akov2.AtlasOnlineArchive
+	service       onlinearchive.OnlineArchiveService
+}
+
+func (r *AtlasOnlineArchiveReconciler) handleCustomResource(ctx context.Context, onlineArchive *akov2.AtlasOnlineArchive) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(onlineArchive) {
+		return r.Skip(ctx, typeName, onlineArchive, onlineArchive.Spec)
+	}
+
+	conditions := api.InitCondition(onlineArchive, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, onlineArchive)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, onlineArchive)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, onlineArchive, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, onlineArchive, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(onlineArchive) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	req, err := r.newReconcileRequest(ctx, onlineArchive)
+	if onlineArchive.DeletionTimestamp != nil && apierrors.IsNotFound(err) {
+		// the parent deployment is gone, along with its archives
+		return r.unmanage(workflowCtx, onlineArchive)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, onlineArchive, workflow.OnlineArchiveNotConfigured, err)
+	}
+	return r.handle(workflowCtx, req)
+}
+
+// newReconcileRequest resolves the Atlas project and deployment of the archive,
+// either through the referenced AtlasDeployment or the external deployment reference.
+func (r *AtlasOnlineArchiveReconciler) newReconcileRequest(ctx context.Context, onlineArchive *akov2.AtlasOnlineArchive) (*reconcileRequest, error) {
+	var referrer project.ProjectReferrerObject = onlineArchive
+	var clusterName string
+	if onlineArchive.Spec.DeploymentRef != nil {
+		deployment, err := r.fetchDeployment(ctx, onlineArchive)
+		if err != nil {
+			return nil, err
+		}
+		referrer = deployment
+		clusterName = deployment.GetDeploymentName()
+	} else {
+		clusterName = onlineArchive.Spec.ExternalDeploymentRef.Name
+	}
+
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, referrer)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return nil, err
+	}
+	atlasProject, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312, referrer)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		projectID:     atlasProject.ID,
+		clusterName:   clusterName,
+		onlineArchive: onlineArchive,
+		service:       onlinearchive.NewOnlineArchiveServiceFromClientSet(sdkClientSet),
+	}, nil
+}
+
+func (r *AtlasOnlineArchiveReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	atlasArchive, err := discover(workflowCtx.Context, req)
+	if err != nil {
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.OnlineArchiveNotConfigured, err)
+	}
+	inAtlas := atlasArchive != nil
+	deleted := req.onlineArchive.DeletionTimestamp != nil
+	switch {
+	case !deleted && !inAtlas:
+		return r.create(workflowCtx, req)
+	case !deleted && inAtlas:
+		return r.sync(workflowCtx, req, atlasArchive)
+	case deleted && inAtlas:
+		return r.delete(workflowCtx, req, atlasArchive)
+	default: // deleted && !inAtlas:
+		return r.unmanage(workflowCtx, req.onlineArchive)
+	}
+}
+
+// discover returns the Online Archive of the resource, by its ID once known or else by its collection.
+func discover(ctx context.Context, req *reconcileRequest) (*onlinearchive.OnlineArchive, error) {
+	if id := req.onlineArchive.Status.ID; id != "" {
+		archive, err := req.service.Get(ctx, req.projectID, req.clusterName, id)
+		if errors.Is(err, onlinearchive.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get online archive %s for cluster %s: %w", id, req.clusterName, err)
+		}
+		return archive, nil
+	}
+	cfg := onlinearchive.NewOnlineArchiveConfig(&req.onlineArchive.Spec.AtlasOnlineArchiveConfig)
+	archive, err := req.service.Find(ctx, req.projectID, req.clusterName, cfg)
+	if err != nil && !errors.Is(err, onlinearchive.ErrNotFound) {
+		return nil, fmt.Errorf("failed to find online archive for cluster %s: %w", req.clusterName, err)
+	}
+	return archive, nil
+}
+
+func (r *AtlasOnlineArchiveReconciler) fetchDeployment(ctx context.Context, onlineArchive *akov2.AtlasOnlineArchive) (*akov2.AtlasDeployment, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := onlineArchive.Spec.DeploymentRef.GetObject(onlineArchive.Namespace)
+	if err := r.Client.Get(ctx, *key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasDeployment %s: %w", key, err)
+	}
+	return deployment, nil
+}
@@ -0,0 +1,349 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasonlinearchive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/onlinearchive"
+)
+
+const (
+	testProjectID = "project-id"
+
+	testClusterName = "test-cluster"
+
+	testArchiveID = "5f4007f327a3bd7b6f4103c5"
+
+	testSyncPeriod = time.Hour
+)
+
+var ErrTestFail = errors.New("failure")
+
+func TestHandle(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		status         status.AtlasOnlineArchiveStatus
+		paused         bool
+		service        func() onlinearchive.OnlineArchiveService
+		wantResult     ctrl.Result
+		wantFinalizers []string
+		wantStatus     status.AtlasOnlineArchiveStatus
+		wantConditions []api.Condition
+	}{
+		{
+			title: "creates the archive",
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Find(mock.Anything, testProjectID, testClusterName, testConfig()).Return(nil, onlinearchive.ErrNotFound)
+				oas.EXPECT().Create(mock.Anything, testProjectID, testClusterName, testConfig()).
+					Return(testArchive(onlinearchive.StatePending, false), nil)
+				return oas
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(onlinearchive.StatePending),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.OnlineArchiveReady).WithReason(string(workflow.OnlineArchiveInProgress)).
+					WithMessageRegexp("Online Archive 5f4007f327a3bd7b6f4103c5 is PENDING"),
+			},
+		},
+		{
+			title: "adopts an existing archive of the collection",
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Find(mock.Anything, testProjectID, testClusterName, testConfig()).
+					Return(testArchive(onlinearchive.StateActive, false), nil)
+				return oas
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(onlinearchive.StateActive),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.OnlineArchiveReady).WithMessageRegexp("Online Archive 5f4007f327a3bd7b6f4103c5 is ACTIVE"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:  "pauses the archive",
+			status: status.AtlasOnlineArchiveStatus{ID: testArchiveID, State: onlinearchive.StateActive},
+			paused: true,
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).
+					Return(testArchive(onlinearchive.StateActive, false), nil)
+				wantConfig := testConfig()
+				wantConfig.Paused = true
+				oas.EXPECT().Update(mock.Anything, testProjectID, testClusterName, testArchiveID, wantConfig).
+					Return(testArchive(onlinearchive.StatePausing, true), nil)
+				return oas
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(onlinearchive.StatePausing),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.OnlineArchiveReady).WithReason(string(workflow.OnlineArchiveInProgress)).
+					WithMessageRegexp("Online Archive 5f4007f327a3bd7b6f4103c5 is PAUSING"),
+			},
+		},
+		{
+			title:  "recreates a deleted archive",
+			status: status.AtlasOnlineArchiveStatus{ID: testArchiveID, State: onlinearchive.StateActive},
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).Return(nil, onlinearchive.ErrNotFound)
+				oas.EXPECT().Create(mock.Anything, testProjectID, testClusterName, testConfig()).
+					Return(testArchive(onlinearchive.StatePending, false), nil)
+				return oas
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(onlinearchive.StatePending),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.OnlineArchiveReady).WithReason(string(workflow.OnlineArchiveInProgress)).
+					WithMessageRegexp("Online Archive 5f4007f327a3bd7b6f4103c5 is PENDING"),
+			},
+		},
+		{
+			title:  "reports an orphaned archive",
+			status: status.AtlasOnlineArchiveStatus{ID: testArchiveID, State: onlinearchive.StateActive},
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).
+					Return(testArchive(onlinearchive.StateOrphaned, false), nil)
+				return oas
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(onlinearchive.StateOrphaned),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.OnlineArchiveOrphaned)).
+					WithMessageRegexp("online archive 5f4007f327a3bd7b6f4103c5 is orphaned, the archived collection no longer exists"),
+			},
+		},
+		{
+			title: "fails to create the archive",
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Find(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, onlinearchive.ErrNotFound)
+				oas.EXPECT().Create(mock.Anything, testProjectID, testClusterName, mock.Anything).Return(nil, ErrTestFail)
+				return oas
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.OnlineArchiveNotConfigured)).
+					WithMessageRegexp("failed to create online archive: failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			onlineArchive := &akov2.AtlasOnlineArchive{
+				ObjectMeta: metav1.ObjectMeta{Name: "archive", Namespace: "default"},
+				Spec:       testSpec(),
+				Status:     tc.status,
+			}
+			onlineArchive.Spec.Paused = tc.paused
+			k8sClient := testClient(t, onlineArchive)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:     testProjectID,
+				clusterName:   testClusterName,
+				onlineArchive: onlineArchive,
+				service:       tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantFinalizers, getOnlineArchive(t, k8sClient, client.ObjectKeyFromObject(onlineArchive)).GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			gotStatus := status.AtlasOnlineArchiveStatus{}
+			for _, option := range workflowCtx.StatusOptions() {
+				option.(status.AtlasOnlineArchiveStatusOption)(&gotStatus)
+			}
+			assert.Equal(t, tc.wantStatus, gotStatus)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		annotations map[string]string
+		service     func() onlinearchive.OnlineArchiveService
+	}{
+		{
+			title: "deletes the archive",
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).
+					Return(testArchive(onlinearchive.StateActive, false), nil)
+				oas.EXPECT().Delete(mock.Anything, testProjectID, testClusterName, testArchiveID).Return(nil)
+				return oas
+			},
+		},
+		{
+			title: "archive already gone",
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).Return(nil, onlinearchive.ErrNotFound)
+				return oas
+			},
+		},
+		{
+			title:       "keeps the archive",
+			annotations: map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep},
+			service: func() onlinearchive.OnlineArchiveService {
+				oas := akomock.NewOnlineArchiveServiceMock(t)
+				oas.EXPECT().Get(mock.Anything, testProjectID, testClusterName, testArchiveID).
+					Return(testArchive(onlinearchive.StateActive, false), nil)
+				return oas
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			onlineArchive := &akov2.AtlasOnlineArchive{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "archive",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec:   testSpec(),
+				Status: status.AtlasOnlineArchiveStatus{ID: testArchiveID, State: onlinearchive.StateActive},
+			}
+			k8sClient := testClient(t, onlineArchive)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:     testProjectID,
+				clusterName:   testClusterName,
+				onlineArchive: onlineArchive,
+				service:       tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getOnlineArchive(t, k8sClient, client.ObjectKeyFromObject(onlineArchive)).GetFinalizers())
+		})
+	}
+}
+
+func testSpec() akov2.AtlasOnlineArchiveSpec {
+	return akov2.AtlasOnlineArchiveSpec{
+		DeploymentDualReference: akov2.DeploymentDualReference{
+			DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+		},
+		AtlasOnlineArchiveConfig: akov2.AtlasOnlineArchiveConfig{
+			DBName:   "sales",
+			CollName: "orders",
+			Criteria: akov2.OnlineArchiveCriteria{
+				Type:            onlinearchive.CriteriaTypeDate,
+				DateField:       "createdAt",
+				ExpireAfterDays: new(90),
+			},
+		},
+	}
+}
+
+func testConfig() *onlinearchive.OnlineArchiveConfig {
+	spec := testSpec()
+	return onlinearchive.NewOnlineArchiveConfig(&spec.AtlasOnlineArchiveConfig)
+}
+
+func testArchive(state string, paused bool) *onlinearchive.OnlineArchive {
+	archive := &onlinearchive.OnlineArchive{OnlineArchiveConfig: *testConfig(), ID: testArchiveID, State: state}
+	archive.Paused = paused
+	return archive
+}
+
+func testStatus(state string) status.AtlasOnlineArchiveStatus {
+	return status.AtlasOnlineArchiveStatus{
+		ID:          testArchiveID,
+		State:       state,
+		ProjectID:   testProjectID,
+		ClusterName: testClusterName,
+	}
+}
+
+func testClient(t *testing.T, onlineArchive *akov2.AtlasOnlineArchive) client.Client {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(onlineArchive).
+		WithStatusSubresource(onlineArchive).
+		Build()
+}
+
+func getOnlineArchive(t *testing.T, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasOnlineArchive {
+	onlineArchive := &akov2.AtlasOnlineArchive{}
+	if err := k8sClient.Get(context.Background(), key, onlineArchive); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return onlineArchive
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasOnlineArchiveReconciler {
+	return &AtlasOnlineArchiveReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder:         record.NewFakeRecorder(10),
+		independentSyncPeriod: testSyncPeriod,
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasonlinearchive
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/onlinearchive"
+)
+
+func (r *AtlasOnlineArchiveReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	cfg := onlinearchive.NewOnlineArchiveConfig(&req.onlineArchive.Spec.AtlasOnlineArchiveConfig)
+	createdArchive, err := req.service.Create(workflowCtx.Context, req.projectID, req.clusterName, cfg)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to create online archive: %w", err)
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.OnlineArchiveNotConfigured, wrappedErr)
+	}
+	return r.ready(workflowCtx, req, createdArchive)
+}
+
+func (r *AtlasOnlineArchiveReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, atlasArchive *onlinearchive.OnlineArchive) (ctrl.Result, error) {
+	desiredConfig := onlinearchive.NewOnlineArchiveConfig(&req.onlineArchive.Spec.AtlasOnlineArchiveConfig)
+	if onlinearchive.NeedsUpdate(desiredConfig, atlasArchive) {
+		return r.update(workflowCtx, req, atlasArchive.ID, desiredConfig)
+	}
+	return r.ready(workflowCtx, req, atlasArchive)
+}
+
+func (r *AtlasOnlineArchiveReconciler) update(workflowCtx *workflow.Context, req *reconcileRequest, id string, config *onlinearchive.OnlineArchiveConfig) (ctrl.Result, error) {
+	updatedArchive, err := req.service.Update(workflowCtx.Context, req.projectID, req.clusterName, id, config)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to update online archive: %w", err)
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.OnlineArchiveNotConfigured, wrappedErr)
+	}
+	return r.ready(workflowCtx, req, updatedArchive)
+}
+
+func (r *AtlasOnlineArchiveReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest, archive *onlinearchive.OnlineArchive) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.onlineArchive, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.onlineArchive)
+	}
+	err := req.service.Delete(workflowCtx.Context, req.projectID, req.clusterName, archive.ID)
+	if err != nil && !errors.Is(err, onlinearchive.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to delete online archive: %w", err)
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.OnlineArchiveNotDeleted, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.onlineArchive)
+}
+
+// ready reports the state of the archive in Atlas. Archives are polled as their state
+// changes in Atlas, e.g. while archiving or when the archived collection is dropped.
+func (r *AtlasOnlineArchiveReconciler) ready(workflowCtx *workflow.Context, req *reconcileRequest, archive *onlinearchive.OnlineArchive) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.onlineArchive, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.AtlasFinalizerNotSet, err)
+	}
+	workflowCtx.EnsureStatusOption(updateOnlineArchiveStatusOption(req, archive))
+
+	if archive.State == onlinearchive.StateOrphaned {
+		err := fmt.Errorf("online archive %s is orphaned, the archived collection no longer exists", archive.ID)
+		return r.terminate(workflowCtx, req.onlineArchive, workflow.OnlineArchiveOrphaned, err)
+	}
+	if !archive.IsActive(req.onlineArchive.Spec.Paused) {
+		msg := fmt.Sprintf("Online Archive %s is %s", archive.ID, archive.State)
+		result := workflow.InProgress(workflow.OnlineArchiveInProgress, msg)
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.OnlineArchiveReady, result)
+		return result.ReconcileResult()
+	}
+
+	workflowCtx.SetConditionTrueMsg(api.OnlineArchiveReady, fmt.Sprintf("Online Archive %s is %s", archive.ID, archive.State)).
+		SetConditionTrue(api.ReadyType)
+	return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
+}
+
+func (r *AtlasOnlineArchiveReconciler) unmanage(workflowCtx *workflow.Context, onlineArchive *akov2.AtlasOnlineArchive) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, onlineArchive, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, onlineArchive, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasOnlineArchiveReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateOnlineArchiveStatusOption(req *reconcileRequest, archive *onlinearchive.OnlineArchive) status.AtlasOnlineArchiveStatusOption {
+	return func(archiveStatus *status.AtlasOnlineArchiveStatus) {
+		archiveStatus.ProjectID = req.projectID
+		archiveStatus.ClusterName = req.clusterName
+		onlinearchive.ApplyOnlineArchiveStatus(archiveStatus, archive)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/atlasorgsettings"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
@@ -59,6 +60,10 @@ func (h *AtlasOrgSettingsHandler) newReconcileRequest(ctx context.Context, aos *
 
 func (h *AtlasOrgSettingsHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
 	aos *akov2.AtlasOrgSettings) (ctrlstate.Result, error) {
+	if err := customresource.UnsupportedReconciliationPolicy(aos, "AtlasOrgSettings"); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	reconcileCtx, err := h.newReconcileRequest(ctx, aos)
 	if err != nil {
 		return result.Error(currentState, fmt.Errorf("failed to create reconcile context: %w", err))
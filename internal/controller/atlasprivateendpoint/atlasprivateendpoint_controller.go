@@ -41,6 +41,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/privateendpoint"
@@ -91,11 +92,21 @@ func (r *AtlasPrivateEndpointReconciler) ensureCustomResource(ctx context.Contex
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, akoPrivateEndpoint, "AtlasPrivateEndpoint")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(akoPrivateEndpoint) {
 		return r.unsupport(workflowCtx)
 	}
 
+	if akoPrivateEndpoint.GetDeletionTimestamp().IsZero() {
+		if err := validate.PrivateEndpoint(akoPrivateEndpoint); err != nil {
+			return r.invalidSpec(workflowCtx, err)
+		}
+	}
+
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, akoPrivateEndpoint)
 	if err != nil {
 		return r.terminate(workflowCtx, akoPrivateEndpoint, nil, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
@@ -141,6 +152,12 @@ func (r *AtlasPrivateEndpointReconciler) unsupport(ctx *workflow.Context) (ctrl.
 	return unsupported.ReconcileResult()
 }
 
+func (r *AtlasPrivateEndpointReconciler) invalidSpec(ctx *workflow.Context, err error) (ctrl.Result, error) {
+	invalid := workflow.Terminate(workflow.PrivateEndpointInvalidSpec, err).WithoutRetry()
+	ctx.SetConditionFromResult(api.ValidationSucceeded, invalid)
+	return invalid.ReconcileResult()
+}
+
 func (r *AtlasPrivateEndpointReconciler) terminate(
 	ctx *workflow.Context,
 	akoPrivateEndpoint *akov2.AtlasPrivateEndpoint,
@@ -236,6 +236,35 @@ func TestEnsureCustomResource(t *testing.T) {
 				"Status update",
 			},
 		},
+		"custom resource spec is invalid": {
+			atlasPrivateEndpoint: &akov2.AtlasPrivateEndpoint{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pe1",
+					Namespace: "default",
+				},
+				Spec: akov2.AtlasPrivateEndpointSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ExternalProjectRef: &akov2.ExternalProjectReference{
+							ID: projectID,
+						},
+						ConnectionSecret: &api.LocalObjectReference{},
+					},
+					Provider:           "AZURE",
+					Region:             "EUROPE_NORTH",
+					PortMappingEnabled: true,
+				},
+			},
+			provider: &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool {
+					return true
+				},
+			},
+			expectedResult: reconcile.Result{},
+			expectedLogs: []string{
+				"resource 'pe1' version is valid",
+				"Status update",
+			},
+		},
 		"failed to get project from atlas": {
 			atlasPrivateEndpoint: &akov2.AtlasPrivateEndpoint{
 				ObjectMeta: metav1.ObjectMeta{
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 	"go.uber.org/zap"
@@ -51,7 +52,7 @@ func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Con
 			service.SetConditionFalseMsg(alertConfigurationCondition, err.Error())
 			return workflow.Terminate(workflow.Internal, err)
 		}
-		result := syncAlertConfigurations(service, project.ID(), specToSync)
+		result := syncAlertConfigurations(service, project.ID(), specToSync, project.Status.AlertConfigurations, r.SubObjectDeletionProtection)
 		if !result.IsOk() {
 			service.SetConditionFromResult(alertConfigurationCondition, result)
 			return result
@@ -144,7 +145,10 @@ func readNotificationSecret(ctx context.Context, kubeClient client.Client, res c
 	return string(val), nil
 }
 
-func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpec []akov2.AlertConfiguration) workflow.DeprecatedResult {
+// syncAlertConfigurations creates the alert configurations of the spec missing in Atlas and deletes the others. With the
+// sub-object deletion protection, only the alert configurations previously recorded in the status, i.e. created or
+// adopted by the operator, are deleted.
+func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpec []akov2.AlertConfiguration, previous []status.AlertConfiguration, subObjectDeletionProtection bool) workflow.DeprecatedResult {
 	logger := service.Log
 	existedAlertConfigs, err := paging.ListAll(service.Context, func(ctx context.Context, pageNum int) (paging.Response[admin.GroupAlertsConfig], *http.Response, error) {
 		return service.SdkClientSet.SdkClient20250312.AlertConfigurationsAPI.
@@ -158,6 +162,9 @@ func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpe
 	}
 
 	diff := sortAlertConfigs(logger, alertSpec, existedAlertConfigs)
+	if subObjectDeletionProtection {
+		diff.Delete = ownedAlertConfigs(diff.Delete, previous)
+	}
 	logger.Debugf("to create %v, to create statuses %v, to delete %v", len(diff.Create), len(diff.CreateStatus), len(diff.Delete))
 
 	newStatuses := createAlertConfigs(service, groupID, diff.Create)
@@ -170,12 +177,36 @@ func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpe
 
 	err = deleteAlertConfigs(service, groupID, diff.Delete)
 	if err != nil {
+		// keep the alert configurations that might not be deleted yet, so that they are still owned on the next attempt
+		newStatuses = append(newStatuses, alertConfigStatuses(diff.Delete, previous)...)
+		service.EnsureStatusOption(status.AtlasProjectSetAlertConfigOption(&newStatuses))
 		return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas, fmt.Errorf("failed to delete alert configurations: %w", err))
 	}
 
 	return checkAlertConfigurationStatuses(newStatuses)
 }
 
+// ownedAlertConfigs returns the given alert configuration IDs recorded in the previous statuses.
+func ownedAlertConfigs(alertConfigIDs []string, previous []status.AlertConfiguration) []string {
+	owned := make([]string, 0, len(alertConfigIDs))
+	for _, alertConfigID := range alertConfigIDs {
+		if slices.ContainsFunc(previous, func(alertConfig status.AlertConfiguration) bool { return alertConfig.ID == alertConfigID }) {
+			owned = append(owned, alertConfigID)
+		}
+	}
+	return owned
+}
+
+func alertConfigStatuses(alertConfigIDs []string, previous []status.AlertConfiguration) []status.AlertConfiguration {
+	var result []status.AlertConfiguration
+	for _, alertConfig := range previous {
+		if alertConfig.ID != "" && slices.Contains(alertConfigIDs, alertConfig.ID) {
+			result = append(result, alertConfig)
+		}
+	}
+	return result
+}
+
 func checkAlertConfigurationStatuses(statuses []status.AlertConfiguration) workflow.DeprecatedResult {
 	for _, alertConfigurationStatus := range statuses {
 		if alertConfigurationStatus.ErrorMessage != "" {
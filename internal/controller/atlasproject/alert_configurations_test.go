@@ -454,6 +454,8 @@ func TestSyncAlertConfigurations(t *testing.T) {
 		groupID              string
 		alertSpecs           []akov2.AlertConfiguration
 		existingAlertConfigs []admin.GroupAlertsConfig
+		previous             []status.AlertConfiguration
+		protected            bool
 		mockAlertConfigsAPI  func() *mockadmin.AlertConfigurationsAPI
 		expectOKResult       bool
 		expectedCreateCount  int
@@ -520,6 +522,59 @@ func TestSyncAlertConfigurations(t *testing.T) {
 			expectedCreateCount: 1,
 			expectedDeleteCount: 0,
 		},
+		{
+			name:       "Delete all alert configurations not in spec",
+			groupID:    "test-group-id",
+			alertSpecs: []akov2.AlertConfiguration{},
+			previous:   []status.AlertConfiguration{{ID: "operator-alert-id"}},
+			mockAlertConfigsAPI: func() *mockadmin.AlertConfigurationsAPI {
+				apiMock := mockadmin.NewAlertConfigurationsAPI(t)
+				apiMock.EXPECT().ListAlertConfigs(mock.Anything, "test-group-id").
+					Return(admin.ListAlertConfigsApiRequest{ApiService: apiMock})
+				apiMock.EXPECT().ListAlertConfigsExecute(mock.Anything).
+					Return(&admin.PaginatedAlertConfig{
+						Results: []admin.GroupAlertsConfig{
+							{Id: new("operator-alert-id"), EventTypeName: new("OUTSIDE_METRIC_THRESHOLD")},
+							{Id: new("manual-alert-id"), EventTypeName: new("HOST_DOWN")},
+						},
+					}, &http.Response{StatusCode: 200}, nil)
+				apiMock.EXPECT().DeleteAlertConfig(mock.Anything, "test-group-id", "operator-alert-id").
+					Return(admin.DeleteAlertConfigApiRequest{ApiService: apiMock})
+				apiMock.EXPECT().DeleteAlertConfig(mock.Anything, "test-group-id", "manual-alert-id").
+					Return(admin.DeleteAlertConfigApiRequest{ApiService: apiMock})
+				apiMock.EXPECT().DeleteAlertConfigExecute(mock.Anything).
+					Return(&http.Response{StatusCode: 204}, nil).Times(2)
+				return apiMock
+			},
+			expectOKResult:      true,
+			expectedDeleteCount: 2,
+		},
+		{
+			name:       "Delete only operator alert configurations with sub-object deletion protection",
+			groupID:    "test-group-id",
+			alertSpecs: []akov2.AlertConfiguration{},
+			previous:   []status.AlertConfiguration{{ID: "operator-alert-id"}},
+			protected:  true,
+			mockAlertConfigsAPI: func() *mockadmin.AlertConfigurationsAPI {
+				apiMock := mockadmin.NewAlertConfigurationsAPI(t)
+				apiMock.EXPECT().ListAlertConfigs(mock.Anything, "test-group-id").
+					Return(admin.ListAlertConfigsApiRequest{ApiService: apiMock})
+				apiMock.EXPECT().ListAlertConfigsExecute(mock.Anything).
+					Return(&admin.PaginatedAlertConfig{
+						Results: []admin.GroupAlertsConfig{
+							{Id: new("operator-alert-id"), EventTypeName: new("OUTSIDE_METRIC_THRESHOLD")},
+							{Id: new("manual-alert-id"), EventTypeName: new("HOST_DOWN")},
+						},
+					}, &http.Response{StatusCode: 200}, nil)
+				apiMock.EXPECT().DeleteAlertConfig(mock.Anything, "test-group-id", "operator-alert-id").
+					Return(admin.DeleteAlertConfigApiRequest{ApiService: apiMock})
+				apiMock.EXPECT().DeleteAlertConfigExecute(mock.Anything).
+					Return(&http.Response{StatusCode: 204}, nil).Once()
+				return apiMock
+			},
+			expectOKResult:      true,
+			expectedDeleteCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -542,7 +597,7 @@ func TestSyncAlertConfigurations(t *testing.T) {
 				SdkClientSet: atlasClientSet,
 			}
 
-			result := syncAlertConfigurations(workflowCtx, tt.groupID, tt.alertSpecs)
+			result := syncAlertConfigurations(workflowCtx, tt.groupID, tt.alertSpecs, tt.previous, tt.protected)
 
 			if tt.expectOKResult {
 				assert.True(t, result.IsOk())
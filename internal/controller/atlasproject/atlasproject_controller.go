@@ -31,14 +31,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
@@ -53,16 +56,18 @@ import (
 
 // AtlasProjectReconciler reconciles a AtlasProject object
 type AtlasProjectReconciler struct {
-	Client                      client.Client
-	Log                         *zap.SugaredLogger
-	Scheme                      *runtime.Scheme
-	GlobalPredicates            []predicate.Predicate
-	EventRecorder               record.EventRecorder
-	AtlasProvider               atlas.Provider
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
-	GlobalSecretRef             client.ObjectKey
-	maxConcurrentReconciles     int
+	Client                       client.Client
+	Log                          *zap.SugaredLogger
+	Scheme                       *runtime.Scheme
+	GlobalPredicates             []predicate.Predicate
+	EventRecorder                record.EventRecorder
+	AtlasProvider                atlas.Provider
+	ObjectDeletionProtection     bool
+	SubObjectDeletionProtection  bool
+	DestructiveOperationApproval bool
+	GlobalSecretRef              client.ObjectKey
+	AtlasNotifications           <-chan event.GenericEvent
+	maxConcurrentReconciles      int
 }
 
 type AtlasProjectServices struct {
@@ -132,12 +137,24 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		r.Log.Debugf("project validation result: %v", resourceVersionIsValid)
 		return resourceVersionIsValid.ReconcileResult()
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, atlasProject, "AtlasProject")
+	if !policyIsValid.IsOk() {
+		r.Log.Debugf("project reconciliation policy validation result: %v", policyIsValid)
+		return policyIsValid.ReconcileResult()
+	}
 
 	if err := validate.Project(atlasProject, r.AtlasProvider.IsCloudGov()); err != nil {
 		result := workflow.Terminate(workflow.Internal, err)
 		setCondition(workflowCtx, api.ValidationSucceeded, result)
 		return result.ReconcileResult()
 	}
+	if atlasProject.GetDeletionTimestamp().IsZero() {
+		if err := policy.CheckProject(ctx, r.Client, atlasProject); err != nil {
+			result := policy.Terminate(err)
+			setCondition(workflowCtx, api.ValidationSucceeded, result)
+			return result.ReconcileResult()
+		}
+	}
 	workflowCtx.SetConditionTrue(api.ValidationSucceeded)
 
 	if !r.AtlasProvider.IsResourceSupported(atlasProject) {
@@ -180,7 +197,7 @@ func (r *AtlasProjectReconciler) ensureProjectResources(workflowCtx *workflow.Co
 	}
 
 	var result workflow.DeprecatedResult
-	if result = handleIPAccessList(workflowCtx, project); result.IsOk() {
+	if result = handleIPAccessList(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(api.IPAccessListReadyType), "")
 	}
 	results = append(results, result)
@@ -200,7 +217,7 @@ func (r *AtlasProjectReconciler) ensureProjectResources(workflowCtx *workflow.Co
 	}
 	results = append(results, result)
 
-	if result = ensureNetworkPeers(workflowCtx, project); result.IsOk() {
+	if result = ensureNetworkPeers(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(api.NetworkPeerReadyType), "")
 	}
 	results = append(results, result)
@@ -263,7 +280,7 @@ func (r *AtlasProjectReconciler) For() (client.Object, builder.Predicates) {
 }
 
 func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasProject").
 		For(r.For()).
 		Watches(
@@ -280,7 +297,13 @@ func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager, skipNameVali
 			&akov2.AtlasBackupCompliancePolicy{},
 			handler.EnqueueRequestsFromMapFunc(newProjectsMapFunc[akov2.AtlasBackupCompliancePolicy](indexer.AtlasProjectByBackupCompliancePolicyIndex, r.Client, r.Log)),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
-		).
+		)
+
+	if r.AtlasNotifications != nil {
+		b = b.WatchesRawSource(source.Channel(r.AtlasNotifications, &handler.EnqueueRequestForObject{}))
+	}
+
+	return b.
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation:      new(skipNameValidation),
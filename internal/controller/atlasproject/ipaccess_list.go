@@ -30,10 +30,11 @@ const ipAccessStatusPending = "PENDING"
 const ipAccessStatusFailed = "FAILED"
 
 type ipAccessListController struct {
-	ctx         *workflow.Context
-	project     *akov2.AtlasProject
-	service     ipaccesslist.IPAccessListService
-	lastApplied ipaccesslist.IPAccessEntries
+	ctx                         *workflow.Context
+	project                     *akov2.AtlasProject
+	service                     ipaccesslist.IPAccessListService
+	lastApplied                 ipaccesslist.IPAccessEntries
+	subObjectDeletionProtection bool
 }
 
 // reconcile dispatch state transitions
@@ -70,7 +71,7 @@ func (i *ipAccessListController) configure(current, desired ipaccesslist.IPAcces
 	managedEntries := len(i.lastApplied) > 0
 	if managedEntries {
 		for key, entry := range current {
-			if _, ok := desired[key]; !ok {
+			if _, ok := desired[key]; !ok && i.owns(key) {
 				err = i.service.Delete(i.ctx.Context, i.project.ID(), entry)
 				if err != nil {
 					return i.terminate(workflow.ProjectIPNotCreatedInAtlas, err)
@@ -86,6 +87,17 @@ func (i *ipAccessListController) configure(current, desired ipaccesslist.IPAcces
 	return i.progress(desired)
 }
 
+// owns returns whether the entry can be removed from Atlas. With the sub-object deletion protection, only the
+// entries of the last applied configuration were created by the operator, the others are left untouched.
+func (i *ipAccessListController) owns(key string) bool {
+	if !i.subObjectDeletionProtection {
+		return true
+	}
+
+	_, ok := i.lastApplied[key]
+	return ok
+}
+
 // progress transitions to pending while ip access list are not active
 func (i *ipAccessListController) progress(ipAccessEntries ipaccesslist.IPAccessEntries) workflow.DeprecatedResult {
 	for _, entry := range ipAccessEntries.GetByStatus(false) {
@@ -138,7 +150,7 @@ func (i *ipAccessListController) unmanage() workflow.DeprecatedResult {
 }
 
 // handleIPAccessList prepare internal ip access list controller to handle states
-func handleIPAccessList(ctx *workflow.Context, project *akov2.AtlasProject) workflow.DeprecatedResult {
+func handleIPAccessList(ctx *workflow.Context, project *akov2.AtlasProject, subObjectDeletionProtection bool) workflow.DeprecatedResult {
 	ctx.Log.Debug("starting ip access list processing")
 	defer ctx.Log.Debug("finished ip access list processing")
 
@@ -148,10 +160,11 @@ func handleIPAccessList(ctx *workflow.Context, project *akov2.AtlasProject) work
 	}
 
 	c := ipAccessListController{
-		ctx:         ctx,
-		project:     project,
-		service:     ipaccesslist.NewIPAccessList(ctx.SdkClientSet.SdkClient20250312.ProjectIPAccessListAPI),
-		lastApplied: lastApplied,
+		ctx:                         ctx,
+		project:                     project,
+		service:                     ipaccesslist.NewIPAccessList(ctx.SdkClientSet.SdkClient20250312.ProjectIPAccessListAPI),
+		lastApplied:                 lastApplied,
+		subObjectDeletionProtection: subObjectDeletionProtection,
 	}
 
 	return c.reconcile()
@@ -494,7 +494,7 @@ func TestHandleIPAccessList(t *testing.T) {
 			}
 			p.WithAnnotations(tt.annotations)
 
-			result := handleIPAccessList(ctx, p)
+			result := handleIPAccessList(ctx, p, false)
 			if tt.expectedResult.GetError() != nil {
 				assert.ErrorContains(t, result.GetError(), tt.expectedResult.GetError().Error())
 			} else {
@@ -547,11 +547,12 @@ func TestMapLastAppliedIPAccessList(t *testing.T) {
 
 func TestIPAccessListNonGreedyBehaviour(t *testing.T) {
 	for _, tc := range []struct {
-		title                   string
-		lastAppliedIPAccessList []string
-		specIPAccessList        []string
-		atlasIPAccessList       []string
-		wantRemoved             []string
+		title                       string
+		lastAppliedIPAccessList     []string
+		specIPAccessList            []string
+		atlasIPAccessList           []string
+		subObjectDeletionProtection bool
+		wantRemoved                 []string
 	}{
 		{
 			title:                   "no last applied no removal in Atlas",
@@ -581,6 +582,22 @@ func TestIPAccessListNonGreedyBehaviour(t *testing.T) {
 			atlasIPAccessList:       []string{"100.90.0.0/24", "101.99.0.0/24"},
 			wantRemoved:             []string{"101.99.0.0/24"},
 		},
+		{
+			title:                       "not in last applied not removed from Atlas with sub-object deletion protection",
+			lastAppliedIPAccessList:     []string{"100.90.0.0/24"},
+			specIPAccessList:            []string{"100.90.0.0/24"},
+			atlasIPAccessList:           []string{"100.90.0.0/24", "101.99.0.0/24"},
+			subObjectDeletionProtection: true,
+			wantRemoved:                 []string{},
+		},
+		{
+			title:                       "removed from last applied removes only from Atlas with sub-object deletion protection",
+			lastAppliedIPAccessList:     []string{"100.90.0.0/24", "101.99.0.0/24"},
+			specIPAccessList:            []string{"100.90.0.0/24"},
+			atlasIPAccessList:           []string{"100.90.0.0/24", "101.99.0.0/24", "102.98.0.0/24"},
+			subObjectDeletionProtection: true,
+			wantRemoved:                 []string{"101.99.0.0/24"},
+		},
 	} {
 		t.Run(tc.title, func(t *testing.T) {
 			prj := newIPAccessListTestProject(tc.specIPAccessList)
@@ -634,7 +651,7 @@ func TestIPAccessListNonGreedyBehaviour(t *testing.T) {
 				},
 			}
 
-			result := handleIPAccessList(&workflowCtx, prj)
+			result := handleIPAccessList(&workflowCtx, prj, tc.subObjectDeletionProtection)
 			require.Equal(t, workflow.OK(), result)
 		})
 	}
@@ -61,7 +61,7 @@ func lastAppliedNetworkPeerings(atlasProject *akov2.AtlasProject) ([]akov2.Netwo
 	return lastApplied.NetworkPeers, nil
 }
 
-func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *akov2.AtlasProject) workflow.DeprecatedResult {
+func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *akov2.AtlasProject, subObjectDeletionProtection bool) workflow.DeprecatedResult {
 	lastAppliedPeers, err := lastAppliedNetworkPeerings(akoProject)
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err)
@@ -70,7 +70,7 @@ func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *akov2.AtlasPr
 	networkPeerStatus := akoProject.Status.DeepCopy().NetworkPeers
 	networkPeerSpec := akoProject.Spec.DeepCopy().NetworkPeers
 
-	result, condition := SyncNetworkPeer(workflowCtx, akoProject.ID(), networkPeerStatus, networkPeerSpec, lastAppliedPeers)
+	result, condition := SyncNetworkPeer(workflowCtx, akoProject.ID(), networkPeerStatus, networkPeerSpec, lastAppliedPeers, subObjectDeletionProtection)
 	if !result.IsOk() {
 		workflowCtx.SetConditionFromResult(condition, result)
 		return result
@@ -101,8 +101,13 @@ func failedPeerStatus(errMessage string, peer akov2.NetworkPeer) status.AtlasNet
 	}
 }
 
-func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses []status.AtlasNetworkPeer, peerSpecs []akov2.NetworkPeer, lastAppliedPeers []akov2.NetworkPeer) (workflow.DeprecatedResult, api.ConditionType) {
+func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses []status.AtlasNetworkPeer, peerSpecs []akov2.NetworkPeer, lastAppliedPeers []akov2.NetworkPeer, subObjectDeletionProtection bool) (workflow.DeprecatedResult, api.ConditionType) {
 	defer workflowCtx.EnsureStatusOption(status.AtlasProjectSetNetworkPeerOption(&peerStatuses))
+	// with the sub-object deletion protection, only the containers of the peers the operator created can be removed
+	var deletableContainers []string
+	if subObjectDeletionProtection {
+		deletableContainers = getPeerIDs(peerStatuses)
+	}
 	logger := workflowCtx.Log
 	mongoClient := workflowCtx.SdkClientSet.SdkClient20250312
 	logger.Debugf("syncing network peers for project %v", groupID)
@@ -137,7 +142,7 @@ func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses
 			errors.New("failed to update network peer statuses")), api.NetworkPeerReadyType
 	}
 	if len(lastAppliedPeers) > 0 {
-		err = deleteUnusedContainers(workflowCtx.Context, mongoClient.NetworkPeeringAPI, groupID, getPeerIDs(peerStatuses))
+		err = deleteUnusedContainers(workflowCtx.Context, mongoClient.NetworkPeeringAPI, groupID, getPeerIDs(peerStatuses), deletableContainers)
 		if err != nil {
 			logger.Errorf("failed to delete unused containers: %v", err)
 			return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas,
@@ -175,7 +180,9 @@ func getPeerIDs(statuses []status.AtlasNetworkPeer) []string {
 	return ids
 }
 
-func deleteUnusedContainers(context context.Context, containerService admin.NetworkPeeringAPI, groupID string, doNotDelete []string) error {
+// deleteUnusedContainers removes the unprovisioned containers not listed in doNotDelete. A non-nil deletable list
+// restricts the removal to the containers it contains.
+func deleteUnusedContainers(context context.Context, containerService admin.NetworkPeeringAPI, groupID string, doNotDelete, deletable []string) error {
 	containers, _, err := containerService.ListGroupContainerAll(context, groupID).Execute()
 	if err != nil {
 		return err
@@ -184,6 +191,9 @@ func deleteUnusedContainers(context context.Context, containerService admin.Netw
 		if container.GetProvisioned() { // a provisioned container is in use, should not be removed
 			continue
 		}
+		if deletable != nil && !compare.Contains(deletable, container.GetId()) { // not created by the operator
+			continue
+		}
 		if !compare.Contains(doNotDelete, container.GetId()) {
 			response, errDelete := containerService.DeleteGroupContainer(context, groupID, container.GetId()).Execute()
 			statusCode := httputil.StatusCode(response)
@@ -116,12 +116,66 @@ func TestNetworkPeeringsNonGreedyBehaviour(t *testing.T) {
 				},
 			}
 
-			result := ensureNetworkPeers(&workflowCtx, prj)
+			result := ensureNetworkPeers(&workflowCtx, prj, false)
 			require.Equal(t, workflow.OK(), result)
 		})
 	}
 }
 
+func TestDeleteUnusedContainers(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		doNotDelete []string
+		deletable   []string
+		wantRemoved []string
+	}{
+		{
+			title:       "all unused containers removed",
+			doNotDelete: []string{"container-1"},
+			wantRemoved: []string{"container-2", "container-3"},
+		},
+		{
+			title:       "only deletable unused containers removed",
+			doNotDelete: []string{"container-1"},
+			deletable:   []string{"container-1", "container-2"},
+			wantRemoved: []string{"container-2"},
+		},
+		{
+			title:       "no container removed when none is deletable",
+			doNotDelete: []string{"container-1"},
+			deletable:   []string{},
+			wantRemoved: []string{},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			peeringAPI := mockadmin.NewNetworkPeeringAPI(t)
+			peeringAPI.EXPECT().ListGroupContainerAll(mock.Anything, "project-id").
+				Return(admin.ListGroupContainerAllApiRequest{ApiService: peeringAPI})
+			peeringAPI.EXPECT().ListGroupContainerAllExecute(mock.Anything).Return(
+				&admin.PaginatedCloudProviderContainer{
+					Results: []admin.CloudProviderContainer{
+						{Id: new("container-1")},
+						{Id: new("container-2")},
+						{Id: new("container-3")},
+						{Id: new("container-4"), Provisioned: new(true)},
+					},
+				}, nil, nil,
+			)
+			for _, id := range tc.wantRemoved {
+				peeringAPI.EXPECT().DeleteGroupContainer(mock.Anything, "project-id", id).
+					Return(admin.DeleteGroupContainerApiRequest{ApiService: peeringAPI}).Once()
+			}
+			if len(tc.wantRemoved) > 0 {
+				peeringAPI.EXPECT().DeleteGroupContainerExecute(mock.Anything).
+					Return(nil, nil).Times(len(tc.wantRemoved))
+			}
+
+			err := deleteUnusedContainers(context.Background(), peeringAPI, "project-id", tc.doNotDelete, tc.deletable)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func newNetworkPeeringTestProject(networkPeers []string) *akov2.AtlasProject {
 	return &akov2.AtlasProject{
 		ObjectMeta: metav1.ObjectMeta{
@@ -25,6 +25,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/approval"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
@@ -157,6 +158,17 @@ func (r *AtlasProjectReconciler) delete(ctx *workflow.Context, services *AtlasPr
 		if customresource.IsResourcePolicyKeepOrDefault(atlasProject, r.ObjectDeletionProtection) {
 			r.Log.Info("Not removing Project from Atlas as per configuration")
 		} else {
+			operation := fmt.Sprintf("delete project %s", atlasProject.Spec.Name)
+			approvalStatus, approved := approval.Check(r.EventRecorder, atlasProject, r.DestructiveOperationApproval, atlasProject.Status.Approval, operation)
+			if approvalStatus != nil || atlasProject.Status.Approval != nil {
+				ctx.EnsureStatusOption(status.AtlasProjectApprovalOption(approvalStatus))
+			}
+			if !approved {
+				result := approval.Terminate(approvalStatus)
+				ctx.SetConditionFromResult(api.ProjectReadyType, result)
+				return result.ReconcileResult()
+			}
+
 			if result := DeleteAllPrivateEndpoints(ctx, atlasProject); !result.IsOk() {
 				return r.terminate(ctx, workflow.ServerlessPrivateEndpointReady, errors.New(result.GetMessage()))
 			}
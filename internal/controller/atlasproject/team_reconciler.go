@@ -59,6 +59,11 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 			r.Log.Debugf("team validation result: %v", resourceVersionIsValid)
 			return resourceVersionIsValid.ReconcileResult()
 		}
+		policyIsValid := customresource.ValidateReconciliationPolicy(teamCtx, team, "AtlasTeam")
+		if !policyIsValid.IsOk() {
+			r.Log.Debugf("team reconciliation policy validation result: %v", policyIsValid)
+			return policyIsValid.ReconcileResult()
+		}
 
 		if !r.AtlasProvider.IsResourceSupported(team) {
 			result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasTeam is not supported by Atlas for government")).
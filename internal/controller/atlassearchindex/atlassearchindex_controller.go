@@ -0,0 +1,164 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+// AtlasSearchIndexReconciler reconciles a AtlasSearchIndex object
+type AtlasSearchIndexReconciler struct {
+	reconciler.AtlasReconciler
+	Scheme                   *runtime.Scheme
+	EventRecorder            record.EventRecorder
+	GlobalPredicates         []predicate.Predicate
+	ObjectDeletionProtection bool
+	maxConcurrentReconciles  int
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindexes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindexes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindexes/finalizers,verbs=update
+
+// Reconcile Atlas Search Index resources
+func (r *AtlasSearchIndexReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log.Infow("-> Starting AtlasSearchIndex reconciliation")
+
+	index := akov2.AtlasSearchIndex{}
+	result := customresource.PrepareResource(ctx, r.Client, req, &index, r.Log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+	return r.handleCustomResource(ctx, &index)
+}
+
+// For prepares the controller for its target Custom Resource; Search Indexes
+func (r *AtlasSearchIndexReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasSearchIndex{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Indexes are updated when their AtlasSearchIndexConfig changes, builds in progress are polled.
+// Indexes are also reconciled when an AtlasDeployment of the same deployment changes, to take over the
+// indexes it stops managing.
+func (r *AtlasSearchIndexReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.For()).
+		Watches(
+			&akov2.AtlasSearchIndexConfig{},
+			handler.EnqueueRequestsFromMapFunc(r.searchIndexesForConfig),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&akov2.AtlasDeployment{},
+			handler.EnqueueRequestsFromMapFunc(r.searchIndexesForDeployment),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(controller.TypedOptions[reconcile.Request]{
+			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
+			SkipNameValidation:      new(skipNameValidation),
+			MaxConcurrentReconciles: r.maxConcurrentReconciles}).
+		Complete(r)
+}
+
+func (r *AtlasSearchIndexReconciler) searchIndexesForConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	config, ok := obj.(*akov2.AtlasSearchIndexConfig)
+	if !ok {
+		r.Log.Warnf("watching AtlasSearchIndexConfig but got %T", obj)
+		return nil
+	}
+
+	indexes := &akov2.AtlasSearchIndexList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasSearchIndexBySearchIndexConfigIndex,
+			client.ObjectKeyFromObject(config).String(),
+		),
+	}
+	if err := r.Client.List(ctx, indexes, listOpts); err != nil {
+		r.Log.Errorf("failed to list AtlasSearchIndexes of config %s: %v", client.ObjectKeyFromObject(config), err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(indexes.Items))
+	for i := range indexes.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&indexes.Items[i])})
+	}
+	return requests
+}
+
+// searchIndexesForDeployment maps an AtlasDeployment to the indexes of its Atlas deployment.
+// The AtlasDeployment releases an index in its status, so status changes are watched as well.
+func (r *AtlasSearchIndexReconciler) searchIndexesForDeployment(ctx context.Context, obj client.Object) []reconcile.Request {
+	deployment, ok := obj.(*akov2.AtlasDeployment)
+	if !ok {
+		r.Log.Warnf("watching AtlasDeployment but got %T", obj)
+		return nil
+	}
+
+	indexes := &akov2.AtlasSearchIndexList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasSearchIndexByDeploymentNameIndex,
+			deployment.GetDeploymentName(),
+		),
+	}
+	if err := r.Client.List(ctx, indexes, listOpts); err != nil {
+		r.Log.Errorf("failed to list AtlasSearchIndexes of deployment %s: %v", deployment.GetDeploymentName(), err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(indexes.Items))
+	for i := range indexes.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&indexes.Items[i])})
+	}
+	return requests
+}
+
+func NewAtlasSearchIndexReconciler(c cluster.Cluster, predicates []predicate.Predicate, atlasProvider atlas.Provider, deletionProtection bool, logger *zap.Logger, globalSecretRef client.ObjectKey, maxConcurrentReconciles int) *AtlasSearchIndexReconciler {
+	return &AtlasSearchIndexReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			Log:             logger.Named("controllers").Named("AtlasSearchIndex").Sugar(),
+			GlobalSecretRef: globalSecretRef,
+			AtlasProvider:   atlasProvider,
+		},
+		Scheme:                   c.GetScheme(),
+		EventRecorder:            c.GetEventRecorderFor("AtlasSearchIndex"),
+		GlobalPredicates:         predicates,
+		ObjectDeletionProtection: deletionProtection,
+		maxConcurrentReconciles:  maxConcurrentReconciles,
+	}
+}
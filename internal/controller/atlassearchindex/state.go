@@ -0,0 +1,223 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+)
+
+const (
+	typeName = "AtlasSearchIndex"
+
+	// indexStateReady and indexStateFailed are the final build states reported by Atlas,
+	// the index is still being built in any other state.
+	indexStateReady  = "READY"
+	indexStateFailed = "FAILED"
+)
+
+type reconcileRequest struct {
+	projectID   string
+	clusterName string
+	index       *akov2.AtlasSearchIndex
+	service     searchindex.AtlasSearchIdxService
+}
+
+func (r *AtlasSearchIndexReconciler) handleCustomResource(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(index) {
+		return r.Skip(ctx, typeName, index, index.Spec)
+	}
+
+	conditions := api.InitCondition(index, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, index)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, index)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, index, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, index, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(index) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	deleted := index.DeletionTimestamp != nil
+	if deleted && index.Status.ID == "" {
+		// the index was never created, nothing to delete in Atlas
+		return r.unmanage(workflowCtx, index)
+	}
+
+	req, err := r.newReconcileRequest(ctx, index)
+	if deleted && apierrors.IsNotFound(err) {
+		// the parent deployment is gone, along with its search indexes
+		return r.unmanage(workflowCtx, index)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, index, workflow.SearchIndexNotConfigured, err)
+	}
+	if deleted {
+		return r.delete(workflowCtx, req)
+	}
+	return r.handle(workflowCtx, req)
+}
+
+// newReconcileRequest resolves the Atlas project and deployment of the index,
+// either through the referenced AtlasDeployment or the external deployment reference.
+func (r *AtlasSearchIndexReconciler) newReconcileRequest(ctx context.Context, index *akov2.AtlasSearchIndex) (*reconcileRequest, error) {
+	var referrer project.ProjectReferrerObject = index
+	var clusterName string
+	if index.Spec.DeploymentRef != nil {
+		deployment, err := r.fetchDeployment(ctx, index)
+		if err != nil {
+			return nil, err
+		}
+		referrer = deployment
+		clusterName = deployment.GetDeploymentName()
+	} else {
+		clusterName = index.Spec.ExternalDeploymentRef.Name
+	}
+
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, referrer)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return nil, err
+	}
+	atlasProject, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312, referrer)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		projectID:   atlasProject.ID,
+		clusterName: clusterName,
+		index:       index,
+		service:     searchindex.NewSearchIndexes(sdkClientSet.SdkClient20250312.AtlasSearchAPI),
+	}, nil
+}
+
+func (r *AtlasSearchIndexReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	deployment, err := r.inlineConflict(workflowCtx.Context, req)
+	if err != nil {
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, err)
+	}
+	if deployment != nil {
+		return r.conflict(workflowCtx, req, deployment)
+	}
+
+	stateInAKO, err := r.desiredState(workflowCtx.Context, req.index)
+	if err != nil {
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, err)
+	}
+
+	id := req.index.Status.ID
+	if id == "" {
+		// the index may exist already, e.g. handed over by an AtlasDeployment or created before its ID was recorded
+		stateInAtlas, err := req.service.FindIndex(workflowCtx.Context, req.projectID, req.clusterName, stateInAKO)
+		if errors.Is(err, searchindex.ErrNotFound) {
+			return r.create(workflowCtx, req, stateInAKO)
+		}
+		if err != nil {
+			wrappedErr := fmt.Errorf("failed to look up search index %s of cluster %s: %w", req.index.Spec.Name, req.clusterName, err)
+			return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, wrappedErr)
+		}
+		return r.adopt(workflowCtx, req, stateInAKO, stateInAtlas)
+	}
+	stateInAtlas, err := req.service.GetIndex(workflowCtx.Context, req.projectID, req.clusterName, req.index.Spec.Name, id)
+	if errors.Is(err, searchindex.ErrNotFound) {
+		// unlike snapshots, an index removed from Atlas can be created again from its spec
+		r.Log.Infow("search index was removed from Atlas, creating it again", "id", id)
+		return r.create(workflowCtx, req, stateInAKO)
+	}
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to get search index %s of cluster %s: %w", id, req.clusterName, err)
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, wrappedErr)
+	}
+	return r.sync(workflowCtx, req, stateInAKO, stateInAtlas)
+}
+
+// desiredState returns the index of the spec, completed with its AtlasSearchIndexConfig for the "search" type.
+func (r *AtlasSearchIndexReconciler) desiredState(ctx context.Context, index *akov2.AtlasSearchIndex) (*searchindex.SearchIndex, error) {
+	if index.Spec.Search == nil {
+		// vector search indexes don't require any external configuration
+		return searchindex.NewSearchIndex(&index.Spec.SearchIndex, &akov2.AtlasSearchIndexConfigSpec{}), nil
+	}
+	config := &akov2.AtlasSearchIndexConfig{}
+	key := index.Spec.Search.SearchConfigurationRef.GetObject(index.Namespace)
+	if err := r.Client.Get(ctx, *key, config); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasSearchIndexConfig %s: %w", key, err)
+	}
+	return searchindex.NewSearchIndex(&index.Spec.SearchIndex, &config.Spec), nil
+}
+
+// inlineConflict returns the AtlasDeployment still declaring an index of the same name in its spec or status.
+// Both resources would manage the same Atlas index otherwise, so the index is only handed over to the
+// AtlasSearchIndex once the AtlasDeployment no longer manages it.
+func (r *AtlasSearchIndexReconciler) inlineConflict(ctx context.Context, req *reconcileRequest) (*akov2.AtlasDeployment, error) {
+	deployments := &akov2.AtlasDeploymentList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasDeploymentByProject, req.projectID),
+	}
+	if err := r.Client.List(ctx, deployments, listOpts); err != nil {
+		return nil, fmt.Errorf("failed to list deployments of project %s: %w", req.projectID, err)
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.GetDeploymentName() == req.clusterName && declaresInline(deployment, req.index.Spec.Name) {
+			return deployment, nil
+		}
+	}
+	return nil, nil
+}
+
+func declaresInline(deployment *akov2.AtlasDeployment, name string) bool {
+	if deployment.Spec.DeploymentSpec != nil && slices.ContainsFunc(deployment.Spec.DeploymentSpec.SearchIndexes,
+		func(index akov2.SearchIndex) bool { return index.Name == name }) {
+		return true
+	}
+	return slices.ContainsFunc(deployment.Status.SearchIndexes,
+		func(index status.DeploymentSearchIndexStatus) bool { return index.Name == name })
+}
+
+func (r *AtlasSearchIndexReconciler) fetchDeployment(ctx context.Context, index *akov2.AtlasSearchIndex) (*akov2.AtlasDeployment, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := index.Spec.DeploymentRef.GetObject(index.Namespace)
+	if err := r.Client.Get(ctx, *key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasDeployment %s: %w", key, err)
+	}
+	return deployment, nil
+}
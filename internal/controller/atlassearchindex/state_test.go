@@ -0,0 +1,541 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+	searchfake "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex/fake"
+)
+
+const (
+	testProjectID = "project-id"
+
+	testClusterName = "test-cluster"
+
+	testIndexID = "6641b7e0a7e3f02d9fb2e0a1"
+)
+
+const conflictMsg = "search index index-name is also declared in AtlasDeployment default/deployment, " +
+	"remove it from the AtlasDeployment to manage it with an AtlasSearchIndex"
+
+var ErrTestFail = errors.New("failure")
+
+func TestHandleCustomResourceDeletion(t *testing.T) {
+	deletionTime := metav1.Now()
+	index := &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "index",
+			Namespace:         "default",
+			Finalizers:        []string{customresource.FinalizerLabel},
+			DeletionTimestamp: &deletionTime,
+		},
+		Spec: testSpec(),
+	}
+	k8sClient := testClient(t, index)
+	// the index was never created, Atlas is never reached
+	provider := &atlasmock.TestProvider{
+		IsSupportedFunc: func() bool { return true },
+	}
+	r := testReconciler(k8sClient, provider, zaptest.NewLogger(t))
+	result, err := r.handleCustomResource(context.Background(), index)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Empty(t, getIndex(t, k8sClient, client.ObjectKeyFromObject(index)).GetFinalizers())
+}
+
+func TestHandle(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		status         status.AtlasSearchIndexStatus
+		objects        []client.Object
+		service        func(t *testing.T) searchindex.AtlasSearchIdxService
+		wantResult     ctrl.Result
+		wantFinalizers []string
+		wantStatus     status.AtlasSearchIndexStatus
+		wantConditions []api.Condition
+	}{
+		{
+			title:   "creates an index",
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					FindIndexFunc: func(_ context.Context, _, _ string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, "index-name", index.Name)
+						return nil, searchindex.ErrNotFound
+					},
+					CreateIndexFunc: func(_ context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, testProjectID, projectID)
+						assert.Equal(t, testClusterName, clusterName)
+						assert.Equal(t, "lucene.standard", *index.Analyzer)
+						return atlasIndex("PENDING", false), nil
+					},
+				}
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus("PENDING", false),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.SearchIndexReady).WithReason(string(workflow.SearchIndexInProgress)).
+					WithMessageRegexp("Search index index-name is PENDING"),
+			},
+		},
+		{
+			title:   "adopts an existing index",
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					FindIndexFunc: func(_ context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, testProjectID, projectID)
+						assert.Equal(t, testClusterName, clusterName)
+						assert.Equal(t, "index-name", index.Name)
+						return atlasIndex("READY", true), nil
+					},
+				}
+			},
+			wantResult:     ctrl.Result{},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus("READY", true),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.SearchIndexReady).WithMessageRegexp("Search index index-name is READY"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:   "fails to look up an existing index",
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					FindIndexFunc: func(_ context.Context, _, _ string, _ *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						return nil, ErrTestFail
+					},
+				}
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.SearchIndexNotConfigured)).
+					WithMessageRegexp("failed to look up search index index-name of cluster test-cluster: failure"),
+			},
+		},
+		{
+			title:   "reports a ready index",
+			status:  status.AtlasSearchIndexStatus{ID: testIndexID, State: "BUILDING"},
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					GetIndexFunc: func(_ context.Context, _, _, _, indexID string) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, testIndexID, indexID)
+						return atlasIndex("READY", true), nil
+					},
+				}
+			},
+			wantResult: ctrl.Result{},
+			wantStatus: testStatus("READY", true),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.SearchIndexReady).WithMessageRegexp("Search index index-name is READY"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:   "updates an index differing from the spec",
+			status:  status.AtlasSearchIndexStatus{ID: testIndexID, State: "READY"},
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					GetIndexFunc: func(_ context.Context, _, _, _, _ string) (*searchindex.SearchIndex, error) {
+						index := atlasIndex("READY", true)
+						index.Analyzer = new("lucene.simple")
+						return index, nil
+					},
+					UpdateIndexFunc: func(_ context.Context, _, _ string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, testIndexID, index.GetID())
+						assert.Equal(t, "lucene.standard", *index.Analyzer)
+						return atlasIndex("PENDING", true), nil
+					},
+				}
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantStatus: testStatus("PENDING", true),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.SearchIndexReady).WithReason(string(workflow.SearchIndexInProgress)).
+					WithMessageRegexp("Search index index-name is PENDING, the previous version of the index can still be queried"),
+			},
+		},
+		{
+			title:   "creates an index removed from Atlas again",
+			status:  status.AtlasSearchIndexStatus{ID: testIndexID, State: "READY"},
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					GetIndexFunc: func(_ context.Context, _, _, _, _ string) (*searchindex.SearchIndex, error) {
+						return nil, searchindex.ErrNotFound
+					},
+					CreateIndexFunc: func(_ context.Context, _, _ string, _ *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						return atlasIndex("PENDING", false), nil
+					},
+				}
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus("PENDING", false),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.SearchIndexReady).WithReason(string(workflow.SearchIndexInProgress)).
+					WithMessageRegexp("Search index index-name is PENDING"),
+			},
+		},
+		{
+			title:   "reports a failed index without retrying",
+			status:  status.AtlasSearchIndexStatus{ID: testIndexID, State: "BUILDING"},
+			objects: []client.Object{testConfig()},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					GetIndexFunc: func(_ context.Context, _, _, _, _ string) (*searchindex.SearchIndex, error) {
+						return atlasIndex("FAILED", false), nil
+					},
+				}
+			},
+			wantResult: ctrl.Result{},
+			wantStatus: testStatus("FAILED", false),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.SearchIndexReady).WithReason(string(workflow.SearchIndexFailed)).
+					WithMessageRegexp("search index index-name failed to build, check the hosts in the status and fix its definition"),
+			},
+		},
+		{
+			title: "fails without its search index config",
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{}
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.SearchIndexNotConfigured)).
+					WithMessageRegexp(`failed to get AtlasSearchIndexConfig default/config: atlassearchindexconfigs.atlas.mongodb.com "config" not found`),
+			},
+		},
+		{
+			title:   "conflicts with an index declared in the deployment",
+			objects: []client.Object{testConfig(), testDeployment(akov2.SearchIndex{Name: "index-name"}, nil)},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{}
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantStatus: status.AtlasSearchIndexStatus{ProjectID: testProjectID, ClusterName: testClusterName},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.SearchIndexConflict)).
+					WithMessageRegexp(conflictMsg),
+			},
+		},
+		{
+			title: "conflicts with an index still managed by the deployment",
+			objects: []client.Object{
+				testConfig(),
+				testDeployment(akov2.SearchIndex{Name: "other"}, []status.DeploymentSearchIndexStatus{{Name: "index-name", ID: testIndexID}}),
+			},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{}
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantStatus: status.AtlasSearchIndexStatus{ProjectID: testProjectID, ClusterName: testClusterName},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.SearchIndexConflict)).
+					WithMessageRegexp(conflictMsg),
+			},
+		},
+		{
+			title:   "ignores other indexes of the deployment",
+			objects: []client.Object{testConfig(), testDeployment(akov2.SearchIndex{Name: "other"}, nil)},
+			service: func(t *testing.T) searchindex.AtlasSearchIdxService {
+				return &searchfake.FakeAtlasSearch{
+					FindIndexFunc: func(_ context.Context, _, _ string, _ *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						return nil, searchindex.ErrNotFound
+					},
+					CreateIndexFunc: func(_ context.Context, _, _ string, _ *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						return nil, ErrTestFail
+					},
+				}
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.SearchIndexNotConfigured)).
+					WithMessageRegexp("failed to create search index: failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			index := &akov2.AtlasSearchIndex{
+				ObjectMeta: metav1.ObjectMeta{Name: "index", Namespace: "default"},
+				Spec:       testSpec(),
+				Status:     tc.status,
+			}
+			k8sClient := testClient(t, append(tc.objects, index)...)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				index:       index,
+				service:     tc.service(t),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantFinalizers, getIndex(t, k8sClient, client.ObjectKeyFromObject(index)).GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			gotStatus := status.AtlasSearchIndexStatus{}
+			for _, option := range workflowCtx.StatusOptions() {
+				option.(status.AtlasSearchIndexStatusOption)(&gotStatus)
+			}
+			assert.Equal(t, tc.wantStatus, gotStatus)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		annotations map[string]string
+		deleteFunc  func(ctx context.Context, projectID, clusterName, indexID string) error
+	}{
+		{
+			title: "deletes the index",
+			deleteFunc: func(_ context.Context, projectID, clusterName, indexID string) error {
+				if projectID != testProjectID || clusterName != testClusterName || indexID != testIndexID {
+					return ErrTestFail
+				}
+				return nil
+			},
+		},
+		{
+			title:       "keeps the index",
+			annotations: map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			index := &akov2.AtlasSearchIndex{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "index",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec:   testSpec(),
+				Status: status.AtlasSearchIndexStatus{ID: testIndexID, State: "READY"},
+			}
+			k8sClient := testClient(t, index)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.delete(workflowCtx, &reconcileRequest{
+				projectID:   testProjectID,
+				clusterName: testClusterName,
+				index:       index,
+				// a nil DeleteIndexFunc panics if the index is deleted unexpectedly
+				service: &searchfake.FakeAtlasSearch{DeleteIndexFunc: tc.deleteFunc},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getIndex(t, k8sClient, client.ObjectKeyFromObject(index)).GetFinalizers())
+		})
+	}
+}
+
+func TestSearchIndexesForConfig(t *testing.T) {
+	index := &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "index", Namespace: "default"},
+		Spec:       testSpec(),
+	}
+	vectorIndex := &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "vector-index", Namespace: "default"},
+		Spec: akov2.AtlasSearchIndexSpec{
+			DeploymentDualReference: testSpec().DeploymentDualReference,
+			SearchIndex: akov2.SearchIndex{
+				Name:           "vector",
+				DBName:         "db",
+				CollectionName: "collection",
+				Type:           "vectorSearch",
+				VectorSearch:   &akov2.VectorSearch{},
+			},
+		},
+	}
+	k8sClient := testClient(t, index, vectorIndex)
+	r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+
+	requests := r.searchIndexesForConfig(context.Background(), testConfig())
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "index", Namespace: "default"}}}, requests)
+}
+
+func TestSearchIndexesForDeployment(t *testing.T) {
+	index := &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "index", Namespace: "default"},
+		Spec:       testSpec(),
+		Status:     status.AtlasSearchIndexStatus{ProjectID: testProjectID, ClusterName: testClusterName},
+	}
+	otherIndex := &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-index", Namespace: "default"},
+		Spec:       testSpec(),
+		Status:     status.AtlasSearchIndexStatus{ProjectID: testProjectID, ClusterName: "other-cluster"},
+	}
+	k8sClient := testClient(t, index, otherIndex)
+	r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+
+	requests := r.searchIndexesForDeployment(context.Background(), testDeployment(akov2.SearchIndex{Name: "other"}, nil))
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "index", Namespace: "default"}}}, requests)
+}
+
+func testSpec() akov2.AtlasSearchIndexSpec {
+	return akov2.AtlasSearchIndexSpec{
+		DeploymentDualReference: akov2.DeploymentDualReference{
+			DeploymentRef: &common.ResourceRefNamespaced{Name: "deployment"},
+		},
+		SearchIndex: akov2.SearchIndex{
+			Name:           "index-name",
+			DBName:         "db",
+			CollectionName: "collection",
+			Type:           "search",
+			Search: &akov2.Search{
+				SearchConfigurationRef: common.ResourceRefNamespaced{Name: "config"},
+			},
+		},
+	}
+}
+
+func testConfig() *akov2.AtlasSearchIndexConfig {
+	return &akov2.AtlasSearchIndexConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+		Spec: akov2.AtlasSearchIndexConfigSpec{
+			Analyzer: new("lucene.standard"),
+		},
+	}
+}
+
+func testDeployment(inline akov2.SearchIndex, statuses []status.DeploymentSearchIndexStatus) *akov2.AtlasDeployment {
+	return &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "default"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ExternalProjectRef: &akov2.ExternalProjectReference{ID: testProjectID},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				Name:          testClusterName,
+				SearchIndexes: []akov2.SearchIndex{inline},
+			},
+		},
+		Status: status.AtlasDeploymentStatus{SearchIndexes: statuses},
+	}
+}
+
+// atlasIndex returns the index of the spec, as reported by Atlas
+func atlasIndex(state string, queryable bool) *searchindex.SearchIndex {
+	spec := testSpec()
+	index := searchindex.NewSearchIndex(&spec.SearchIndex, &testConfig().Spec)
+	index.ID = new(testIndexID)
+	index.Status = new(state)
+	index.Queryable = new(queryable)
+	index.Hosts = []searchindex.HostStatus{{Hostname: "host-0", Status: state, Queryable: queryable}}
+	return index
+}
+
+func testStatus(state string, queryable bool) status.AtlasSearchIndexStatus {
+	return status.AtlasSearchIndexStatus{
+		ID:          testIndexID,
+		ProjectID:   testProjectID,
+		ClusterName: testClusterName,
+		State:       state,
+		Queryable:   queryable,
+		Hosts:       []status.SearchIndexHostStatus{{Hostname: "host-0", State: state, Queryable: queryable}},
+	}
+}
+
+func testClient(t *testing.T, objects ...client.Object) client.Client {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+
+	// the deployment indexer resolves project references, it needs a client holding the projects
+	projectClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objects...).Build()
+	deploymentIndexer := indexer.NewAtlasDeploymentByProjectIndexer(t.Context(), projectClient, zaptest.NewLogger(t))
+	configIndexer := indexer.NewAtlasSearchIndexBySearchIndexConfigIndexer(zaptest.NewLogger(t))
+	deploymentNameIndexer := indexer.NewAtlasSearchIndexByDeploymentNameIndexer(zaptest.NewLogger(t))
+
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		WithIndex(deploymentIndexer.Object(), deploymentIndexer.Name(), deploymentIndexer.Keys).
+		WithIndex(configIndexer.Object(), configIndexer.Name(), configIndexer.Keys).
+		WithIndex(deploymentNameIndexer.Object(), deploymentNameIndexer.Name(), deploymentNameIndexer.Keys).
+		Build()
+}
+
+func getIndex(t *testing.T, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasSearchIndex {
+	index := &akov2.AtlasSearchIndex{}
+	if err := k8sClient.Get(context.Background(), key, index); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return index
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasSearchIndexReconciler {
+	return &AtlasSearchIndexReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
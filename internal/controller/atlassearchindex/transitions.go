@@ -0,0 +1,195 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+)
+
+func (r *AtlasSearchIndexReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest, stateInAKO *searchindex.SearchIndex) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.index, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.index, workflow.AtlasFinalizerNotSet, err)
+	}
+	atlasIndex, err := req.service.CreateIndex(workflowCtx.Context, req.projectID, req.clusterName, stateInAKO)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to create search index: %w", err)
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, wrappedErr)
+	}
+	r.EventRecorder.Eventf(req.index, corev1.EventTypeNormal, string(workflow.SearchIndexInProgress),
+		"Creating search index %s on deployment %s", req.index.Spec.Name, req.clusterName)
+	return r.progress(workflowCtx, req, atlasIndex)
+}
+
+// adopt takes over an index already in Atlas, it is updated to match the spec like any other index.
+func (r *AtlasSearchIndexReconciler) adopt(workflowCtx *workflow.Context, req *reconcileRequest, stateInAKO, stateInAtlas *searchindex.SearchIndex) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.index, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.index, workflow.AtlasFinalizerNotSet, err)
+	}
+	r.EventRecorder.Eventf(req.index, corev1.EventTypeNormal, string(workflow.SearchIndexInProgress),
+		"Adopting search index %s(%s) of deployment %s", req.index.Spec.Name, stateInAtlas.GetID(), req.clusterName)
+	return r.sync(workflowCtx, req, stateInAKO, stateInAtlas)
+}
+
+// sync updates the index in Atlas when it differs from the spec, once Atlas is done building it.
+func (r *AtlasSearchIndexReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, stateInAKO, stateInAtlas *searchindex.SearchIndex) (ctrl.Result, error) {
+	state := stateInAtlas.GetStatus()
+	if state != indexStateReady && state != indexStateFailed {
+		return r.progress(workflowCtx, req, stateInAtlas)
+	}
+
+	isEqual, err := stateInAKO.EqualTo(stateInAtlas)
+	if err != nil {
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, err)
+	}
+	if !isEqual {
+		return r.update(workflowCtx, req, stateInAKO, stateInAtlas)
+	}
+	if state == indexStateFailed {
+		return r.failed(workflowCtx, req, stateInAtlas)
+	}
+	return r.ready(workflowCtx, req, stateInAtlas)
+}
+
+func (r *AtlasSearchIndexReconciler) update(workflowCtx *workflow.Context, req *reconcileRequest, stateInAKO, stateInAtlas *searchindex.SearchIndex) (ctrl.Result, error) {
+	stateInAKO.ID = stateInAtlas.ID
+	atlasIndex, err := req.service.UpdateIndex(workflowCtx.Context, req.projectID, req.clusterName, stateInAKO)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to update search index: %w", err)
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotConfigured, wrappedErr)
+	}
+	r.EventRecorder.Eventf(req.index, corev1.EventTypeNormal, string(workflow.SearchIndexInProgress),
+		"Updating search index %s on deployment %s", req.index.Spec.Name, req.clusterName)
+	return r.progress(workflowCtx, req, atlasIndex)
+}
+
+func (r *AtlasSearchIndexReconciler) progress(workflowCtx *workflow.Context, req *reconcileRequest, atlasIndex *searchindex.SearchIndex) (ctrl.Result, error) {
+	workflowCtx.EnsureStatusOption(updateIndexStatusOption(req, atlasIndex))
+	msg := fmt.Sprintf("Search index %s is %s", req.index.Spec.Name, atlasIndex.GetStatus())
+	if pointer.GetOrDefault(atlasIndex.Queryable, false) {
+		msg += ", the previous version of the index can still be queried"
+	}
+	result := workflow.InProgress(workflow.SearchIndexInProgress, msg)
+	workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.SearchIndexReady, result)
+	return result.ReconcileResult()
+}
+
+func (r *AtlasSearchIndexReconciler) ready(workflowCtx *workflow.Context, req *reconcileRequest, atlasIndex *searchindex.SearchIndex) (ctrl.Result, error) {
+	workflowCtx.EnsureStatusOption(updateIndexStatusOption(req, atlasIndex))
+	workflowCtx.SetConditionTrueMsg(api.SearchIndexReady, fmt.Sprintf("Search index %s is %s", req.index.Spec.Name, atlasIndex.GetStatus())).
+		SetConditionTrue(api.ReadyType)
+	return workflow.OK().ReconcileResult()
+}
+
+// failed reports an index Atlas could not build, e.g. due to mappings not matching the documents.
+// Atlas keeps it failed until its definition changes, so the spec needs fixing.
+func (r *AtlasSearchIndexReconciler) failed(workflowCtx *workflow.Context, req *reconcileRequest, atlasIndex *searchindex.SearchIndex) (ctrl.Result, error) {
+	workflowCtx.EnsureStatusOption(updateIndexStatusOption(req, atlasIndex))
+	if req.index.Status.State != indexStateFailed {
+		r.EventRecorder.Eventf(req.index, corev1.EventTypeWarning, string(workflow.SearchIndexFailed),
+			"Search index %s failed to build", req.index.Spec.Name)
+	}
+	err := fmt.Errorf("search index %s failed to build, check the hosts in the status and fix its definition", req.index.Spec.Name)
+	result := workflow.Terminate(workflow.SearchIndexFailed, err).WithoutRetry()
+	workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.SearchIndexReady, result)
+	return result.ReconcileResult()
+}
+
+func (r *AtlasSearchIndexReconciler) conflict(workflowCtx *workflow.Context, req *reconcileRequest, deployment *akov2.AtlasDeployment) (ctrl.Result, error) {
+	err := fmt.Errorf("search index %s is also declared in AtlasDeployment %s, remove it from the AtlasDeployment to manage it with an %s",
+		req.index.Spec.Name, client.ObjectKeyFromObject(deployment), typeName)
+	// the deployment of the index is recorded, so the AtlasDeployment finds the index it hands over
+	workflowCtx.EnsureStatusOption(deploymentStatusOption(req))
+	return r.terminate(workflowCtx, req.index, workflow.SearchIndexConflict, err)
+}
+
+func (r *AtlasSearchIndexReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.index, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.index)
+	}
+	// a search index already removed from Atlas is not reported as an error
+	err := req.service.DeleteIndex(workflowCtx.Context, req.projectID, req.clusterName, req.index.Status.ID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to delete search index: %w", err)
+		return r.terminate(workflowCtx, req.index, workflow.SearchIndexNotDeleted, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.index)
+}
+
+func (r *AtlasSearchIndexReconciler) unmanage(workflowCtx *workflow.Context, index *akov2.AtlasSearchIndex) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, index, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, index, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasSearchIndexReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateIndexStatusOption(req *reconcileRequest, atlasIndex *searchindex.SearchIndex) status.AtlasSearchIndexStatusOption {
+	return func(indexStatus *status.AtlasSearchIndexStatus) {
+		indexStatus.ID = atlasIndex.GetID()
+		indexStatus.ProjectID = req.projectID
+		indexStatus.ClusterName = req.clusterName
+		indexStatus.State = atlasIndex.GetStatus()
+		indexStatus.Queryable = pointer.GetOrDefault(atlasIndex.Queryable, false)
+		indexStatus.Hosts = hostStatuses(atlasIndex.Hosts)
+	}
+}
+
+func deploymentStatusOption(req *reconcileRequest) status.AtlasSearchIndexStatusOption {
+	return func(indexStatus *status.AtlasSearchIndexStatus) {
+		indexStatus.ProjectID = req.projectID
+		indexStatus.ClusterName = req.clusterName
+	}
+}
+
+func hostStatuses(hosts []searchindex.HostStatus) []status.SearchIndexHostStatus {
+	if len(hosts) == 0 {
+		return nil
+	}
+	result := make([]status.SearchIndexHostStatus, 0, len(hosts))
+	for _, host := range hosts {
+		result = append(result, status.SearchIndexHostStatus{
+			Hostname:  host.Hostname,
+			State:     host.Status,
+			Queryable: host.Queryable,
+		})
+	}
+	return result
+}
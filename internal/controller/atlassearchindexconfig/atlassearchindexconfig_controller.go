@@ -48,6 +48,8 @@ import (
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindexconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeployments,verbs=get;list
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindexes,verbs=get;list
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindexes,verbs=get;list
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
@@ -85,6 +87,10 @@ func (r *AtlasSearchIndexConfigReconciler) Reconcile(ctx context.Context, req ct
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, atlasSearchIndexConfig, "AtlasSearchIndexConfig")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(atlasSearchIndexConfig) {
 		return r.unsupport(workflowCtx)
@@ -102,7 +108,19 @@ func (r *AtlasSearchIndexConfigReconciler) Reconcile(ctx context.Context, req ct
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
-	if len(deployments.Items) > 0 {
+	searchIndexes := &akov2.AtlasSearchIndexList{}
+	listOps = &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasSearchIndexBySearchIndexConfigIndex,
+			client.ObjectKeyFromObject(atlasSearchIndexConfig).String(),
+		),
+	}
+	err = r.Client.List(ctx, searchIndexes, listOps)
+	if err != nil {
+		return r.terminate(workflowCtx, workflow.Internal, err)
+	}
+
+	if len(deployments.Items) > 0 || len(searchIndexes.Items) > 0 {
 		// set finalizer
 		return r.lock(workflowCtx, atlasSearchIndexConfig)
 	}
@@ -124,6 +142,11 @@ func (r *AtlasSearchIndexConfigReconciler) SetupWithManager(mgr ctrl.Manager, sk
 			handler.EnqueueRequestsFromMapFunc(r.findReferencesInAtlasDeployments),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		Watches(
+			&akov2.AtlasSearchIndex{},
+			handler.EnqueueRequestsFromMapFunc(r.findReferencesInAtlasSearchIndexes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation:      new(skipNameValidation),
@@ -167,6 +190,20 @@ func (r *AtlasSearchIndexConfigReconciler) findReferencesInAtlasDeployments(ctx
 	return requests
 }
 
+func (r *AtlasSearchIndexConfigReconciler) findReferencesInAtlasSearchIndexes(ctx context.Context, obj client.Object) []reconcile.Request {
+	searchIndex, ok := obj.(*akov2.AtlasSearchIndex)
+	if !ok {
+		r.Log.Warnf("watching AtlasSearchIndex but got %T", obj)
+		return nil
+	}
+
+	if searchIndex.Spec.Search == nil {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: *searchIndex.Spec.Search.SearchConfigurationRef.GetObject(searchIndex.GetNamespace())}}
+}
+
 func (r *AtlasSearchIndexConfigReconciler) skip(ctx context.Context, log *zap.SugaredLogger, searchIndexConfig *akov2.AtlasSearchIndexConfig) (ctrl.Result, error) {
 	log.Infow(fmt.Sprintf("-> Skipping AtlasSearchIndexConfig reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", searchIndexConfig.Spec)
 	if !searchIndexConfig.GetDeletionTimestamp().IsZero() {
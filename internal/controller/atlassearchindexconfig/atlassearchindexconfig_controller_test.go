@@ -208,6 +208,7 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
 		deploymentIndexer := indexer.NewAtlasDeploymentBySearchIndexIndexer(zaptest.NewLogger(t))
+		searchIndexIndexer := indexer.NewAtlasSearchIndexBySearchIndexConfigIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
 			WithObjects(searchIndexConfig).
@@ -217,6 +218,11 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 				deploymentIndexer.Name(),
 				deploymentIndexer.Keys,
 			).
+			WithIndex(
+				searchIndexIndexer.Object(),
+				searchIndexIndexer.Name(),
+				searchIndexIndexer.Keys,
+			).
 			WithInterceptorFuncs(interceptor.Funcs{List: func(ctx context.Context, client client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
 				return errors.New("failed to list instances")
 			}}).
@@ -286,6 +292,7 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
 		deploymentIndexer := indexer.NewAtlasDeploymentBySearchIndexIndexer(zaptest.NewLogger(t))
+		searchIndexIndexer := indexer.NewAtlasSearchIndexBySearchIndexConfigIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
 			WithObjects(searchIndexConfig, atlasDeployment).
@@ -295,6 +302,11 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 				deploymentIndexer.Name(),
 				deploymentIndexer.Keys,
 			).
+			WithIndex(
+				searchIndexIndexer.Object(),
+				searchIndexIndexer.Name(),
+				searchIndexIndexer.Keys,
+			).
 			Build()
 
 		reconciler := &AtlasSearchIndexConfigReconciler{
@@ -341,6 +353,7 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
 		deploymentIndexer := indexer.NewAtlasDeploymentBySearchIndexIndexer(zaptest.NewLogger(t))
+		searchIndexIndexer := indexer.NewAtlasSearchIndexBySearchIndexConfigIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
 			WithObjects(searchIndexConfig).
@@ -350,6 +363,11 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 				deploymentIndexer.Name(),
 				deploymentIndexer.Keys,
 			).
+			WithIndex(
+				searchIndexIndexer.Object(),
+				searchIndexIndexer.Name(),
+				searchIndexIndexer.Keys,
+			).
 			Build()
 
 		reconciler := &AtlasSearchIndexConfigReconciler{
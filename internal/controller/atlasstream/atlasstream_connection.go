@@ -233,10 +233,53 @@ func streamConnectionToAtlas(ctx context.Context, k8sClient client.Client) strea
 			connection.Config = &streamConnection.Spec.KafkaConfig.Config
 		}
 
+		if streamConnection.Spec.HTTPSConfig != nil {
+			headers, err := httpsHeaders(ctx, k8sClient, streamConnection)
+			if err != nil {
+				return nil, err
+			}
+
+			connection.Url = &streamConnection.Spec.HTTPSConfig.URL
+			connection.Headers = &headers
+		}
+
+		if streamConnection.Spec.AWSConfig != nil {
+			connection.Aws = &admin.StreamsAWSConnectionConfig{
+				RoleArn: &streamConnection.Spec.AWSConfig.RoleARN,
+			}
+			if streamConnection.Spec.AWSConfig.TestBucket != "" {
+				connection.Aws.TestBucket = &streamConnection.Spec.AWSConfig.TestBucket
+			}
+		}
+
 		return &connection, nil
 	}
 }
 
+// httpsHeaders merges the headers of an HTTPS connection with the ones stored in its headers secret.
+func httpsHeaders(ctx context.Context, k8sClient client.Client, streamConnection *akov2.AtlasStreamConnection) (map[string]string, error) {
+	headers := make(map[string]string, len(streamConnection.Spec.HTTPSConfig.Headers))
+	for name, value := range streamConnection.Spec.HTTPSConfig.Headers {
+		headers[name] = value
+	}
+
+	if streamConnection.Spec.HTTPSConfig.HeadersSecret == nil {
+		return headers, nil
+	}
+
+	ref := *streamConnection.Spec.HTTPSConfig.HeadersSecret.GetObject(streamConnection.Namespace)
+	secret := corev1.Secret{}
+	if err := k8sClient.Get(ctx, ref, &secret); err != nil {
+		return nil, fmt.Errorf("failed to retrieve secret %v: %w", ref, err)
+	}
+
+	for name, value := range secret.Data {
+		headers[name] = string(value)
+	}
+
+	return headers, nil
+}
+
 func getSecretData(ctx context.Context, k8sClient client.Client, ref client.ObjectKey, keys ...string) (map[string]string, error) {
 	secret := corev1.Secret{}
 	err := k8sClient.Get(ctx, ref, &secret)
@@ -277,6 +320,10 @@ func hasStreamConnectionChanged(
 		atlasStreamConnection.Security.Links = nil
 	}
 
+	if _, ok := atlasStreamConnection.GetAwsOk(); ok {
+		atlasStreamConnection.Aws.Links = nil
+	}
+
 	connection, err := mapper(streamConnection)
 	if err != nil {
 		return false, err
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -34,6 +35,7 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
@@ -60,6 +62,9 @@ type AtlasStreamsConnectionReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasstreamconnections/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasstreaminstances,verbs=get;list
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasstreaminstances,verbs=get;list
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects,verbs=get
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects,verbs=get
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *AtlasStreamsConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.With("atlasstreamconnection", req.NamespacedName)
@@ -88,6 +93,10 @@ func (r *AtlasStreamsConnectionReconciler) ensureAtlasStreamConnection(ctx conte
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, akoStreamConnection, "AtlasStreamConnection")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(akoStreamConnection) {
 		return r.unsupport(workflowCtx)
@@ -106,12 +115,54 @@ func (r *AtlasStreamsConnectionReconciler) ensureAtlasStreamConnection(ctx conte
 	}
 
 	if len(streamInstances.Items) > 0 {
+		if err = r.validate(ctx, akoStreamConnection, streamInstances.Items); err != nil {
+			return r.misconfigured(workflowCtx, akoStreamConnection, err)
+		}
+
 		return r.lock(workflowCtx, akoStreamConnection)
 	}
 
 	return r.release(workflowCtx, akoStreamConnection)
 }
 
+// validate checks the connection can be configured in the projects of the stream instances using it:
+// the headers secret of an HTTPS connection must exist, and the role of an AWS connection
+// must be an authorized cloud provider access role of each project.
+func (r *AtlasStreamsConnectionReconciler) validate(ctx context.Context, streamConnection *akov2.AtlasStreamConnection, streamInstances []akov2.AtlasStreamInstance) error {
+	if streamConnection.Spec.HTTPSConfig != nil {
+		if _, err := httpsHeaders(ctx, r.Client, streamConnection); err != nil {
+			return err
+		}
+	}
+
+	if streamConnection.Spec.AWSConfig == nil {
+		return nil
+	}
+
+	for i := range streamInstances {
+		project := akov2.AtlasProject{}
+		if err := r.Client.Get(ctx, streamInstances[i].AtlasProjectObjectKey(), &project); err != nil {
+			return fmt.Errorf("failed to retrieve project of stream instance %s: %w", client.ObjectKeyFromObject(&streamInstances[i]), err)
+		}
+
+		if !isAuthorizedRole(&project, streamConnection.Spec.AWSConfig.RoleARN) {
+			return fmt.Errorf("role %s is not an authorized cloud provider access role of project %s", streamConnection.Spec.AWSConfig.RoleARN, project.Spec.Name)
+		}
+	}
+
+	return nil
+}
+
+func isAuthorizedRole(project *akov2.AtlasProject, roleARN string) bool {
+	for _, integration := range project.Status.CloudProviderIntegrations {
+		if integration.IamAssumedRoleArn == roleARN {
+			return integration.Status == status.CloudProviderIntegrationStatusAuthorized
+		}
+	}
+
+	return false
+}
+
 func (r *AtlasStreamsConnectionReconciler) For() (client.Object, builder.Predicates) {
 	return &akov2.AtlasStreamConnection{}, builder.WithPredicates(r.GlobalPredicates...)
 }
@@ -125,6 +176,11 @@ func (r *AtlasStreamsConnectionReconciler) SetupWithManager(mgr ctrl.Manager, sk
 			handler.EnqueueRequestsFromMapFunc(r.findStreamConnectionsForStreamInstances),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findStreamConnectionsForSecret),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:             ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation:      new(skipNameValidation),
@@ -166,6 +222,40 @@ func (r *AtlasStreamsConnectionReconciler) findStreamConnectionsForStreamInstanc
 	return requests
 }
 
+func (r *AtlasStreamsConnectionReconciler) findStreamConnectionsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		r.Log.Warnf("watching Secret but got %T", obj)
+		return nil
+	}
+
+	connections := &akov2.AtlasStreamConnectionList{}
+	listOps := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasStreamConnectionBySecretIndex,
+			client.ObjectKeyFromObject(secret).String(),
+		),
+	}
+
+	err := r.Client.List(ctx, connections, listOps)
+	if err != nil {
+		r.Log.Errorf("failed to list Atlas stream connections: %e", err)
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(connections.Items))
+	for i := range connections.Items {
+		requests = append(
+			requests,
+			reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&connections.Items[i]),
+			},
+		)
+	}
+
+	return requests
+}
+
 func (r *AtlasStreamsConnectionReconciler) skip(ctx context.Context, log *zap.SugaredLogger, streamConnection *akov2.AtlasStreamConnection) (ctrl.Result, error) {
 	log.Infow(fmt.Sprintf("-> Skipping AtlasStreamConnection reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", streamConnection.Spec)
 	if !streamConnection.GetDeletionTimestamp().IsZero() {
@@ -201,6 +291,18 @@ func (r *AtlasStreamsConnectionReconciler) terminate(ctx *workflow.Context, erro
 	return terminated.ReconcileResult()
 }
 
+// misconfigured reports a connection that cannot be configured in Atlas.
+// The connection is still locked as it is referred by stream instances.
+func (r *AtlasStreamsConnectionReconciler) misconfigured(ctx *workflow.Context, streamConnection *akov2.AtlasStreamConnection, err error) (ctrl.Result, error) {
+	if !customresource.HaveFinalizer(streamConnection, customresource.FinalizerLabel) {
+		if err := customresource.ManageFinalizer(ctx.Context, r.Client, streamConnection, customresource.SetFinalizer); err != nil {
+			return r.terminate(ctx, workflow.AtlasFinalizerNotSet, err)
+		}
+	}
+
+	return r.terminate(ctx, workflow.StreamConnectionNotConfigured, err)
+}
+
 func (r *AtlasStreamsConnectionReconciler) ready(ctx *workflow.Context) (ctrl.Result, error) {
 	result := workflow.OK()
 	ctx.SetConditionFromResult(api.ReadyType, result)
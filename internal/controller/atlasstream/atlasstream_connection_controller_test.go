@@ -37,6 +37,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
@@ -561,6 +562,215 @@ func TestFindStreamConnectionsForStreamInstances(t *testing.T) {
 	})
 }
 
+func TestFindStreamConnectionsForSecret(t *testing.T) {
+	t.Run("should fail when watching wrong object", func(t *testing.T) {
+		core, logs := observer.New(zap.DebugLevel)
+		reconciler := &AtlasStreamsConnectionReconciler{
+			Log: zap.New(core).Sugar(),
+		}
+
+		assert.Nil(t, reconciler.findStreamConnectionsForSecret(context.Background(), &akov2.AtlasProject{}))
+		assert.Equal(t, 1, logs.Len())
+		assert.Equal(t, zap.WarnLevel, logs.All()[0].Level)
+		assert.Equal(t, "watching Secret but got *v1.AtlasProject", logs.All()[0].Message)
+	})
+
+	t.Run("should return slice of requests for connections using the secret", func(t *testing.T) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "headers",
+				Namespace: "default",
+			},
+		}
+		connection := &akov2.AtlasStreamConnection{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "https-connection",
+				Namespace: "default",
+			},
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "https",
+				ConnectionType: "Https",
+				HTTPSConfig: &akov2.StreamsHTTPSConnection{
+					URL: "https://example.com/ingest",
+					HeadersSecret: &common.ResourceRefNamespaced{
+						Name: "headers",
+					},
+				},
+			},
+		}
+		testScheme := runtime.NewScheme()
+		assert.NoError(t, akov2.AddToScheme(testScheme))
+		assert.NoError(t, corev1.AddToScheme(testScheme))
+		secretIndexer := indexer.NewAtlasStreamConnectionBySecretIndexer(zaptest.NewLogger(t))
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(secret, connection).
+			WithIndex(
+				secretIndexer.Object(),
+				secretIndexer.Name(),
+				secretIndexer.Keys,
+			).
+			Build()
+		reconciler := &AtlasStreamsConnectionReconciler{
+			Client: k8sClient,
+			Log:    zaptest.NewLogger(t).Sugar(),
+		}
+
+		requests := reconciler.findStreamConnectionsForSecret(context.Background(), secret)
+		assert.Equal(
+			t,
+			[]ctrl.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Name:      "https-connection",
+						Namespace: "default",
+					},
+				},
+			},
+			requests,
+		)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/streams"
+	instance := akov2.AtlasStreamInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "instance",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasStreamInstanceSpec{
+			Name: "instance",
+			Project: common.ResourceRefNamespaced{
+				Name: "my-project",
+			},
+		},
+	}
+	project := func(integrations ...status.CloudProviderIntegration) *akov2.AtlasProject {
+		return &akov2.AtlasProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-project",
+				Namespace: "default",
+			},
+			Spec: akov2.AtlasProjectSpec{
+				Name: "project",
+			},
+			Status: status.AtlasProjectStatus{
+				CloudProviderIntegrations: integrations,
+			},
+		}
+	}
+	s3Connection := &akov2.AtlasStreamConnection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s3-connection",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasStreamConnectionSpec{
+			Name:           "s3",
+			ConnectionType: "S3",
+			AWSConfig: &akov2.StreamsAWSConnection{
+				RoleARN: roleARN,
+			},
+		},
+	}
+	httpsConnection := &akov2.AtlasStreamConnection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "https-connection",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasStreamConnectionSpec{
+			Name:           "https",
+			ConnectionType: "Https",
+			HTTPSConfig: &akov2.StreamsHTTPSConnection{
+				URL: "https://example.com/ingest",
+				HeadersSecret: &common.ResourceRefNamespaced{
+					Name: "headers",
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		connection  *akov2.AtlasStreamConnection
+		objects     []client.Object
+		expectedErr string
+	}{
+		"should accept a sample connection": {
+			connection: &akov2.AtlasStreamConnection{
+				Spec: akov2.AtlasStreamConnectionSpec{
+					Name:           "sample_stream_solar",
+					ConnectionType: "Sample",
+				},
+			},
+		},
+		"should accept a role authorized in the project": {
+			connection: s3Connection,
+			objects: []client.Object{
+				project(status.CloudProviderIntegration{
+					IamAssumedRoleArn: roleARN,
+					Status:            status.CloudProviderIntegrationStatusAuthorized,
+				}),
+			},
+		},
+		"should reject a role not yet authorized in the project": {
+			connection: s3Connection,
+			objects: []client.Object{
+				project(status.CloudProviderIntegration{
+					IamAssumedRoleArn: roleARN,
+					Status:            status.CloudProviderIntegrationStatusCreated,
+				}),
+			},
+			expectedErr: "role arn:aws:iam::123456789012:role/streams is not an authorized cloud provider access role of project project",
+		},
+		"should reject a role unknown to the project": {
+			connection:  s3Connection,
+			objects:     []client.Object{project()},
+			expectedErr: "role arn:aws:iam::123456789012:role/streams is not an authorized cloud provider access role of project project",
+		},
+		"should fail when the project is not found": {
+			connection:  s3Connection,
+			expectedErr: "failed to retrieve project of stream instance default/instance",
+		},
+		"should accept an https connection with its headers secret": {
+			connection: httpsConnection,
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "headers",
+						Namespace: "default",
+					},
+				},
+			},
+		},
+		"should fail when the headers secret is not found": {
+			connection:  httpsConnection,
+			expectedErr: "failed to retrieve secret default/headers",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			assert.NoError(t, akov2.AddToScheme(testScheme))
+			assert.NoError(t, corev1.AddToScheme(testScheme))
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(tt.objects...).
+				Build()
+			reconciler := &AtlasStreamsConnectionReconciler{
+				Client: k8sClient,
+				Log:    zaptest.NewLogger(t).Sugar(),
+			}
+
+			err := reconciler.validate(context.Background(), tt.connection, []akov2.AtlasStreamInstance{instance})
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestLock(t *testing.T) {
 	t.Run("should transition to ready when finalizer is already set", func(t *testing.T) {
 		connection := &akov2.AtlasStreamConnection{
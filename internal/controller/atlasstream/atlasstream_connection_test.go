@@ -978,6 +978,147 @@ func TestStreamConnectionToAtlas(t *testing.T) {
 	})
 }
 
+func TestStreamConnectionToAtlasHTTPSAndAWS(t *testing.T) {
+	t.Run("should map an https connection configuration merging headers from the secret", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		assert.NoError(t, corev1.AddToScheme(testScheme))
+		secretHeaders := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-https-headers",
+			},
+			Data: map[string][]byte{
+				"Authorization": []byte("Bearer token"),
+				"X-Tenant":      []byte("from-secret"),
+			},
+		}
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(&secretHeaders).
+			Build()
+		akoConnection := akov2.AtlasStreamConnection{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+			},
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "https-connection",
+				ConnectionType: "Https",
+				HTTPSConfig: &akov2.StreamsHTTPSConnection{
+					URL: "https://example.com/ingest",
+					Headers: map[string]string{
+						"Content-Type": "application/json",
+						"X-Tenant":     "from-spec",
+					},
+					HeadersSecret: &common.ResourceRefNamespaced{
+						Name: "my-https-headers",
+					},
+				},
+			},
+		}
+
+		mapFunc := streamConnectionToAtlas(context.Background(), k8sClient)
+		conn, err := mapFunc(&akoConnection)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			&admin.StreamsConnection{
+				Name: new("https-connection"),
+				Type: new("Https"),
+				Url:  new("https://example.com/ingest"),
+				Headers: &map[string]string{
+					"Authorization": "Bearer token",
+					"Content-Type":  "application/json",
+					"X-Tenant":      "from-secret",
+				},
+			},
+			conn,
+		)
+	})
+
+	t.Run("should return error to map an https configuration when fail to get the headers secret", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().
+			Build()
+		akoConnection := akov2.AtlasStreamConnection{
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "https-connection",
+				ConnectionType: "Https",
+				HTTPSConfig: &akov2.StreamsHTTPSConnection{
+					URL: "https://example.com/ingest",
+					HeadersSecret: &common.ResourceRefNamespaced{
+						Name:      "my-https-headers",
+						Namespace: "default",
+					},
+				},
+			},
+		}
+
+		mapFunc := streamConnectionToAtlas(context.Background(), k8sClient)
+		conn, err := mapFunc(&akoConnection)
+		assert.ErrorContains(t, err, "failed to retrieve secret default/my-https-headers")
+		assert.Nil(t, conn)
+	})
+
+	t.Run("should map an aws lambda connection configuration", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().
+			Build()
+		akoConnection := akov2.AtlasStreamConnection{
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "lambda-connection",
+				ConnectionType: "AWSLambda",
+				AWSConfig: &akov2.StreamsAWSConnection{
+					RoleARN: "arn:aws:iam::123456789012:role/streams",
+				},
+			},
+		}
+
+		mapFunc := streamConnectionToAtlas(context.Background(), k8sClient)
+		conn, err := mapFunc(&akoConnection)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			&admin.StreamsConnection{
+				Name: new("lambda-connection"),
+				Type: new("AWSLambda"),
+				Aws: &admin.StreamsAWSConnectionConfig{
+					RoleArn: new("arn:aws:iam::123456789012:role/streams"),
+				},
+			},
+			conn,
+		)
+	})
+
+	t.Run("should map an s3 connection configuration", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().
+			Build()
+		akoConnection := akov2.AtlasStreamConnection{
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "s3-connection",
+				ConnectionType: "S3",
+				AWSConfig: &akov2.StreamsAWSConnection{
+					RoleARN:    "arn:aws:iam::123456789012:role/streams",
+					TestBucket: "my-bucket",
+				},
+			},
+		}
+
+		mapFunc := streamConnectionToAtlas(context.Background(), k8sClient)
+		conn, err := mapFunc(&akoConnection)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			&admin.StreamsConnection{
+				Name: new("s3-connection"),
+				Type: new("S3"),
+				Aws: &admin.StreamsAWSConnectionConfig{
+					RoleArn:    new("arn:aws:iam::123456789012:role/streams"),
+					TestBucket: new("my-bucket"),
+				},
+			},
+			conn,
+		)
+	})
+}
+
 func TestGetSecretData(t *testing.T) {
 	t.Run("should return error when secret doesn't exist", func(t *testing.T) {
 		testScheme := runtime.NewScheme()
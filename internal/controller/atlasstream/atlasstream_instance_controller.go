@@ -99,6 +99,10 @@ func (r *AtlasStreamsInstanceReconciler) ensureAtlasStreamsInstance(ctx context.
 	if !isValid.IsOk() {
 		return r.invalidate(isValid)
 	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, akoStreamInstance, "AtlasStreamInstance")
+	if !policyIsValid.IsOk() {
+		return r.invalidate(policyIsValid)
+	}
 
 	// check if stream instance is in "unsupported" state
 	if !r.AtlasProvider.IsResourceSupported(akoStreamInstance) {
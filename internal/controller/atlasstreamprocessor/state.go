@@ -0,0 +1,136 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasstreamprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/streamprocessor"
+)
+
+const (
+	typeName = "AtlasStreamProcessor"
+)
+
+type reconcileRequest struct {
+	projectID       string
+	instanceName    string
+	streamProcessor *akov2.AtlasStreamProcessor
+	service         streamprocessor.StreamProcessorService
+}
+
+func (r *AtlasStreamProcessorReconciler) handleCustomResource(ctx context.Context, streamProcessor *akov2.AtlasStreamProcessor) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(streamProcessor) {
+		return r.Skip(ctx, typeName, streamProcessor, streamProcessor.Spec)
+	}
+
+	conditions := api.InitCondition(streamProcessor, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, streamProcessor)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, streamProcessor)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, streamProcessor, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+	policyIsValid := customresource.ValidateReconciliationPolicy(workflowCtx, streamProcessor, typeName)
+	if !policyIsValid.IsOk() {
+		return r.Invalidate(typeName, policyIsValid)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(streamProcessor) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	req, err := r.newReconcileRequest(ctx, streamProcessor)
+	if streamProcessor.DeletionTimestamp != nil && apierrors.IsNotFound(err) {
+		// the stream instance is gone, along with its processors
+		return r.unmanage(workflowCtx, streamProcessor)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, streamProcessor, workflow.StreamProcessorNotConfigured, err)
+	}
+	return r.handle(workflowCtx, req)
+}
+
+// newReconcileRequest resolves the Atlas project and stream instance of the processor
+// through the referenced AtlasStreamInstance and its AtlasProject.
+func (r *AtlasStreamProcessorReconciler) newReconcileRequest(ctx context.Context, streamProcessor *akov2.AtlasStreamProcessor) (*reconcileRequest, error) {
+	instance := &akov2.AtlasStreamInstance{}
+	instanceKey := streamProcessor.AtlasStreamInstanceObjectKey()
+	if err := r.Client.Get(ctx, instanceKey, instance); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasStreamInstance %s: %w", instanceKey, err)
+	}
+	project := &akov2.AtlasProject{}
+	projectKey := instance.AtlasProjectObjectKey()
+	if err := r.Client.Get(ctx, projectKey, project); err != nil {
+		return nil, fmt.Errorf("failed to get AtlasProject %s: %w", projectKey, err)
+	}
+	if project.ID() == "" {
+		return nil, fmt.Errorf("AtlasProject %s is not ready yet", projectKey)
+	}
+
+	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, project.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		projectID:       project.ID(),
+		instanceName:    instance.Spec.Name,
+		streamProcessor: streamProcessor,
+		service:         streamprocessor.NewStreamProcessorServiceFromClientSet(sdkClientSet),
+	}, nil
+}
+
+func (r *AtlasStreamProcessorReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	atlasProcessor, err := req.service.Get(workflowCtx.Context, req.projectID, req.instanceName, req.streamProcessor.Spec.Name)
+	if err != nil && !errors.Is(err, streamprocessor.ErrNotFound) {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotConfigured, err)
+	}
+	inAtlas := err == nil
+	deleted := req.streamProcessor.DeletionTimestamp != nil
+	if deleted {
+		if inAtlas {
+			return r.delete(workflowCtx, req)
+		}
+		return r.unmanage(workflowCtx, req.streamProcessor)
+	}
+
+	config, err := streamprocessor.NewStreamProcessorConfig(&req.streamProcessor.Spec)
+	if err != nil {
+		// retrying does not help, the spec must be fixed
+		result := workflow.Terminate(workflow.StreamProcessorNotConfigured, err).WithoutRetry()
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.StreamProcessorReadyType, result)
+		return result.ReconcileResult()
+	}
+	if !inAtlas {
+		return r.create(workflowCtx, req, config)
+	}
+	return r.sync(workflowCtx, req, config, atlasProcessor)
+}
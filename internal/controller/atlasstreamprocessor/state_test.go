@@ -0,0 +1,398 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasstreamprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/streamprocessor"
+)
+
+const (
+	testProjectID = "project-id"
+
+	testInstanceName = "test-instance"
+
+	testProcessorID = "5f4007f327a3bd7b6f4103c5"
+
+	testProcessorName = "solar"
+
+	testSyncPeriod = time.Hour
+)
+
+var ErrTestFail = errors.New("failure")
+
+func TestHandle(t *testing.T) {
+	stats := &status.StreamProcessorStats{InputMessageCount: 10, OutputMessageCount: 8}
+	for _, tc := range []struct {
+		title              string
+		state              string
+		pipeline           string
+		generation         int64
+		observedGeneration int64
+		service            func() streamprocessor.StreamProcessorService
+		wantResult         ctrl.Result
+		wantFinalizers     []string
+		wantStatus         status.AtlasStreamProcessorStatus
+		wantConditions     []api.Condition
+	}{
+		{
+			title: "creates and starts the processor",
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil, streamprocessor.ErrNotFound).Once()
+				sps.EXPECT().Create(mock.Anything, testProjectID, testInstanceName, testConfig(t)).
+					Return(testProcessor(t, streamprocessor.StateCreated, nil), nil)
+				sps.EXPECT().Start(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, stats), nil).Once()
+				return sps
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateStarted, stats),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.StreamProcessorReadyType).WithMessageRegexp("Stream processor solar is STARTED"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "creates a stopped processor",
+			state: akov2.StreamProcessorStateStopped,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil, streamprocessor.ErrNotFound)
+				sps.EXPECT().Create(mock.Anything, testProjectID, testInstanceName, testConfig(t)).
+					Return(testProcessor(t, streamprocessor.StateCreated, nil), nil)
+				return sps
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateCreated, nil),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.StreamProcessorReadyType).WithMessageRegexp("Stream processor solar is CREATED"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:    "stops, modifies and starts a running processor",
+			pipeline: `[{"$source":{"connectionName":"sample_stream_solar"}},{"$limit":10}]`,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, nil), nil).Once()
+				sps.EXPECT().Stop(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				sps.EXPECT().Update(mock.Anything, testProjectID, testInstanceName, mock.Anything).
+					Return(testProcessor(t, streamprocessor.StateStopped, nil), nil)
+				sps.EXPECT().Start(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, stats), nil).Once()
+				return sps
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateStarted, stats),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.StreamProcessorReadyType).WithMessageRegexp("Stream processor solar is STARTED"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "stops the processor",
+			state: akov2.StreamProcessorStateStopped,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, stats), nil).Once()
+				sps.EXPECT().Stop(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStopped, stats), nil).Once()
+				return sps
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateStopped, stats),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.StreamProcessorReadyType).WithMessageRegexp("Stream processor solar is STOPPED"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:              "reports a failed processor without starting it again",
+			generation:         1,
+			observedGeneration: 1,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateFailed, nil), nil)
+				return sps
+			},
+			wantResult:     ctrl.Result{},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateFailed, nil),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.StreamProcessorReadyType).WithReason(string(workflow.StreamProcessorFailed)).
+					WithMessageRegexp("stream processor solar failed, update its spec to start it again"),
+			},
+		},
+		{
+			title:              "starts a failed processor again once its spec changes",
+			generation:         2,
+			observedGeneration: 1,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateFailed, nil), nil).Once()
+				sps.EXPECT().Start(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, nil), nil).Once()
+				return sps
+			},
+			wantResult:     ctrl.Result{RequeueAfter: testSyncPeriod},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantStatus:     testStatus(streamprocessor.StateStarted, nil),
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.StreamProcessorReadyType).WithMessageRegexp("Stream processor solar is STARTED"),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title:    "rejects an invalid pipeline without retrying",
+			pipeline: `{"$source":{"connectionName":"sample_stream_solar"}}`,
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil, streamprocessor.ErrNotFound)
+				return sps
+			},
+			wantResult: ctrl.Result{},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.StreamProcessorReadyType).WithReason(string(workflow.StreamProcessorNotConfigured)).
+					WithMessageRegexp("invalid pipeline: expected a list of stages"),
+			},
+		},
+		{
+			title: "fails to create the processor",
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil, streamprocessor.ErrNotFound)
+				sps.EXPECT().Create(mock.Anything, testProjectID, testInstanceName, mock.Anything).Return(nil, ErrTestFail)
+				return sps
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.StreamProcessorNotCreated)).
+					WithMessageRegexp("failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			spec := testSpec()
+			spec.State = tc.state
+			if tc.pipeline != "" {
+				spec.Pipeline = apiextensions.JSON{Raw: []byte(tc.pipeline)}
+			}
+			streamProcessor := &akov2.AtlasStreamProcessor{
+				ObjectMeta: metav1.ObjectMeta{Name: "processor", Namespace: "default", Generation: tc.generation},
+				Spec:       spec,
+				Status:     status.AtlasStreamProcessorStatus{Common: api.Common{ObservedGeneration: tc.observedGeneration}},
+			}
+			k8sClient := testClient(t, streamProcessor)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:       testProjectID,
+				instanceName:    testInstanceName,
+				streamProcessor: streamProcessor,
+				service:         tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			assert.Equal(t, tc.wantFinalizers, getStreamProcessor(t, k8sClient, client.ObjectKeyFromObject(streamProcessor)).GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			gotStatus := status.AtlasStreamProcessorStatus{}
+			for _, option := range workflowCtx.StatusOptions() {
+				option.(status.AtlasStreamProcessorStatusOption)(&gotStatus)
+			}
+			assert.Equal(t, tc.wantStatus, gotStatus)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		annotations map[string]string
+		service     func() streamprocessor.StreamProcessorService
+	}{
+		{
+			title: "deletes the processor",
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, nil), nil)
+				sps.EXPECT().Delete(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil)
+				return sps
+			},
+		},
+		{
+			title: "processor already gone",
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).Return(nil, streamprocessor.ErrNotFound)
+				return sps
+			},
+		},
+		{
+			title:       "keeps the processor",
+			annotations: map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep},
+			service: func() streamprocessor.StreamProcessorService {
+				sps := akomock.NewStreamProcessorServiceMock(t)
+				sps.EXPECT().Get(mock.Anything, testProjectID, testInstanceName, testProcessorName).
+					Return(testProcessor(t, streamprocessor.StateStarted, nil), nil)
+				return sps
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			deletionTime := metav1.Now()
+			streamProcessor := &akov2.AtlasStreamProcessor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "processor",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTime,
+				},
+				Spec: testSpec(),
+			}
+			k8sClient := testClient(t, streamProcessor)
+			workflowCtx := &workflow.Context{Context: context.Background()}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:       testProjectID,
+				instanceName:    testInstanceName,
+				streamProcessor: streamProcessor,
+				service:         tc.service(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Empty(t, getStreamProcessor(t, k8sClient, client.ObjectKeyFromObject(streamProcessor)).GetFinalizers())
+		})
+	}
+}
+
+func testSpec() akov2.AtlasStreamProcessorSpec {
+	return akov2.AtlasStreamProcessorSpec{
+		Name:     testProcessorName,
+		Instance: common.ResourceRefNamespaced{Name: "instance"},
+		Pipeline: apiextensions.JSON{Raw: []byte(`[{"$source":{"connectionName":"sample_stream_solar"}}]`)},
+	}
+}
+
+func testConfig(t *testing.T) *streamprocessor.StreamProcessorConfig {
+	spec := testSpec()
+	config, err := streamprocessor.NewStreamProcessorConfig(&spec)
+	require.NoError(t, err)
+	return config
+}
+
+func testProcessor(t *testing.T, state string, stats *status.StreamProcessorStats) *streamprocessor.StreamProcessor {
+	return &streamprocessor.StreamProcessor{
+		StreamProcessorConfig: *testConfig(t),
+		ID:                    testProcessorID,
+		State:                 state,
+		Stats:                 stats,
+	}
+}
+
+func testStatus(state string, stats *status.StreamProcessorStats) status.AtlasStreamProcessorStatus {
+	return status.AtlasStreamProcessorStatus{
+		ID:           testProcessorID,
+		State:        state,
+		ProjectID:    testProjectID,
+		InstanceName: testInstanceName,
+		Stats:        stats,
+	}
+}
+
+func testClient(t *testing.T, streamProcessor *akov2.AtlasStreamProcessor) client.Client {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(streamProcessor).
+		WithStatusSubresource(streamProcessor).
+		Build()
+}
+
+func getStreamProcessor(t *testing.T, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasStreamProcessor {
+	streamProcessor := &akov2.AtlasStreamProcessor{}
+	if err := k8sClient.Get(context.Background(), key, streamProcessor); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return streamProcessor
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasStreamProcessorReconciler {
+	return &AtlasStreamProcessorReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder:         record.NewFakeRecorder(10),
+		independentSyncPeriod: testSyncPeriod,
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
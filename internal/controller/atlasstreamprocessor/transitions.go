@@ -0,0 +1,156 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasstreamprocessor
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/streamprocessor"
+)
+
+func (r *AtlasStreamProcessorReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest, config *streamprocessor.StreamProcessorConfig) (ctrl.Result, error) {
+	createdProcessor, err := req.service.Create(workflowCtx.Context, req.projectID, req.instanceName, config)
+	if err != nil {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotCreated, err)
+	}
+	return r.ensureState(workflowCtx, req, createdProcessor)
+}
+
+// sync updates the pipeline and options of the processor when changed.
+// Running processors cannot be modified, so they are stopped, modified and started again.
+func (r *AtlasStreamProcessorReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, config *streamprocessor.StreamProcessorConfig, atlasProcessor *streamprocessor.StreamProcessor) (ctrl.Result, error) {
+	if !streamprocessor.NeedsUpdate(config, atlasProcessor) {
+		return r.ensureState(workflowCtx, req, atlasProcessor)
+	}
+	if atlasProcessor.State == streamprocessor.StateStarted {
+		if err := req.service.Stop(workflowCtx.Context, req.projectID, req.instanceName, config.Name); err != nil {
+			return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotUpdated, err)
+		}
+	}
+	updatedProcessor, err := req.service.Update(workflowCtx.Context, req.projectID, req.instanceName, config)
+	if err != nil {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotUpdated, err)
+	}
+	return r.ensureState(workflowCtx, req, updatedProcessor)
+}
+
+// ensureState starts or stops the processor to match the desired state.
+// A failed processor is only started again once its spec changes.
+func (r *AtlasStreamProcessorReconciler) ensureState(workflowCtx *workflow.Context, req *reconcileRequest, atlasProcessor *streamprocessor.StreamProcessor) (ctrl.Result, error) {
+	name := req.streamProcessor.Spec.Name
+	specChanged := req.streamProcessor.Status.ObservedGeneration != req.streamProcessor.Generation
+	var err error
+	switch desiredState := req.streamProcessor.DesiredState(); {
+	case desiredState == akov2.StreamProcessorStateStarted && atlasProcessor.State == streamprocessor.StateFailed && !specChanged:
+		return r.ready(workflowCtx, req, atlasProcessor)
+	case desiredState == akov2.StreamProcessorStateStarted && atlasProcessor.State != streamprocessor.StateStarted:
+		err = req.service.Start(workflowCtx.Context, req.projectID, req.instanceName, name)
+	case desiredState == akov2.StreamProcessorStateStopped && atlasProcessor.State == streamprocessor.StateStarted:
+		err = req.service.Stop(workflowCtx.Context, req.projectID, req.instanceName, name)
+	default:
+		return r.ready(workflowCtx, req, atlasProcessor)
+	}
+	if err != nil {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotUpdated, err)
+	}
+
+	refreshedProcessor, err := req.service.Get(workflowCtx.Context, req.projectID, req.instanceName, name)
+	if err != nil {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotConfigured, err)
+	}
+	return r.ready(workflowCtx, req, refreshedProcessor)
+}
+
+func (r *AtlasStreamProcessorReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.streamProcessor, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.streamProcessor)
+	}
+	err := req.service.Delete(workflowCtx.Context, req.projectID, req.instanceName, req.streamProcessor.Spec.Name)
+	if err != nil && !errors.Is(err, streamprocessor.ErrNotFound) {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.StreamProcessorNotRemoved, err)
+	}
+	return r.unmanage(workflowCtx, req.streamProcessor)
+}
+
+// ready reports the state of the processor in Atlas. Processors are polled to refresh their stats.
+func (r *AtlasStreamProcessorReconciler) ready(workflowCtx *workflow.Context, req *reconcileRequest, atlasProcessor *streamprocessor.StreamProcessor) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, req.streamProcessor, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, req.streamProcessor, workflow.AtlasFinalizerNotSet, err)
+	}
+	workflowCtx.EnsureStatusOption(updateStreamProcessorStatusOption(req, atlasProcessor))
+
+	if atlasProcessor.State == streamprocessor.StateFailed {
+		err := fmt.Errorf("stream processor %s failed, update its spec to start it again", atlasProcessor.Name)
+		result := workflow.Terminate(workflow.StreamProcessorFailed, err).WithoutRetry()
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.StreamProcessorReadyType, result)
+		return result.ReconcileResult()
+	}
+	msg := fmt.Sprintf("Stream processor %s is %s", atlasProcessor.Name, atlasProcessor.State)
+	if !inDesiredState(req.streamProcessor.DesiredState(), atlasProcessor) {
+		result := workflow.InProgress(workflow.StreamProcessorInProgress, msg)
+		workflowCtx.SetConditionFalse(api.ReadyType).SetConditionFromResult(api.StreamProcessorReadyType, result)
+		return result.ReconcileResult()
+	}
+
+	workflowCtx.SetConditionTrueMsg(api.StreamProcessorReadyType, msg).
+		SetConditionTrue(api.ReadyType)
+	return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
+}
+
+// inDesiredState is true when the processor runs or not as desired, created processors never ran
+func inDesiredState(desiredState string, atlasProcessor *streamprocessor.StreamProcessor) bool {
+	if desiredState == akov2.StreamProcessorStateStopped {
+		return atlasProcessor.State == streamprocessor.StateStopped || atlasProcessor.State == streamprocessor.StateCreated
+	}
+	return atlasProcessor.State == streamprocessor.StateStarted
+}
+
+func (r *AtlasStreamProcessorReconciler) unmanage(workflowCtx *workflow.Context, streamProcessor *akov2.AtlasStreamProcessor) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, streamProcessor, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, streamProcessor, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasStreamProcessorReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateStreamProcessorStatusOption(req *reconcileRequest, atlasProcessor *streamprocessor.StreamProcessor) status.AtlasStreamProcessorStatusOption {
+	return func(processorStatus *status.AtlasStreamProcessorStatus) {
+		processorStatus.ProjectID = req.projectID
+		processorStatus.InstanceName = req.instanceName
+		streamprocessor.ApplyStreamProcessorStatus(processorStatus, atlasProcessor)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/thirdpartyintegration"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 )
@@ -62,6 +63,10 @@ func (h *AtlasThirdPartyIntegrationHandler) HandleDeletionRequested(ctx context.
 
 func (h *AtlasThirdPartyIntegrationHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
 	integration *akov2.AtlasThirdPartyIntegration) (ctrlstate.Result, error) {
+	if err := customresource.UnsupportedReconciliationPolicy(integration, "AtlasThirdPartyIntegration"); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	req, err := h.newReconcileRequest(ctx, integration)
 	if err != nil {
 		return result.Error(currentState, fmt.Errorf("failed to build reconcile request: %w", err))
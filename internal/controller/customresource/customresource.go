@@ -32,14 +32,22 @@ import (
 )
 
 const (
-	ResourcePolicyAnnotation       = "mongodb.com/atlas-resource-policy"
-	ReconciliationPolicyAnnotation = "mongodb.com/atlas-reconciliation-policy"
-	ResourceVersion                = "mongodb.com/atlas-resource-version"
-	ResourceVersionOverride        = "mongodb.com/atlas-resource-version-policy"
-	ResourcePolicyKeep             = "keep"
-	ResourcePolicyDelete           = "delete"
-	ReconciliationPolicySkip       = "skip"
-	ResourceVersionAllow           = "allow"
+	ResourcePolicyAnnotation         = "mongodb.com/atlas-resource-policy"
+	ReconciliationPolicyAnnotation   = "mongodb.com/atlas-reconciliation-policy"
+	ResourceVersion                  = "mongodb.com/atlas-resource-version"
+	ResourceVersionOverride          = "mongodb.com/atlas-resource-version-policy"
+	ResourcePolicyKeep               = "keep"
+	ResourcePolicyDelete             = "delete"
+	ReconciliationPolicySkip         = "skip"
+	ReconciliationPolicyDetectOnly   = "detect-only"
+	ResourceVersionAllow             = "allow"
+	ApprovalPolicyAnnotation         = "mongodb.com/atlas-approval-policy"
+	ApproveAnnotation                = "mongodb.com/atlas-approve"
+	ApprovedByAnnotation             = "mongodb.com/atlas-approved-by"
+	ApprovalPolicyRequired           = "required"
+	ApprovalPolicyDisabled           = "disabled"
+	DeletionGracePeriodAnnotation    = "mongodb.com/atlas-deletion-grace-period"
+	FinalSnapshotRetentionAnnotation = "mongodb.com/atlas-final-snapshot-retention-days"
 )
 
 // PrepareResource queries the Custom Resource 'request.NamespacedName' and populates the 'resource' pointer.
@@ -93,6 +101,16 @@ func IsResourcePolicyKeepOrDefault(resource metav1.Object, protectionFlag bool)
 	return protectionFlag
 }
 
+// IsApprovalRequired returns 'true' if destructive operations on the resource must be approved, the annotation
+// overriding the operator-wide default given by approvalFlag.
+func IsApprovalRequired(resource metav1.Object, approvalFlag bool) bool {
+	if policy, ok := resource.GetAnnotations()[ApprovalPolicyAnnotation]; ok {
+		return policy == ApprovalPolicyRequired
+	}
+
+	return approvalFlag
+}
+
 // IsResourcePolicyKeep returns 'true' if the resource should not be removed from Atlas on K8s resource removal.
 func IsResourcePolicyKeep(resource akov2.AtlasCustomResource) bool {
 	if v, ok := resource.GetAnnotations()[ResourcePolicyAnnotation]; ok {
@@ -144,6 +162,31 @@ func ReconciliationShouldBeSkipped(resource metav1.Object) bool {
 	return false
 }
 
+// ReconciliationIsDetectOnly returns 'true' if differences with Atlas must be reported but never applied to Atlas.
+func ReconciliationIsDetectOnly(resource metav1.Object) bool {
+	return resource.GetAnnotations()[ReconciliationPolicyAnnotation] == ReconciliationPolicyDetectOnly
+}
+
+// UnsupportedReconciliationPolicy returns an error for the detect-only reconciliation policy set on a kind that
+// cannot report drift. Such resources would keep applying their spec to Atlas, so they are rejected instead.
+func UnsupportedReconciliationPolicy(resource metav1.Object, kind string) error {
+	if !ReconciliationIsDetectOnly(resource) {
+		return nil
+	}
+	return fmt.Errorf("the %s reconciliation policy is not supported by %s, remove the %s annotation",
+		ReconciliationPolicyDetectOnly, kind, ReconciliationPolicyAnnotation)
+}
+
+// ValidateReconciliationPolicy rejects the detect-only reconciliation policy on kinds that cannot report drift.
+func ValidateReconciliationPolicy(ctx *workflow.Context, resource metav1.Object, kind string) workflow.DeprecatedResult {
+	if err := UnsupportedReconciliationPolicy(resource, kind); err != nil {
+		result := workflow.Terminate(workflow.AtlasReconciliationPolicyUnsupported, err)
+		ctx.SetConditionFromResult(api.ReadyType, result)
+		return result
+	}
+	return workflow.OK()
+}
+
 // SetAnnotation sets an annotation in resource while respecting the rest of annotations.
 func SetAnnotation(resource akov2.AtlasCustomResource, key, value string) {
 	annot := resource.GetAnnotations()
@@ -98,6 +98,42 @@ func TestReconciliationShouldBeSkipped(t *testing.T) {
 	})
 }
 
+func TestReconciliationIsDetectOnly(t *testing.T) {
+	deployment := &akov2.AtlasDeployment{}
+	assert.False(t, ReconciliationIsDetectOnly(deployment))
+
+	deployment.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicySkip})
+	assert.False(t, ReconciliationIsDetectOnly(deployment))
+
+	deployment.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicyDetectOnly})
+	assert.True(t, ReconciliationIsDetectOnly(deployment))
+	assert.False(t, ReconciliationShouldBeSkipped(deployment))
+}
+
+func TestUnsupportedReconciliationPolicy(t *testing.T) {
+	project := &akov2.AtlasProject{}
+	assert.NoError(t, UnsupportedReconciliationPolicy(project, "AtlasProject"))
+
+	project.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicySkip})
+	assert.NoError(t, UnsupportedReconciliationPolicy(project, "AtlasProject"))
+
+	project.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicyDetectOnly})
+	assert.EqualError(t, UnsupportedReconciliationPolicy(project, "AtlasProject"),
+		"the detect-only reconciliation policy is not supported by AtlasProject, remove the mongodb.com/atlas-reconciliation-policy annotation")
+}
+
+func TestIsApprovalRequired(t *testing.T) {
+	deployment := &akov2.AtlasDeployment{}
+	assert.False(t, IsApprovalRequired(deployment, false))
+	assert.True(t, IsApprovalRequired(deployment, true))
+
+	deployment.SetAnnotations(map[string]string{ApprovalPolicyAnnotation: ApprovalPolicyRequired})
+	assert.True(t, IsApprovalRequired(deployment, false))
+
+	deployment.SetAnnotations(map[string]string{ApprovalPolicyAnnotation: ApprovalPolicyDisabled})
+	assert.False(t, IsApprovalRequired(deployment, true))
+}
+
 func TestResourceVersionIsValid(t *testing.T) {
 	tests := []struct {
 		name            string
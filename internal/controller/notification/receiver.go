@@ -0,0 +1,347 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notification receives the alert notifications sent by the webhook
+// integration of Atlas projects, and triggers the reconciliation of the
+// resources the notifications refer to, so that changes made in Atlas are
+// noticed without waiting for the next resync.
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Atlas signs webhook notifications with HMAC-SHA1
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+)
+
+const (
+	// Path is the path notifications are received on.
+	Path = "/notifications"
+
+	// SignatureHeader holds the base64 encoded HMAC-SHA1 of the request body,
+	// keyed with the secret configured in the Atlas webhook integration.
+	SignatureHeader = "X-MMS-Signature"
+
+	// SecretKey is the key of the Kubernetes Secret holding the shared secret.
+	SecretKey = "secret"
+
+	// PortName is the name of the port of the Service routing the notifications to the leader.
+	PortName = "notifications"
+
+	// SecretTTL is how long the shared secret is kept before it is read again from the Secret.
+	SecretTTL = 30 * time.Second
+
+	maxBodySize = 1 << 20
+	queueSize   = 1024
+
+	fieldOwner = "atlas-notifications"
+)
+
+// Notification holds the fields of an Atlas alert notification used to find
+// the affected resources.
+type Notification struct {
+	ID            string `json:"id"`
+	GroupID       string `json:"groupId"`
+	ClusterName   string `json:"clusterName"`
+	EventTypeName string `json:"eventTypeName"`
+}
+
+// Receiver is an HTTP endpoint receiving Atlas notifications. The AtlasProject and
+// AtlasDeployment resources of the notified project and cluster are sent to the
+// channels returned by Projects and Deployments, which the reconcilers watch.
+type Receiver struct {
+	address      string
+	secretRef    client.ObjectKey
+	kubeClient   client.Client
+	secretReader client.Reader
+	logger       *zap.SugaredLogger
+	service      client.ObjectKey
+	podIP        string
+
+	mu            sync.Mutex
+	sharedSecret  []byte
+	secretExpires time.Time
+
+	projects    chan event.GenericEvent
+	deployments chan event.GenericEvent
+}
+
+// NewReceiver returns a receiver listening on the given address. The resources are looked up
+// with the field indexes of kubeClient, the shared secret is read with secretReader, which should
+// not be cached as the Secret does not carry the labels of the Secrets cached by the operator.
+// The shared secret is then kept for SecretTTL.
+func NewReceiver(address string, secretRef client.ObjectKey, kubeClient client.Client, secretReader client.Reader, logger *zap.Logger) *Receiver {
+	return &Receiver{
+		address:      address,
+		secretRef:    secretRef,
+		kubeClient:   kubeClient,
+		secretReader: secretReader,
+		logger:       logger.Named("atlas-notifications").Sugar(),
+		projects:     make(chan event.GenericEvent, queueSize),
+		deployments:  make(chan event.GenericEvent, queueSize),
+	}
+}
+
+// Projects returns the channel of AtlasProject resources to reconcile.
+func (r *Receiver) Projects() <-chan event.GenericEvent {
+	return r.projects
+}
+
+// Deployments returns the channel of AtlasDeployment resources to reconcile.
+func (r *Receiver) Deployments() <-chan event.GenericEvent {
+	return r.deployments
+}
+
+// WithService routes the given Service, which has no selector, to the receiver: once it listens, the receiver
+// publishes the given IP of its Pod as the only endpoint of the Service.
+func (r *Receiver) WithService(service client.ObjectKey, podIP string) *Receiver {
+	r.service = service
+	r.podIP = podIP
+	return r
+}
+
+// NeedLeaderElection returns true, notifications are received by the leader only, as only the leader
+// reconciles the resources they refer to. The Service set with WithService routes them to the leader.
+func (r *Receiver) NeedLeaderElection() bool {
+	return true
+}
+
+// Start serves notifications until the context is cancelled.
+func (r *Receiver) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", r.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen for Atlas notifications: %w", err)
+	}
+	if r.service.Name != "" {
+		if err := r.publishEndpoint(ctx, listener.Addr()); err != nil {
+			_ = listener.Close()
+			return err
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, r)
+	server := &http.Server{
+		Addr:              r.address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			r.logger.Errorf("failed to shut down the notification server: %v", err)
+		}
+	}()
+
+	r.logger.Infof("receiving Atlas notifications on %s%s", r.address, Path)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve Atlas notifications: %w", err)
+	}
+	return nil
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read the request body", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := r.secret(req.Context())
+	if err != nil {
+		r.logger.Errorf("failed to read the shared secret: %v", err)
+		http.Error(w, "failed to verify the notification", http.StatusInternalServerError)
+		return
+	}
+	if !verify(body, req.Header.Get(SignatureHeader), secret) {
+		r.logger.Warnf("rejected a notification with an invalid signature from %s", req.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	notification := Notification{}
+	if err := json.Unmarshal(body, &notification); err != nil {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.enqueue(req.Context(), &notification); err != nil {
+		r.logger.Errorf("failed to find the resources of notification %s: %v", notification.ID, err)
+		http.Error(w, "failed to process the notification", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// publishEndpoint makes the EndpointSlice of the Service hold the address of this replica. A new leader
+// replaces the address of the previous one.
+func (r *Receiver) publishEndpoint(ctx context.Context, addr net.Addr) error {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return fmt.Errorf("unexpected listener address %s", addr)
+	}
+	ip := net.ParseIP(r.podIP)
+	if ip == nil {
+		return fmt.Errorf("invalid Pod IP %q", r.podIP)
+	}
+	addressType := discoveryv1.AddressTypeIPv4
+	if ip.To4() == nil {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+
+	endpointSlice := &discoveryv1.EndpointSlice{
+		TypeMeta: metav1.TypeMeta{APIVersion: discoveryv1.SchemeGroupVersion.String(), Kind: "EndpointSlice"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.service.Name,
+			Namespace: r.service.Namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: r.service.Name,
+				discoveryv1.LabelManagedBy:   fieldOwner,
+			},
+		},
+		AddressType: addressType,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{r.podIP},
+				Conditions: discoveryv1.EndpointConditions{Ready: new(true)},
+			},
+		},
+		Ports: []discoveryv1.EndpointPort{
+			{
+				Name:     new(PortName),
+				Port:     new(int32(tcpAddr.Port)), //nolint:gosec // ports fit in int32
+				Protocol: new(corev1.ProtocolTCP),
+			},
+		},
+	}
+	if err := r.kubeClient.Patch(ctx, endpointSlice, client.Apply, client.ForceOwnership, client.FieldOwner(fieldOwner)); err != nil {
+		return fmt.Errorf("failed to route Service %s to %s: %w", r.service, r.podIP, err)
+	}
+	r.logger.Infof("routed Service %s to %s", r.service, r.podIP)
+	return nil
+}
+
+// secret returns the shared secret, read again from the Secret once SecretTTL elapsed. Notifications,
+// with a valid signature or not, never read the Secret more often: concurrent notifications wait for
+// the one reading it.
+func (r *Receiver) secret(ctx context.Context) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sharedSecret != nil && time.Now().Before(r.secretExpires) {
+		return r.sharedSecret, nil
+	}
+
+	value, err := r.readSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.sharedSecret = value
+	r.secretExpires = time.Now().Add(SecretTTL)
+	return value, nil
+}
+
+func (r *Receiver) readSecret(ctx context.Context) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.secretReader.Get(ctx, r.secretRef, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", r.secretRef, err)
+	}
+	value, ok := secret.Data[SecretKey]
+	if !ok || len(value) == 0 {
+		return nil, fmt.Errorf("secret %s has no %q key", r.secretRef, SecretKey)
+	}
+	return value, nil
+}
+
+// enqueue sends the AtlasProjects of the notified project and, for notifications about a
+// cluster, the AtlasDeployments of that cluster to the reconcilers.
+func (r *Receiver) enqueue(ctx context.Context, notification *Notification) error {
+	if notification.GroupID == "" {
+		return nil
+	}
+
+	projects := &akov2.AtlasProjectList{}
+	if err := r.kubeClient.List(ctx, projects, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasProjectByIDIndex, notification.GroupID),
+	}); err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	for i := range projects.Items {
+		r.send(r.projects, &projects.Items[i], notification)
+	}
+
+	if notification.ClusterName == "" {
+		return nil
+	}
+
+	deployments := &akov2.AtlasDeploymentList{}
+	if err := r.kubeClient.List(ctx, deployments, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasDeploymentByProject, notification.GroupID),
+	}); err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		if deployments.Items[i].GetDeploymentName() == notification.ClusterName {
+			r.send(r.deployments, &deployments.Items[i], notification)
+		}
+	}
+	return nil
+}
+
+// send never blocks: the object is dropped when the queue is full, it is still reconciled on the next resync.
+func (r *Receiver) send(queue chan event.GenericEvent, obj client.Object, notification *Notification) {
+	select {
+	case queue <- event.GenericEvent{Object: obj}:
+		r.logger.Debugf("enqueued %T %s for notification %s (%s)", obj, client.ObjectKeyFromObject(obj), notification.ID, notification.EventTypeName)
+	default:
+		r.logger.Warnf("dropped %T %s for notification %s, the queue is full", obj, client.ObjectKeyFromObject(obj), notification.ID)
+	}
+}
+
+func verify(body []byte, signature string, secret []byte) bool {
+	got, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
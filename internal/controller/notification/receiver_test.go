@@ -0,0 +1,275 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Atlas signs webhook notifications with HMAC-SHA1
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+)
+
+const testSecret = "shared-secret"
+
+func TestReceiver(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		method              string
+		body                string
+		signature           string
+		secret              *corev1.Secret
+		expectedCode        int
+		expectedProjects    []string
+		expectedDeployments []string
+	}{
+		{
+			name:         "should reject other methods",
+			method:       http.MethodGet,
+			secret:       sharedSecret(testSecret),
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:         "should fail without the shared secret",
+			body:         `{"groupId":"project-id"}`,
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "should reject a missing signature",
+			body:         `{"groupId":"project-id"}`,
+			signature:    "",
+			secret:       sharedSecret(testSecret),
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "should reject a signature made with another secret",
+			body:         `{"groupId":"project-id"}`,
+			signature:    sign(`{"groupId":"project-id"}`, "other-secret"),
+			secret:       sharedSecret(testSecret),
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "should reject an invalid payload",
+			body:         `{"groupId":`,
+			signature:    sign(`{"groupId":`, testSecret),
+			secret:       sharedSecret(testSecret),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:             "should enqueue the projects of a project notification",
+			body:             `{"id":"alert-id","groupId":"project-id","eventTypeName":"USERS_WITHOUT_MULTI_FACTOR_AUTH"}`,
+			signature:        sign(`{"id":"alert-id","groupId":"project-id","eventTypeName":"USERS_WITHOUT_MULTI_FACTOR_AUTH"}`, testSecret),
+			secret:           sharedSecret(testSecret),
+			expectedCode:     http.StatusAccepted,
+			expectedProjects: []string{"ns/project"},
+		},
+		{
+			name:                "should enqueue the project and deployment of a cluster notification",
+			body:                `{"id":"alert-id","groupId":"project-id","clusterName":"cluster0","eventTypeName":"CLUSTER_MONGOS_IS_MISSING"}`,
+			signature:           sign(`{"id":"alert-id","groupId":"project-id","clusterName":"cluster0","eventTypeName":"CLUSTER_MONGOS_IS_MISSING"}`, testSecret),
+			secret:              sharedSecret(testSecret),
+			expectedCode:        http.StatusAccepted,
+			expectedProjects:    []string{"ns/project"},
+			expectedDeployments: []string{"ns/cluster0", "other/external-cluster0"},
+		},
+		{
+			name:         "should accept notifications of unknown projects",
+			body:         `{"id":"alert-id","groupId":"unknown-id","clusterName":"cluster0"}`,
+			signature:    sign(`{"id":"alert-id","groupId":"unknown-id","clusterName":"cluster0"}`, testSecret),
+			secret:       sharedSecret(testSecret),
+			expectedCode: http.StatusAccepted,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := testObjects()
+			if tc.secret != nil {
+				objects = append(objects, tc.secret)
+			}
+			kubeClient := testClient(t, objects...)
+			receiver := NewReceiver(":0", client.ObjectKey{Namespace: "operator", Name: "atlas-webhook"}, kubeClient, kubeClient, zaptest.NewLogger(t))
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, Path, strings.NewReader(tc.body))
+			req.Header.Set(SignatureHeader, tc.signature)
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			assert.Equal(t, tc.expectedProjects, drain(receiver.projects))
+			assert.Equal(t, tc.expectedDeployments, drain(receiver.deployments))
+		})
+	}
+}
+
+func TestReceiverKeepsSharedSecret(t *testing.T) {
+	kubeClient := testClient(t, append(testObjects(), sharedSecret(testSecret))...)
+	receiver := NewReceiver(":0", client.ObjectKey{Namespace: "operator", Name: "atlas-webhook"}, kubeClient, kubeClient, zaptest.NewLogger(t))
+	notify := func() int {
+		body := `{"id":"alert-id","groupId":"project-id"}`
+		req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body))
+		req.Header.Set(SignatureHeader, sign(body, testSecret))
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		drain(receiver.projects)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusAccepted, notify())
+	require.NoError(t, kubeClient.Delete(t.Context(), sharedSecret(testSecret)))
+	assert.Equal(t, http.StatusAccepted, notify(), "the shared secret must not be read on every notification")
+
+	receiver.secretExpires = time.Now().Add(-time.Second)
+	assert.Equal(t, http.StatusInternalServerError, notify(), "the shared secret must be read again once expired")
+}
+
+func TestPublishEndpoint(t *testing.T) {
+	var applied *discoveryv1.EndpointSlice
+	kubeClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+			assert.Equal(t, types.ApplyPatchType, patch.Type())
+			applied = obj.(*discoveryv1.EndpointSlice)
+			return nil
+		},
+	}).Build()
+	receiver := NewReceiver(":0", client.ObjectKey{Namespace: "operator", Name: "atlas-webhook"}, kubeClient, kubeClient, zaptest.NewLogger(t)).
+		WithService(client.ObjectKey{Namespace: "operator", Name: "atlas-operator-notifications"}, "10.0.0.12")
+
+	require.NoError(t, receiver.publishEndpoint(t.Context(), &net.TCPAddr{Port: 8082}))
+
+	require.NotNil(t, applied)
+	assert.Equal(t, "atlas-operator-notifications", applied.Name)
+	assert.Equal(t, "atlas-operator-notifications", applied.Labels[discoveryv1.LabelServiceName])
+	assert.Equal(t, discoveryv1.AddressTypeIPv4, applied.AddressType)
+	require.Len(t, applied.Endpoints, 1)
+	assert.Equal(t, []string{"10.0.0.12"}, applied.Endpoints[0].Addresses)
+	require.Len(t, applied.Ports, 1)
+	assert.Equal(t, PortName, *applied.Ports[0].Name)
+	assert.Equal(t, int32(8082), *applied.Ports[0].Port)
+
+	receiver.podIP = "not-an-ip"
+	assert.ErrorContains(t, receiver.publishEndpoint(t.Context(), &net.TCPAddr{Port: 8082}), `invalid Pod IP "not-an-ip"`)
+}
+
+func testObjects() []client.Object {
+	project := &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns"},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+	otherProject := &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-project", Namespace: "ns"},
+		Status:     status.AtlasProjectStatus{ID: "other-project-id"},
+	}
+	deployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "ns"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ProjectRef: &common.ResourceRefNamespaced{Name: "project"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+		},
+	}
+	otherDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: "ns"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ProjectRef: &common.ResourceRefNamespaced{Name: "project"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster1"},
+		},
+	}
+	externalDeployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "external-cluster0", Namespace: "other"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{
+				ExternalProjectRef: &akov2.ExternalProjectReference{ID: "project-id"},
+			},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: "cluster0"},
+		},
+	}
+	return []client.Object{project, otherProject, deployment, otherDeployment, externalDeployment}
+}
+
+func testClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+
+	// the deployment indexer resolves project references, it needs a client holding the projects
+	projectClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	projectIndexer := indexer.NewAtlasProjectByIDIndexer(zaptest.NewLogger(t))
+	deploymentIndexer := indexer.NewAtlasDeploymentByProjectIndexer(t.Context(), projectClient, zaptest.NewLogger(t))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithIndex(projectIndexer.Object(), projectIndexer.Name(), projectIndexer.Keys).
+		WithIndex(deploymentIndexer.Object(), deploymentIndexer.Name(), deploymentIndexer.Keys).
+		Build()
+}
+
+func sharedSecret(value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "atlas-webhook", Namespace: "operator"},
+		Data:       map[string][]byte{SecretKey: []byte(value)},
+	}
+}
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func drain(queue chan event.GenericEvent) []string {
+	var keys []string
+	for {
+		select {
+		case e := <-queue:
+			keys = append(keys, client.ObjectKeyFromObject(e.Object).String())
+		default:
+			slices.Sort(keys)
+			return keys
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+// CheckCluster returns Violations if the generated Cluster doesn't comply with the deployment policies of its namespace.
+func CheckCluster(ctx context.Context, reader client.Reader, cluster *akov2generated.Cluster) error {
+	if cluster.Spec.V20250312 == nil || cluster.Spec.V20250312.Entry == nil {
+		return nil
+	}
+	deployment := &akov2.AtlasDeployment{
+		Spec: akov2.AtlasDeploymentSpec{DeploymentSpec: clusterDeploymentSpec(cluster.Spec.V20250312.Entry)},
+	}
+	return check(ctx, reader, cluster.Namespace, func(spec *akov2.AtlasPolicySpec) error {
+		if spec.Deployments == nil {
+			return nil
+		}
+		return validate.DeploymentPolicy(deployment, spec.Deployments)
+	})
+}
+
+// CheckFlexCluster returns Violations if the generated FlexCluster doesn't comply with the deployment policies of its namespace.
+func CheckFlexCluster(ctx context.Context, reader client.Reader, flexCluster *akov2generated.FlexCluster) error {
+	if flexCluster.Spec.V20250312 == nil || flexCluster.Spec.V20250312.Entry == nil {
+		return nil
+	}
+	settings := flexCluster.Spec.V20250312.Entry.ProviderSettings
+	deployment := &akov2.AtlasDeployment{
+		Spec: akov2.AtlasDeploymentSpec{
+			FlexSpec: &akov2.FlexSpec{
+				ProviderSettings: &akov2.FlexProviderSettings{BackingProviderName: settings.BackingProviderName, RegionName: settings.RegionName},
+			},
+		},
+	}
+	return check(ctx, reader, flexCluster.Namespace, func(spec *akov2.AtlasPolicySpec) error {
+		if spec.Deployments == nil {
+			return nil
+		}
+		return validate.DeploymentPolicy(deployment, spec.Deployments)
+	})
+}
+
+// CheckIPAccessListEntry returns Violations if the generated IPAccessListEntry doesn't comply with the policies of its namespace.
+func CheckIPAccessListEntry(ctx context.Context, reader client.Reader, entry *akov2generated.IPAccessListEntry) error {
+	if entry.Spec.V20250312 == nil || entry.Spec.V20250312.Entry == nil {
+		return nil
+	}
+	spec := entry.Spec.V20250312.Entry
+	return check(ctx, reader, entry.Namespace, func(policySpec *akov2.AtlasPolicySpec) error {
+		if policySpec.IPAccessList == nil {
+			return nil
+		}
+		return validate.IPAccessListPolicy(
+			pointer.GetOrDefault(spec.IpAddress, ""),
+			pointer.GetOrDefault(spec.CidrBlock, ""),
+			pointer.GetOrDefault(spec.AwsSecurityGroup, ""),
+			policySpec.IPAccessList,
+		)
+	})
+}
+
+// clusterDeploymentSpec returns the fields of the generated Cluster the deployment policies check.
+func clusterDeploymentSpec(entry *akov2generated.V20250312Entry) *akov2.AdvancedDeploymentSpec {
+	spec := &akov2.AdvancedDeploymentSpec{
+		MongoDBMajorVersion: pointer.GetOrDefault(entry.MongoDBMajorVersion, ""),
+		BackupEnabled:       entry.BackupEnabled,
+	}
+	for _, replicationSpec := range pointer.GetOrDefault(entry.ReplicationSpecs, nil) {
+		advancedReplicationSpec := &akov2.AdvancedReplicationSpec{}
+		for _, regionConfig := range pointer.GetOrDefault(replicationSpec.RegionConfigs, nil) {
+			advancedRegionConfig := &akov2.AdvancedRegionConfig{
+				ProviderName:         pointer.GetOrDefault(regionConfig.ProviderName, ""),
+				BackingProviderName:  pointer.GetOrDefault(regionConfig.BackingProviderName, ""),
+				RegionName:           pointer.GetOrDefault(regionConfig.RegionName, ""),
+				AutoScaling:          autoScaling(regionConfig.AutoScaling),
+				AnalyticsAutoScaling: autoScaling(regionConfig.AnalyticsAutoScaling),
+			}
+			if regionConfig.ElectableSpecs != nil {
+				advancedRegionConfig.ElectableSpecs = &akov2.Specs{InstanceSize: pointer.GetOrDefault(regionConfig.ElectableSpecs.InstanceSize, "")}
+			}
+			if regionConfig.ReadOnlySpecs != nil {
+				advancedRegionConfig.ReadOnlySpecs = &akov2.Specs{InstanceSize: pointer.GetOrDefault(regionConfig.ReadOnlySpecs.InstanceSize, "")}
+			}
+			if regionConfig.AnalyticsSpecs != nil {
+				advancedRegionConfig.AnalyticsSpecs = &akov2.Specs{InstanceSize: pointer.GetOrDefault(regionConfig.AnalyticsSpecs.InstanceSize, "")}
+			}
+			advancedReplicationSpec.RegionConfigs = append(advancedReplicationSpec.RegionConfigs, advancedRegionConfig)
+		}
+		spec.ReplicationSpecs = append(spec.ReplicationSpecs, advancedReplicationSpec)
+	}
+	return spec
+}
+
+func autoScaling(generated *akov2generated.AnalyticsAutoScaling) *akov2.AdvancedAutoScalingSpec {
+	if generated == nil || generated.Compute == nil {
+		return nil
+	}
+	return &akov2.AdvancedAutoScalingSpec{
+		Compute: &akov2.ComputeSpec{
+			Enabled:         generated.Compute.Enabled,
+			MinInstanceSize: pointer.GetOrDefault(generated.Compute.MinInstanceSize, ""),
+			MaxInstanceSize: pointer.GetOrDefault(generated.Compute.MaxInstanceSize, ""),
+		},
+	}
+}
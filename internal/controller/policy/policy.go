@@ -0,0 +1,207 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy evaluates the AtlasPolicy and AtlasClusterPolicy guardrails. The policies are
+// evaluated by the admission webhooks and by the reconcilers before changing anything in Atlas.
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+)
+
+// Retry is how often resources violating a policy are evaluated again, so that policy changes are picked up.
+const Retry = time.Minute
+
+// Policy is an AtlasPolicy or an AtlasClusterPolicy applying to a namespace.
+type Policy struct {
+	// Name identifies the policy, e.g. AtlasPolicy team-a/limits.
+	Name string
+	Spec *akov2.AtlasPolicySpec
+}
+
+// Violation lists the reasons a resource doesn't comply with a policy.
+type Violation struct {
+	Policy  string
+	Reasons []string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("%s violated: %s", v.Policy, strings.Join(v.Reasons, ", "))
+}
+
+// Violations is returned when a resource doesn't comply with one or more policies.
+type Violations []*Violation
+
+func (v Violations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// IsViolation returns true if the error is returned for resources that don't comply with a policy,
+// as opposed to a failure to evaluate the policies.
+func IsViolation(err error) bool {
+	var violations Violations
+	return errors.As(err, &violations)
+}
+
+// Terminate returns the result of a reconciliation stopped by the policies. Violations are reported
+// with the PolicyViolation reason, which is also the reason of the warning event, and evaluated again
+// after Retry instead of backing off, as only a change of the resource or of the policies fixes them.
+// Failures to evaluate the policies are retried as usual.
+func Terminate(err error) workflow.DeprecatedResult {
+	if IsViolation(err) {
+		return workflow.Terminate(workflow.PolicyViolation, err).CloneWithoutError().WithRetry(Retry)
+	}
+	return workflow.Terminate(workflow.Internal, err)
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlaspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlaspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasclusterpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Applicable returns the AtlasPolicies of the namespace and the AtlasClusterPolicies selecting it.
+func Applicable(ctx context.Context, reader client.Reader, namespace string) ([]Policy, error) {
+	policies := &akov2.AtlasPolicyList{}
+	if err := reader.List(ctx, policies, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasPolicies: %w", err)
+	}
+	clusterPolicies := &akov2.AtlasClusterPolicyList{}
+	if err := reader.List(ctx, clusterPolicies); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasClusterPolicies: %w", err)
+	}
+
+	applicable := make([]Policy, 0, len(policies.Items)+len(clusterPolicies.Items))
+	for i := range policies.Items {
+		p := &policies.Items[i]
+		applicable = append(applicable, Policy{Name: fmt.Sprintf("AtlasPolicy %s/%s", p.Namespace, p.Name), Spec: &p.Spec})
+	}
+
+	var namespaceLabels labels.Set
+	for i := range clusterPolicies.Items {
+		p := &clusterPolicies.Items[i]
+		if p.Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(p.Spec.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid namespaceSelector of AtlasClusterPolicy %s: %w", p.Name, err)
+			}
+			if namespaceLabels == nil {
+				ns := &corev1.Namespace{}
+				if err := reader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+					return nil, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+				}
+				namespaceLabels = ns.Labels
+				if namespaceLabels == nil {
+					namespaceLabels = labels.Set{}
+				}
+			}
+			if !selector.Matches(namespaceLabels) {
+				continue
+			}
+		}
+		applicable = append(applicable, Policy{Name: "AtlasClusterPolicy " + p.Name, Spec: &p.Spec.AtlasPolicySpec})
+	}
+
+	slices.SortFunc(applicable, func(a, b Policy) int { return strings.Compare(a.Name, b.Name) })
+	return applicable, nil
+}
+
+// CheckDeployment returns Violations if the deployment doesn't comply with the policies of its namespace.
+func CheckDeployment(ctx context.Context, reader client.Reader, deployment *akov2.AtlasDeployment) error {
+	return check(ctx, reader, deployment.Namespace, func(spec *akov2.AtlasPolicySpec) error {
+		if spec.Deployments == nil {
+			return nil
+		}
+		return validate.DeploymentPolicy(deployment, spec.Deployments)
+	})
+}
+
+// CheckProject returns Violations if the IP access list of the project doesn't comply with the policies of its namespace.
+func CheckProject(ctx context.Context, reader client.Reader, atlasProject *akov2.AtlasProject) error {
+	return check(ctx, reader, atlasProject.Namespace, func(spec *akov2.AtlasPolicySpec) error {
+		return ipAccessListPolicy(spec, atlasProject.Spec.ProjectIPAccessList, func(entry project.IPAccessList) (string, string, string) {
+			return entry.IPAddress, entry.CIDRBlock, entry.AwsSecurityGroup
+		})
+	})
+}
+
+// CheckIPAccessList returns Violations if the entries don't comply with the policies of their namespace.
+func CheckIPAccessList(ctx context.Context, reader client.Reader, ipAccessList *akov2.AtlasIPAccessList) error {
+	return check(ctx, reader, ipAccessList.Namespace, func(spec *akov2.AtlasPolicySpec) error {
+		return ipAccessListPolicy(spec, ipAccessList.Spec.Entries, func(entry akov2.IPAccessEntry) (string, string, string) {
+			return entry.IPAddress, entry.CIDRBlock, entry.AwsSecurityGroup
+		})
+	})
+}
+
+func ipAccessListPolicy[T any](spec *akov2.AtlasPolicySpec, entries []T, fields func(T) (string, string, string)) error {
+	if spec.IPAccessList == nil {
+		return nil
+	}
+	var errs []error
+	for _, entry := range entries {
+		ipAddress, cidrBlock, awsSecurityGroup := fields(entry)
+		errs = append(errs, validate.IPAccessListPolicy(ipAddress, cidrBlock, awsSecurityGroup, spec.IPAccessList))
+	}
+	return errors.Join(errs...)
+}
+
+func check(ctx context.Context, reader client.Reader, namespace string, validateSpec func(*akov2.AtlasPolicySpec) error) error {
+	policies, err := Applicable(ctx, reader, namespace)
+	if err != nil {
+		return err
+	}
+
+	var violations Violations
+	for _, p := range policies {
+		if err := validateSpec(p.Spec); err != nil {
+			violations = append(violations, &Violation{Policy: p.Name, Reasons: reasons(err)})
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// reasons splits the errors joined by the validate package.
+func reasons(err error) []string {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+	var result []string
+	for _, e := range joined.Unwrap() {
+		result = append(result, reasons(e)...)
+	}
+	return result
+}
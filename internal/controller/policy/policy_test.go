@@ -0,0 +1,258 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func TestApplicable(t *testing.T) {
+	objects := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tier": "dev"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"tier": "prod"}}},
+		&akov2.AtlasPolicy{ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "team-a"}},
+		&akov2.AtlasPolicy{ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "team-b"}},
+		&akov2.AtlasClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "all"}},
+		&akov2.AtlasClusterPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: akov2.AtlasClusterPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "dev"}},
+			},
+		},
+	}
+	reader := newFakeClient(t, objects...)
+
+	for _, tc := range []struct {
+		namespace string
+		expected  []string
+	}{
+		{namespace: "team-a", expected: []string{"AtlasClusterPolicy all", "AtlasClusterPolicy dev", "AtlasPolicy team-a/limits"}},
+		{namespace: "team-b", expected: []string{"AtlasClusterPolicy all", "AtlasPolicy team-b/limits"}},
+	} {
+		t.Run(tc.namespace, func(t *testing.T) {
+			policies, err := Applicable(context.Background(), reader, tc.namespace)
+			require.NoError(t, err)
+			names := make([]string, 0, len(policies))
+			for _, p := range policies {
+				names = append(names, p.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+
+	t.Run("missing namespace", func(t *testing.T) {
+		_, err := Applicable(context.Background(), reader, "missing")
+		require.ErrorContains(t, err, "failed to get namespace missing")
+	})
+}
+
+func TestCheckDeployment(t *testing.T) {
+	deployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "team-a"},
+		Spec: akov2.AtlasDeploymentSpec{
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+					{
+						RegionConfigs: []*akov2.AdvancedRegionConfig{
+							{
+								ProviderName:   "AWS",
+								RegionName:     "AP_SOUTH_1",
+								ElectableSpecs: &akov2.Specs{InstanceSize: "M700"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		objects       []client.Object
+		expectedError string
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name: "compliant",
+			objects: []client.Object{
+				&akov2.AtlasPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "team-a"},
+					Spec: akov2.AtlasPolicySpec{
+						Deployments: &akov2.DeploymentPolicy{AllowedProviders: []string{"AWS"}},
+					},
+				},
+			},
+		},
+		{
+			name: "policy without deployment restrictions",
+			objects: []client.Object{
+				&akov2.AtlasClusterPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "network"},
+					Spec: akov2.AtlasClusterPolicySpec{
+						AtlasPolicySpec: akov2.AtlasPolicySpec{
+							IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "violations of several policies",
+			objects: []client.Object{
+				&akov2.AtlasPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "team-a"},
+					Spec: akov2.AtlasPolicySpec{
+						Deployments: &akov2.DeploymentPolicy{AllowedRegions: []string{"US_EAST_1"}, MaxInstanceSize: "M60"},
+					},
+				},
+				&akov2.AtlasClusterPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "providers"},
+					Spec: akov2.AtlasClusterPolicySpec{
+						AtlasPolicySpec: akov2.AtlasPolicySpec{
+							Deployments: &akov2.DeploymentPolicy{AllowedProviders: []string{"GCP"}},
+						},
+					},
+				},
+			},
+			expectedError: "AtlasClusterPolicy providers violated: provider AWS is not allowed, allowed providers: [GCP]; " +
+				"AtlasPolicy team-a/limits violated: region AP_SOUTH_1 is not allowed, allowed regions: [US_EAST_1], " +
+				"instance size M700 is above the maximum instance size M60",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckDeployment(context.Background(), newFakeClient(t, tc.objects...), deployment)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedError)
+			assert.True(t, IsViolation(err))
+		})
+	}
+}
+
+func TestCheckIPAccessList(t *testing.T) {
+	reader := newFakeClient(t, &akov2.AtlasPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "team-a"},
+		Spec: akov2.AtlasPolicySpec{
+			IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+		},
+	})
+
+	atlasProject := &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "team-a"},
+		Spec: akov2.AtlasProjectSpec{
+			ProjectIPAccessList: []project.IPAccessList{{CIDRBlock: "10.1.0.0/16"}, {IPAddress: "8.8.8.8"}},
+		},
+	}
+	err := CheckProject(context.Background(), reader, atlasProject)
+	require.EqualError(t, err, "AtlasPolicy team-a/network violated: 8.8.8.8/32 is not within the allowed CIDRs [10.0.0.0/8]")
+
+	ipAccessList := &akov2.AtlasIPAccessList{
+		ObjectMeta: metav1.ObjectMeta{Name: "entries", Namespace: "team-a"},
+		Spec: akov2.AtlasIPAccessListSpec{
+			Entries: []akov2.IPAccessEntry{{CIDRBlock: "10.0.0.0/24"}, {IPAddress: "10.2.3.4"}},
+		},
+	}
+	require.NoError(t, CheckIPAccessList(context.Background(), reader, ipAccessList))
+}
+
+func TestCheckGeneratedResources(t *testing.T) {
+	reader := newFakeClient(t, &akov2.AtlasPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "team-a"},
+		Spec: akov2.AtlasPolicySpec{
+			Deployments:  &akov2.DeploymentPolicy{AllowedRegions: []string{"US_EAST_1"}, MaxInstanceSize: "M60", RequireBackup: true},
+			IPAccessList: &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}},
+		},
+	})
+
+	cluster := &akov2generated.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "team-a"},
+		Spec: akov2generated.ClusterSpec{
+			V20250312: &akov2generated.ClusterSpecV20250312{
+				Entry: &akov2generated.V20250312Entry{
+					ReplicationSpecs: &[]akov2generated.ReplicationSpecs{
+						{
+							RegionConfigs: &[]akov2generated.RegionConfigs{
+								{
+									ProviderName:   pointer.MakePtr("AWS"),
+									RegionName:     pointer.MakePtr("AP_SOUTH_1"),
+									ElectableSpecs: &akov2generated.ElectableSpecs{InstanceSize: pointer.MakePtr("M700")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	err := CheckCluster(context.Background(), reader, cluster)
+	require.EqualError(t, err, "AtlasPolicy team-a/limits violated: region AP_SOUTH_1 is not allowed, allowed regions: [US_EAST_1], "+
+		"instance size M700 is above the maximum instance size M60, backupEnabled must be true")
+	assert.True(t, IsViolation(err))
+
+	flexCluster := &akov2generated.FlexCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "flex", Namespace: "team-a"},
+		Spec: akov2generated.FlexClusterSpec{
+			V20250312: &akov2generated.FlexClusterSpecV20250312{
+				Entry: &akov2generated.FlexClusterSpecV20250312Entry{
+					ProviderSettings: akov2generated.ProviderSettings{BackingProviderName: "AWS", RegionName: "US_EAST_1"},
+				},
+			},
+		},
+	}
+	require.NoError(t, CheckFlexCluster(context.Background(), reader, flexCluster))
+
+	entry := &akov2generated.IPAccessListEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: "team-a"},
+		Spec: akov2generated.IPAccessListEntrySpec{
+			V20250312: &akov2generated.IPAccessListEntrySpecV20250312{
+				Entry: &akov2generated.IPAccessListEntrySpecV20250312Entry{IpAddress: pointer.MakePtr("8.8.8.8")},
+			},
+		},
+	}
+	require.EqualError(t, CheckIPAccessListEntry(context.Background(), reader, entry),
+		"AtlasPolicy team-a/limits violated: 8.8.8.8/32 is not within the allowed CIDRs [10.0.0.0/8]")
+}
+
+func TestIsViolation(t *testing.T) {
+	assert.False(t, IsViolation(errors.New("failed to list AtlasPolicies")))
+	assert.True(t, IsViolation(Violations{{Policy: "AtlasPolicy ns/name", Reasons: []string{"reason"}}}))
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
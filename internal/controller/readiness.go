@@ -0,0 +1,85 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
+)
+
+// conditionsObject is implemented by the kinds reconciled with ctrlstate.
+type conditionsObject interface {
+	client.Object
+	GetConditions() []metav1.Condition
+}
+
+// observeReadiness reports the Ready condition of the resources of kind T to the resources metric.
+// ctrlstate patches the status of the resources itself, so the metric is fed by the informer of the kind,
+// with the status as patched, instead of statushandler.Update for the hand-written controllers.
+func observeReadiness[T any](mgr ctrl.Manager) error {
+	obj, ok := any(new(T)).(conditionsObject)
+	if !ok {
+		return nil
+	}
+
+	informer, err := mgr.GetCache().GetInformer(context.Background(), obj)
+	if err != nil {
+		return fmt.Errorf("failed to get the informer of %T: %w", obj, err)
+	}
+	kind := reflect.TypeFor[T]().Name()
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			observeConditions(kind, obj)
+		},
+		UpdateFunc: func(_, obj any) {
+			observeConditions(kind, obj)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if resource, ok := obj.(client.Object); ok {
+				metrics.ForgetResource(kind, client.ObjectKeyFromObject(resource))
+			}
+		},
+	})
+	return err
+}
+
+// observeConditions reports the Ready condition of the given resource to the resources metric.
+func observeConditions(kind string, obj any) {
+	resource, ok := obj.(conditionsObject)
+	if !ok {
+		return
+	}
+
+	ready := string(metav1.ConditionUnknown)
+	reason := ""
+	if condition := meta.FindStatusCondition(resource.GetConditions(), string(api.ReadyType)); condition != nil {
+		ready = string(condition.Status)
+		reason = condition.Reason
+	}
+	metrics.ObserveResource(kind, client.ObjectKeyFromObject(resource), ready, reason)
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
+)
+
+func TestObserveConditions(t *testing.T) {
+	metrics.Resources.Reset()
+	cluster := &akov2generated.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "ns"}}
+
+	observeConditions("Cluster", cluster)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.Resources.WithLabelValues("Cluster", "Unknown", "")))
+
+	cluster.Status.Conditions = &[]metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Pending"}}
+	observeConditions("Cluster", cluster)
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.Resources.WithLabelValues("Cluster", "Unknown", "")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.Resources.WithLabelValues("Cluster", "False", "Pending")))
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/accesstoken"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
 )
 
@@ -38,6 +39,16 @@ const (
 	ClientSecretKey = "clientSecret"
 )
 
+// credentialSources caches the credentials of connection Secrets pointing to a credential source.
+// No credential source is allowed until ConfigureCredentialSources is called.
+var credentialSources = credentialsource.NewResolver(credentialsource.Policy{})
+
+// ConfigureCredentialSources sets the Vault servers and paths connection Secrets may read credentials from.
+// It must be called before the controllers are started.
+func ConfigureCredentialSources(policy credentialsource.Policy) {
+	credentialSources = credentialsource.NewResolver(policy)
+}
+
 func (r *AtlasReconciler) ResolveConnectionConfig(ctx context.Context, referrer project.ProjectReferrerObject) (*atlas.ConnectionConfig, error) {
 	connectionSecret := r.connectionSecretRef(referrer)
 	if connectionSecret != nil && connectionSecret.Name != "" {
@@ -85,6 +96,11 @@ func GetConnectionConfig(ctx context.Context, k8sClient client.Client, secretRef
 		return nil, fmt.Errorf("failed to read Atlas API credentials from the secret %s: %w", secretRef.String(), err)
 	}
 
+	secret, err := ResolveConnectionSecret(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := validateConnectionSecret(secret); err != nil {
 		return nil, fmt.Errorf("invalid connection secret %s: %w", secretRef, err)
 	}
@@ -99,8 +115,10 @@ func GetConnectionConfig(ctx context.Context, k8sClient client.Client, secretRef
 			OrgID: string(secret.Data[orgIDKey]),
 			Credentials: &atlas.Credentials{
 				ServiceAccount: &atlas.ServiceAccountToken{
+					ClientID:    string(secret.Data[ClientIDKey]),
 					BearerToken: bearerToken,
 				},
+				OrgID: string(secret.Data[orgIDKey]),
 			},
 		}, nil
 	}
@@ -112,10 +130,28 @@ func GetConnectionConfig(ctx context.Context, k8sClient client.Client, secretRef
 				PublicKey:  string(secret.Data[publicAPIKey]),
 				PrivateKey: string(secret.Data[privateAPIKey]),
 			},
+			OrgID: string(secret.Data[orgIDKey]),
 		},
 	}, nil
 }
 
+// ResolveConnectionSecret returns a copy of the connection Secret holding the credentials
+// read from its credential source, or the Secret itself if it holds the credentials.
+func ResolveConnectionSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	if !credentialsource.IsConfigured(secret.Data) {
+		return secret, nil
+	}
+
+	data, err := credentialSources.Resolve(ctx, secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Atlas API credentials from the %s source of secret %s: %w",
+			secret.Data[credentialsource.SourceKey], client.ObjectKeyFromObject(secret), err)
+	}
+	resolved := secret.DeepCopy()
+	resolved.Data = data
+	return resolved, nil
+}
+
 func getServiceAccountAccessToken(ctx context.Context, k8sClient client.Client, secret *corev1.Secret) (string, error) {
 	tokenSecretName := accesstoken.DeriveSecretName(secret.Namespace, secret.Name)
 	tokenRef := client.ObjectKey{Namespace: secret.Namespace, Name: tokenSecretName}
@@ -17,6 +17,8 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
 )
 
@@ -49,7 +52,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 			// given an empty project reference
 			input: &akov2.AtlasIPAccessList{},
 			// we expect the credentials to match the global fallback secret
-			expected: &atlas.ConnectionConfig{OrgID: "global", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "global", PrivateKey: "global"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "global", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "global", PrivateKey: "global"}, OrgID: "global"}},
 		},
 		{
 			title: "local connection secret reference",
@@ -76,7 +79,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 				},
 			}},
 			// we expect the credentials to match the local secret
-			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}, OrgID: "some"}},
 		},
 		{
 			title: "project reference",
@@ -123,7 +126,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 				},
 			},
 			// we expect the credentials to match the local secret
-			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}, OrgID: "some"}},
 		},
 		{
 			title: "project reference without namespace",
@@ -169,7 +172,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 				},
 			},
 			// we expect the credentials to match the local secret
-			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}, OrgID: "some"}},
 		},
 		{
 			title: "project reference to non-existing project",
@@ -218,7 +221,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 					},
 				},
 			},
-			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}, OrgID: "some"}},
 		},
 		{
 			title: "favor local connection secret over project reference",
@@ -252,7 +255,7 @@ func TestResolveConnectionConfig(t *testing.T) {
 				},
 			},
 			// we expect the local secret to be used
-			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}, OrgID: "some"}},
 		},
 	} {
 		t.Run(tc.title, func(t *testing.T) {
@@ -394,11 +397,91 @@ func TestGetConnectionConfig_ServiceAccount(t *testing.T) {
 			assert.Equal(t, string(tc.credSecret.Data["orgId"]), cfg.OrgID)
 			require.NotNil(t, cfg.Credentials.ServiceAccount)
 			assert.Equal(t, string(tc.tokenSecret.Data["accessToken"]), cfg.Credentials.ServiceAccount.BearerToken)
+			assert.Equal(t, string(tc.credSecret.Data["clientId"]), cfg.Credentials.ServiceAccount.ClientID)
 			assert.Nil(t, cfg.Credentials.APIKeys)
 		})
 	}
 }
 
+func TestGetConnectionConfig_CredentialSource(t *testing.T) {
+	ctx := context.Background()
+	ConfigureCredentialSources(credentialsource.Policy{VaultAddresses: []string{"https://vault"}, PathRoots: []string{os.TempDir()}})
+	t.Cleanup(func() { ConfigureCredentialSources(credentialsource.Policy{}) })
+
+	apiKeysDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(apiKeysDir, "publicApiKey"), []byte("pub\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(apiKeysDir, "privateApiKey"), []byte("priv\n"), 0o600))
+	incompleteDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(incompleteDir, "publicApiKey"), []byte("pub"), 0o600))
+
+	sourceSecret := func(data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "source-creds", Namespace: "ns"},
+			Data:       map[string][]byte{"orgId": []byte("org-123")},
+		}
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+		return secret
+	}
+
+	for _, tc := range []struct {
+		name          string
+		secret        *corev1.Secret
+		expected      *atlas.ConnectionConfig
+		expectedError string
+	}{
+		{
+			name:   "API keys read from a file source",
+			secret: sourceSecret(map[string]string{"credentialSource": "file", "credentialPath": apiKeysDir}),
+			expected: &atlas.ConnectionConfig{
+				OrgID: "org-123",
+				Credentials: &atlas.Credentials{
+					APIKeys: &atlas.APIKeys{PublicKey: "pub", PrivateKey: "priv"},
+					OrgID:   "org-123",
+				},
+			},
+		},
+		{
+			name:          "incomplete credentials in a file source",
+			secret:        sourceSecret(map[string]string{"credentialSource": "file", "credentialPath": incompleteDir}),
+			expectedError: "invalid connection secret ns/source-creds: missing required fields: [privateApiKey]",
+		},
+		{
+			name:          "unreadable file source",
+			secret:        sourceSecret(map[string]string{"credentialSource": "file", "credentialPath": "relative"}),
+			expectedError: `failed to read Atlas API credentials from the file source of secret ns/source-creds: credentialPath "relative" must be an absolute path`,
+		},
+		{
+			name:          "misconfigured vault source",
+			secret:        sourceSecret(map[string]string{"credentialSource": "vault", "vaultAddress": "https://vault"}),
+			expectedError: "failed to read Atlas API credentials from the vault source of secret ns/source-creds: vault source requires vaultPath, vaultRole or vaultTokenPath",
+		},
+		{
+			name: "vault source not allowed by the operator",
+			secret: sourceSecret(map[string]string{
+				"credentialSource": "vault",
+				"vaultAddress":     "https://attacker.example.com",
+				"vaultPath":        "secret/data/atlas",
+				"vaultRole":        "operator",
+			}),
+			expectedError: `failed to read Atlas API credentials from the vault source of secret ns/source-creds: vaultAddress "https://attacker.example.com" is not allowed, must be one of ["https://vault"]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := newFakeKubeClient(t, tc.secret)
+			cfg, err := GetConnectionConfig(ctx, k8sClient, new(client.ObjectKeyFromObject(tc.secret)), nil)
+
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	for _, tc := range []struct {
 		name          string
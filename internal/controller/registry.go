@@ -29,7 +29,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasalerts"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupcompliancepolicy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackuprestorejob"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupsnapshot"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascustomrole"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdatafederation"
@@ -38,12 +41,16 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasipaccesslist"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasnetworkcontainer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasnetworkpeering"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasonlinearchive"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasorgsettings"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasprivateendpoint"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasproject"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlassearchindex"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlassearchindexconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasstream"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasstreamprocessor"
 	integrations "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasthirdpartyintegrations"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/notification"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/serviceaccounttoken"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
@@ -77,8 +84,13 @@ type Registry struct {
 	globalSecretRef client.ObjectKey
 	atlasDomain     string
 
-	reapplySupport          bool
-	maxConcurrentReconciles int
+	reapplySupport               bool
+	maxConcurrentReconciles      int
+	notifications                *notification.Receiver
+	destructiveOperationApproval bool
+	subObjectDeletionProtection  bool
+	deletionGracePeriod          time.Duration
+	finalSnapshotRetentionDays   int
 }
 
 func NewRegistry(predicates []predicate.Predicate, deletionProtection bool, logger *zap.Logger, independentSyncPeriod time.Duration, featureFlags *featureflags.FeatureFlags, globalSecretRef client.ObjectKey, maxConcurrentReconciles int, atlasDomain string) *Registry {
@@ -95,6 +107,35 @@ func NewRegistry(predicates []predicate.Predicate, deletionProtection bool, logg
 	}
 }
 
+// WithNotifications reconciles the AtlasProjects and AtlasDeployments the Atlas notifications
+// of the given receiver refer to.
+func (r *Registry) WithNotifications(receiver *notification.Receiver) *Registry {
+	r.notifications = receiver
+	return r
+}
+
+// WithSubObjectDeletionProtection restricts the AtlasProject reconciler to remove only the sub-objects, e.g. IP access
+// list entries or alert configurations, previously created by the operator.
+func (r *Registry) WithSubObjectDeletionProtection(protected bool) *Registry {
+	r.subObjectDeletionProtection = protected
+	return r
+}
+
+// WithDeploymentDeletion holds the deletion of AtlasDeployments from Atlas during the grace period following the
+// deletion of their resource, and takes a final snapshot retained for the given days, if not zero, before deleting them.
+func (r *Registry) WithDeploymentDeletion(gracePeriod time.Duration, finalSnapshotRetentionDays int) *Registry {
+	r.deletionGracePeriod = gracePeriod
+	r.finalSnapshotRetentionDays = finalSnapshotRetentionDays
+	return r
+}
+
+// WithDestructiveOperationApproval holds the destructive operations on AtlasProjects, AtlasDeployments and
+// AtlasDatabaseUsers until they are approved, unless the approval policy annotation of the resource disables it.
+func (r *Registry) WithDestructiveOperationApproval(required bool) *Registry {
+	r.destructiveOperationApproval = required
+	return r
+}
+
 func (r *Registry) RegisterWithDryRunManager(mgr *dryrun.Manager, ap atlas.Provider) error {
 	if err := r.registerControllers(mgr, ap); err != nil {
 		return fmt.Errorf("error registering controllers: %w", err)
@@ -149,20 +190,39 @@ func (r *Registry) registerControllers(c cluster.Cluster, ap atlas.Provider) err
 
 func (r *Registry) legacyReconcilers(c cluster.Cluster, ap atlas.Provider) []Reconciler {
 	var reconcilers []Reconciler
-	reconcilers = append(reconcilers, atlasproject.NewAtlasProjectReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
-	reconcilers = append(reconcilers, atlasdeployment.NewAtlasDeploymentReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
-	reconcilers = append(reconcilers, atlasdatabaseuser.NewAtlasDatabaseUserReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.featureFlags, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
+	projectReconciler := atlasproject.NewAtlasProjectReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles)
+	deploymentReconciler := atlasdeployment.NewAtlasDeploymentReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.maxConcurrentReconciles)
+	dbUserReconciler := atlasdatabaseuser.NewAtlasDatabaseUserReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.featureFlags, r.logger, r.globalSecretRef, r.maxConcurrentReconciles)
+	if r.notifications != nil {
+		projectReconciler.AtlasNotifications = r.notifications.Projects()
+		deploymentReconciler.AtlasNotifications = r.notifications.Deployments()
+	}
+	projectReconciler.DestructiveOperationApproval = r.destructiveOperationApproval
+	deploymentReconciler.DestructiveOperationApproval = r.destructiveOperationApproval
+	dbUserReconciler.DestructiveOperationApproval = r.destructiveOperationApproval
+	projectReconciler.SubObjectDeletionProtection = r.subObjectDeletionProtection
+	deploymentReconciler.DeletionGracePeriod = r.deletionGracePeriod
+	deploymentReconciler.FinalSnapshotRetentionDays = r.finalSnapshotRetentionDays
+	reconcilers = append(reconcilers, projectReconciler)
+	reconcilers = append(reconcilers, deploymentReconciler)
+	reconcilers = append(reconcilers, dbUserReconciler)
 	reconcilers = append(reconcilers, atlasdatafederation.NewAtlasDataFederationReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasfederatedauth.NewAtlasFederatedAuthReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsInstanceReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsConnectionReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlasstreamprocessor.NewAtlasStreamProcessorReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlassearchindexconfig.NewAtlasSearchIndexConfigReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasbackupcompliancepolicy.NewAtlasBackupCompliancePolicyReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlasbackuprestorejob.NewAtlasBackupRestoreJobReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlasbackupsnapshot.NewAtlasBackupSnapshotReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlassearchindex.NewAtlasSearchIndexReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlasonlinearchive.NewAtlasOnlineArchiveReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlascustomrole.NewAtlasCustomRoleReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasprivateendpoint.NewAtlasPrivateEndpointReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasipaccesslist.NewAtlasIPAccessListReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasnetworkcontainer.NewAtlasNetworkContainerReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, atlasnetworkpeering.NewAtlasNetworkPeeringsReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.maxConcurrentReconciles))
+	reconcilers = append(reconcilers, atlasalerts.NewAtlasProjectAlertsReconciler(c, r.sharedPredicates, ap, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, serviceaccounttoken.NewServiceAccountTokenReconciler(c, r.logger, r.atlasDomain, r.maxConcurrentReconciles))
 
 	orgSettingsReconciler := atlasorgsettings.NewAtlasOrgSettingsReconciler(c, ap, r.logger, r.globalSecretRef, r.reapplySupport)
@@ -202,6 +262,7 @@ func (r *Registry) generatedReconcilers(c cluster.Cluster, ap atlas.Provider) ([
 	reconcilers = append(reconcilers, newCtrlStateReconciler(flexReconciler, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, newCtrlStateReconciler(ipAccessListReconciler, r.maxConcurrentReconciles))
 	reconcilers = append(reconcilers, connectionsecret.NewConnectionSecretReconciler(c, r.defaultPredicates(), ap, r.logger, r.globalSecretRef))
+	reconcilers = append(reconcilers, atlasalerts.NewGroupAlertsReconciler(c, r.sharedPredicates, ap, r.logger, r.globalSecretRef, r.maxConcurrentReconciles))
 	return reconcilers, nil
 }
 
@@ -231,5 +292,8 @@ func (nr *ctrlStateReconciler[T]) SetupWithManager(mgr ctrl.Manager, skipNameVal
 		SkipNameValidation:      new(skipNameValidation),
 		MaxConcurrentReconciles: nr.maxConcurrentReconciles,
 	}
-	return nr.Reconciler.SetupWithManager(mgr, defaultReconcilerOptions)
+	if err := nr.Reconciler.SetupWithManager(mgr, defaultReconcilerOptions); err != nil {
+		return err
+	}
+	return observeReadiness[T](mgr)
 }
@@ -26,6 +26,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/secrettemplate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/servicebinding"
 )
 
 const (
@@ -52,6 +54,14 @@ type ConnectionData struct {
 	ConnURL         string
 	SrvConnURL      string
 	PrivateConnURLs []PrivateLinkConnURLs
+
+	// AtlasUserName is the Atlas user authenticating with the Password when it differs from DBUserName, which names
+	// the Secret: the users with a rotated password alternate between two Atlas users
+	AtlasUserName string
+	// AuthDatabase is the database the user authenticates against
+	AuthDatabase string
+	// Templates render additional keys into the Secret, see AtlasConnectionSecretTemplate
+	Templates map[string]string
 }
 
 type PrivateLinkConnURLs struct {
@@ -84,6 +94,9 @@ func Ensure(ctx context.Context, client client.Client, namespace, projectName, p
 
 func fillSecret(secret *corev1.Secret, projectID string, clusterName string, data ConnectionData) error {
 	var err error
+	if data.AtlasUserName != "" {
+		data.DBUserName = data.AtlasUserName
+	}
 	if data.ConnURL, err = AddCredentialsToConnectionURL(data.ConnURL, data.DBUserName, data.Password); err != nil {
 		return err
 	}
@@ -124,7 +137,27 @@ func fillSecret(secret *corev1.Secret, projectID string, clusterName string, dat
 		secret.Data[privateShardKey+suffix] = []byte(privateConn.PvtShardConnURL)
 	}
 
-	return nil
+	if err := servicebinding.Fill(secret.Data, data.ConnURL, data.SrvConnURL); err != nil {
+		return err
+	}
+
+	templateData := &secrettemplate.Data{
+		ProjectID:      projectID,
+		DeploymentName: clusterName,
+		Username:       data.DBUserName,
+		Password:       data.Password,
+		AuthDatabase:   data.AuthDatabase,
+		URI:            data.ConnURL,
+		SrvURI:         data.SrvConnURL,
+	}
+	for _, privateConn := range data.PrivateConnURLs {
+		templateData.PrivateURIs = append(templateData.PrivateURIs, secrettemplate.PrivateURI{
+			URI:      privateConn.PvtConnURL,
+			SrvURI:   privateConn.PvtSrvConnURL,
+			ShardURI: privateConn.PvtShardConnURL,
+		})
+	}
+	return secrettemplate.ApplyConnection(secret.Data, data.Templates, templateData)
 }
 
 func getSuffix(idx int) string {
@@ -37,6 +37,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/accesstoken"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/secretservice"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
@@ -48,6 +50,10 @@ const (
 	// one approaches expiry.
 	refreshFraction = 2.0 / 3.0
 	minRequeue      = 10 * time.Second
+
+	// credentialSourceRecheck is how often credentials read from a credential
+	// source are checked for a rotation.
+	credentialSourceRecheck = 5 * time.Minute
 )
 
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
@@ -80,8 +86,13 @@ func (r *ServiceAccountTokenReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	clientID := string(secret.Data[reconciler.ClientIDKey])
-	clientSecret := string(secret.Data[reconciler.ClientSecretKey])
+	credentials, err := reconciler.ResolveConnectionSecret(ctx, secret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	clientID := string(credentials.Data[reconciler.ClientIDKey])
+	clientSecret := string(credentials.Data[reconciler.ClientSecretKey])
 	// Skip if not Service Account credentials secret
 	if clientID == "" || clientSecret == "" {
 		return ctrl.Result{}, nil
@@ -89,6 +100,23 @@ func (r *ServiceAccountTokenReconciler) Reconcile(ctx context.Context, req ctrl.
 
 	log.Info("Reconciling service account credential secret")
 
+	result, err := r.ensureToken(ctx, log, credentials, clientID, clientSecret)
+	// Credentials read from a credential source rotate without any change to the
+	// Secret, check them regularly so the token follows the rotation.
+	if err == nil && credentialsource.IsConfigured(secret.Data) &&
+		(result.RequeueAfter == 0 || result.RequeueAfter > credentialSourceRecheck) {
+		result.RequeueAfter = credentialSourceRecheck
+	}
+	return result, err
+}
+
+func (r *ServiceAccountTokenReconciler) ensureToken(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	secret *corev1.Secret,
+	clientID, clientSecret string,
+) (ctrl.Result, error) {
+
 	tokenSecretName := accesstoken.DeriveSecretName(secret.Namespace, secret.Name)
 	tokenRef := client.ObjectKey{Namespace: secret.Namespace, Name: tokenSecretName}
 
@@ -136,6 +164,7 @@ func (r *ServiceAccountTokenReconciler) refreshToken(
 ) (ctrl.Result, error) {
 	token, expiry, err := r.TokenProvider.FetchToken(ctx, clientID, clientSecretValue)
 	if err != nil {
+		metrics.TokenRefreshFailures.WithLabelValues(tokenSecret.Namespace).Inc()
 		return ctrl.Result{}, fmt.Errorf("failed to refresh access token: %w", err)
 	}
 
@@ -166,6 +195,7 @@ func (r *ServiceAccountTokenReconciler) createToken(
 ) (ctrl.Result, error) {
 	token, expiry, err := r.TokenProvider.FetchToken(ctx, clientID, clientSecretValue)
 	if err != nil {
+		metrics.TokenRefreshFailures.WithLabelValues(credentialSecret.Namespace).Inc()
 		log.Errorw("Failed to fetch access token", "error", err)
 		return ctrl.Result{}, err
 	}
@@ -234,8 +264,10 @@ func serviceAccountCredentialsPredicate() predicate.Predicate {
 			return false
 		}
 
-		return len(secret.Data[reconciler.ClientIDKey]) > 0 &&
-			len(secret.Data[reconciler.ClientSecretKey]) > 0
+		// the kind of credentials read from a credential source is only known once they are read
+		return credentialsource.IsConfigured(secret.Data) ||
+			(len(secret.Data[reconciler.ClientIDKey]) > 0 &&
+				len(secret.Data[reconciler.ClientSecretKey]) > 0)
 	})
 }
 
@@ -17,6 +17,8 @@ package serviceaccounttoken_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -32,8 +34,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/accesstoken"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/secretservice"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/serviceaccounttoken"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
 )
 
 type fakeTokenProvider struct {
@@ -137,6 +141,58 @@ func TestReconcile_CreatesTokenSecretOnFirstRun(t *testing.T) {
 		"credential secret must not be mutated by the controller")
 }
 
+func TestReconcile_CreatesTokenFromCredentialSource(t *testing.T) {
+	reconciler.ConfigureCredentialSources(credentialsource.Policy{PathRoots: []string{os.TempDir()}})
+	t.Cleanup(func() { reconciler.ConfigureCredentialSources(credentialsource.Policy{}) })
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clientId"), []byte("my-client-id"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clientSecret"), []byte("my-client-secret"), 0o600))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa-creds", Namespace: "ns"},
+		Data: map[string][]byte{
+			"orgId":            []byte("org-123"),
+			"credentialSource": []byte("file"),
+			"credentialPath":   []byte(dir),
+		},
+	}
+	tp := &fakeTokenProvider{token: "access-token-value", expiry: time.Now().Add(1 * time.Hour)}
+	r, k8sClient := newReconciler(t, tp, secret)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "sa-creds", Namespace: "ns"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, result.RequeueAfter,
+		"credentials read from a credential source must be checked for a rotation")
+	assert.Equal(t, 1, tp.calls)
+
+	tokenSecret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Name: accesstoken.DeriveSecretName("ns", "sa-creds"), Namespace: "ns"}, tokenSecret))
+	assert.Equal(t, accesstoken.CredentialsHash("my-client-id", "my-client-secret"), string(tokenSecret.Data["credentialsHash"]))
+}
+
+func TestReconcile_CredentialSourceError(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa-creds", Namespace: "ns"},
+		Data: map[string][]byte{
+			"orgId":            []byte("org-123"),
+			"credentialSource": []byte("file"),
+			"credentialPath":   []byte("/non/existent"),
+		},
+	}
+	tp := &fakeTokenProvider{}
+	r, _ := newReconciler(t, tp, secret)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "sa-creds", Namespace: "ns"},
+	})
+
+	require.ErrorContains(t, err, "failed to read Atlas API credentials from the file source of secret ns/sa-creds")
+	assert.Equal(t, 0, tp.calls)
+}
+
 func TestReconcile_RefreshesExpiredToken(t *testing.T) {
 	expiredExpiry := time.Now().Add(-10 * time.Minute)
 	tokenSecretName := accesstoken.DeriveSecretName("ns", "sa-creds")
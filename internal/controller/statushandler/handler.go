@@ -15,13 +15,18 @@
 package statushandler
 
 import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
 )
 
 // Update performs the update (in the form of patch) for the Atlas Custom Resource status.
@@ -34,12 +39,44 @@ func Update(ctx *workflow.Context, kubeClient client.Client, eventRecorder recor
 	if err := patchUpdateStatus(ctx, kubeClient, resource); err != nil {
 		if apierrors.IsNotFound(err) {
 			ctx.Log.Infof("The resource %s no longer exists, not updating the status", kube.ObjectKey(resource.GetNamespace(), resource.GetName()))
+			metrics.ForgetResource(kindOf(resource), client.ObjectKeyFromObject(resource))
 			return
 		}
 		// Implementation logic: we deliberately don't return the 'error' to avoid cumbersome handling logic as the
 		// failed update of the status is not something that should block reconciliation
 		ctx.Log.Errorf("Failed to update status: %s", err)
 	}
+
+	if isReleased(resource) {
+		metrics.ForgetResource(kindOf(resource), client.ObjectKeyFromObject(resource))
+		return
+	}
+	observeReadiness(ctx, resource)
+}
+
+// isReleased is true once the finalizer of a resource being deleted is removed, Kubernetes deletes it right after.
+func isReleased(resource api.AtlasCustomResource) bool {
+	return !resource.GetDeletionTimestamp().IsZero() && !customresource.HaveFinalizer(resource, customresource.FinalizerLabel)
+}
+
+// observeReadiness reports the Ready condition of the resource to the resources metric.
+func observeReadiness(ctx *workflow.Context, resource api.AtlasCustomResource) {
+	ready := string(corev1.ConditionUnknown)
+	reason := ""
+	if condition, ok := ctx.GetCondition(api.ReadyType); ok {
+		ready = string(condition.Status)
+		reason = condition.Reason
+	}
+	metrics.ObserveResource(kindOf(resource), client.ObjectKeyFromObject(resource), ready, reason)
+}
+
+// kindOf returns the kind of the resource. The GVK of typed objects is often not populated, so the Go type name is used.
+func kindOf(resource api.AtlasCustomResource) string {
+	t := reflect.TypeOf(resource)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
 }
 
 // logEvent logs the last condition to the output and also creates the Event for it in Kubernetes.
@@ -0,0 +1,59 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statushandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
+)
+
+func TestUpdateForgetsReleasedResource(t *testing.T) {
+	metrics.Resources.Reset()
+	project := &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "some-project",
+			Namespace:         "test-ns",
+			DeletionTimestamp: new(metav1.Now()),
+			// another controller still holds the resource, the finalizer of the operator is removed
+			Finalizers: []string{"example.com/other"},
+		},
+	}
+	scheme := runtime.NewScheme()
+	utilruntime.Must(akov2.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).
+		WithStatusSubresource(project).Build()
+	metrics.ObserveResource("AtlasProject", client.ObjectKeyFromObject(project), "True", "")
+
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), nil, context.Background(), project)
+	ctx.SetConditionTrue(api.ReadyType)
+	Update(ctx, fakeClient, record.NewFakeRecorder(10), project)
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.Resources.WithLabelValues("AtlasProject", "True", "")))
+}
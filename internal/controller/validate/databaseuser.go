@@ -0,0 +1,65 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+)
+
+const noAuthType = "NONE"
+
+func DatabaseUser(user *akov2.AtlasDatabaseUser) error {
+	if user.Spec.DeleteAfterDate != "" {
+		if _, err := timeutil.ParseISO8601(user.Spec.DeleteAfterDate); err != nil {
+			return fmt.Errorf("deleteAfterDate is not a valid ISO 8601 date: %w", err)
+		}
+	}
+
+	if user.Spec.PasswordSecret != nil && !usesPasswordAuth(user) {
+		return errors.New("passwordSecretRef cannot be used together with x509Type, awsIamType or oidcAuthType")
+	}
+
+	return databaseUserScopes(user.Spec.Scopes)
+}
+
+func usesPasswordAuth(user *akov2.AtlasDatabaseUser) bool {
+	for _, authType := range []string{user.Spec.X509Type, user.Spec.AWSIAMType, user.Spec.OIDCAuthType} {
+		if authType != "" && authType != noAuthType {
+			return false
+		}
+	}
+
+	return true
+}
+
+func databaseUserScopes(scopes []akov2.ScopeSpec) error {
+	seen := make(map[akov2.ScopeSpec]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if scope.Name == "" {
+			return errors.New("scope name cannot be empty")
+		}
+
+		if _, ok := seen[scope]; ok {
+			return fmt.Errorf("duplicate scope %s %q", scope.Type, scope.Name)
+		}
+		seen[scope] = struct{}{}
+	}
+
+	return nil
+}
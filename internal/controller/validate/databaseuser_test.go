@@ -0,0 +1,77 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+func TestDatabaseUser(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		spec    akov2.AtlasDatabaseUserSpec
+		wantErr string
+	}{
+		{
+			name: "valid user",
+			spec: akov2.AtlasDatabaseUserSpec{
+				DeleteAfterDate: "2030-01-01T00:00:00Z",
+				PasswordSecret:  &common.ResourceRef{Name: "password"},
+				X509Type:        "NONE",
+				Scopes: []akov2.ScopeSpec{
+					{Name: "cluster0", Type: akov2.DeploymentScopeType},
+					{Name: "cluster0", Type: akov2.DataLakeScopeType},
+				},
+			},
+		},
+		{
+			name:    "invalid delete after date",
+			spec:    akov2.AtlasDatabaseUserSpec{DeleteAfterDate: "01/01/2030"},
+			wantErr: "deleteAfterDate is not a valid ISO 8601 date",
+		},
+		{
+			name: "password with x509 authentication",
+			spec: akov2.AtlasDatabaseUserSpec{
+				PasswordSecret: &common.ResourceRef{Name: "password"},
+				X509Type:       "MANAGED",
+			},
+			wantErr: "passwordSecretRef cannot be used together with x509Type, awsIamType or oidcAuthType",
+		},
+		{
+			name: "duplicate scopes",
+			spec: akov2.AtlasDatabaseUserSpec{
+				Scopes: []akov2.ScopeSpec{
+					{Name: "cluster0", Type: akov2.DeploymentScopeType},
+					{Name: "cluster0", Type: akov2.DeploymentScopeType},
+				},
+			},
+			wantErr: "duplicate scope CLUSTER \"cluster0\"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := DatabaseUser(&akov2.AtlasDatabaseUser{Spec: tc.spec})
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -17,12 +17,14 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/cron"
 )
 
 const (
@@ -72,7 +74,11 @@ func AtlasDeployment(atlasDeployment *akov2.AtlasDeployment) error {
 		return err
 	}
 
-	return nil
+	if err = deploymentSchedules(atlasDeployment.Spec.Schedules, atlasDeployment.Spec.DeploymentSpec); err != nil {
+		return err
+	}
+
+	return deploymentChangeWindow(atlasDeployment.Spec.ChangeWindow, atlasDeployment.Spec.DeploymentSpec)
 }
 
 func regularDeployment(spec *akov2.AdvancedDeploymentSpec) error {
@@ -96,6 +102,126 @@ func regularDeployment(spec *akov2.AdvancedDeploymentSpec) error {
 	return nil
 }
 
+// deploymentSchedules validates the schedule entries of a deployment. The instance sizes of the entries
+// are applied to all regions, with compute auto-scaling they must be within the auto-scaling range,
+// which autoscalingForDeployment ensures is the same for all regions.
+func deploymentSchedules(schedules []akov2.DeploymentSchedule, spec *akov2.AdvancedDeploymentSpec) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	if spec == nil {
+		return errors.New("schedules are only supported with deploymentSpec")
+	}
+
+	names := map[string]bool{}
+	for _, schedule := range schedules {
+		if names[schedule.Name] {
+			return fmt.Errorf("schedule %q is defined more than once", schedule.Name)
+		}
+		names[schedule.Name] = true
+
+		if err := deploymentSchedule(schedule, spec); err != nil {
+			return fmt.Errorf("invalid schedule %q: %w", schedule.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func deploymentChangeWindow(changeWindow *akov2.ChangeWindow, spec *akov2.AdvancedDeploymentSpec) error {
+	if changeWindow == nil {
+		return nil
+	}
+	if spec == nil {
+		return errors.New("changeWindow is only supported with deploymentSpec")
+	}
+	if err := ChangeWindow(changeWindow); err != nil {
+		return fmt.Errorf("invalid changeWindow: %w", err)
+	}
+	return nil
+}
+
+// ChangeWindow validates the cron expressions and the time zone of a change window.
+func ChangeWindow(changeWindow *akov2.ChangeWindow) error {
+	return window(changeWindow.Start, changeWindow.End, changeWindow.TimeZone)
+}
+
+// window validates a window open from every activation of start until the following activation of end.
+func window(start, end, timeZone string) error {
+	if err := cron.Validate(start); err != nil {
+		return fmt.Errorf("invalid start: %w", err)
+	}
+	if err := cron.Validate(end); err != nil {
+		return fmt.Errorf("invalid end: %w", err)
+	}
+	if timeZone != "" {
+		if _, err := time.LoadLocation(timeZone); err != nil {
+			return fmt.Errorf("invalid time zone: %w", err)
+		}
+	}
+	return nil
+}
+
+func deploymentSchedule(schedule akov2.DeploymentSchedule, spec *akov2.AdvancedDeploymentSpec) error {
+	if err := window(schedule.Start, schedule.End, schedule.TimeZone); err != nil {
+		return err
+	}
+
+	paused := schedule.Paused != nil && *schedule.Paused
+	scaled := schedule.InstanceSize != "" || schedule.AnalyticsInstanceSize != ""
+	switch {
+	case schedule.Paused == nil && !scaled:
+		return errors.New("one of paused, instanceSize or analyticsInstanceSize must be set")
+	case paused && scaled:
+		return errors.New("a paused deployment cannot be scaled")
+	}
+
+	for _, replicationSpec := range spec.ReplicationSpecs {
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if scaled && regionConfig.ProviderName == string(provider.ProviderTenant) {
+				return errors.New("the instance size of shared tier deployments cannot be scheduled")
+			}
+			if schedule.InstanceSize != "" && computeAutoscalingEnabled(regionConfig.AutoScaling) {
+				if err := scheduledScaleDown(regionConfig.AutoScaling); err != nil {
+					return err
+				}
+				if err := advancedInstanceSizeInRange(
+					schedule.InstanceSize,
+					regionConfig.AutoScaling.Compute.MinInstanceSize,
+					regionConfig.AutoScaling.Compute.MaxInstanceSize); err != nil {
+					return err
+				}
+			}
+			if schedule.AnalyticsInstanceSize != "" && computeAutoscalingEnabled(regionConfig.AnalyticsAutoScaling) {
+				if err := scheduledScaleDown(regionConfig.AnalyticsAutoScaling); err != nil {
+					return err
+				}
+				if err := advancedInstanceSizeInRange(
+					schedule.AnalyticsInstanceSize,
+					regionConfig.AnalyticsAutoScaling.Compute.MinInstanceSize,
+					regionConfig.AnalyticsAutoScaling.Compute.MaxInstanceSize); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// scheduledScaleDown checks the auto-scaling of a deployment can be overridden by a schedule: the schedule
+// raises the minimum instance size, which Atlas only applies when scaling down is enabled.
+func scheduledScaleDown(autoscaling *akov2.AdvancedAutoScalingSpec) error {
+	if autoscaling.Compute.ScaleDownEnabled == nil || !*autoscaling.Compute.ScaleDownEnabled {
+		return errors.New("the instance size of auto-scaled deployments can only be scheduled with scaleDownEnabled")
+	}
+	return nil
+}
+
+func computeAutoscalingEnabled(autoscaling *akov2.AdvancedAutoScalingSpec) bool {
+	return autoscaling != nil && autoscaling.Compute != nil && autoscaling.Compute.Enabled != nil && *autoscaling.Compute.Enabled
+}
+
 func providerConfig(regionConfig *akov2.AdvancedRegionConfig) error {
 	supportedProviders := provider.SupportedProviders()
 
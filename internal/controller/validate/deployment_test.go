@@ -636,6 +636,178 @@ func TestInstanceSizeRangeForAdvancedDeployment(t *testing.T) {
 	}
 }
 
+func TestDeploymentSchedules(t *testing.T) {
+	autoscaled := &akov2.AdvancedDeploymentSpec{
+		ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+			{
+				RegionConfigs: []*akov2.AdvancedRegionConfig{
+					{
+						ProviderName: "AWS",
+						AutoScaling: &akov2.AdvancedAutoScalingSpec{
+							Compute: &akov2.ComputeSpec{
+								Enabled:          new(true),
+								ScaleDownEnabled: new(true),
+								MinInstanceSize:  "M10",
+								MaxInstanceSize:  "M40",
+							},
+						},
+						ElectableSpecs: &akov2.Specs{InstanceSize: "M10"},
+					},
+				},
+			},
+		},
+	}
+	tenant := &akov2.AdvancedDeploymentSpec{
+		ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+			{
+				RegionConfigs: []*akov2.AdvancedRegionConfig{
+					{
+						ProviderName:        string(provider.ProviderTenant),
+						BackingProviderName: "AWS",
+						ElectableSpecs:      &akov2.Specs{InstanceSize: "M0"},
+					},
+				},
+			},
+		},
+	}
+	nightly := akov2.DeploymentSchedule{Name: "nightly", Start: "0 20 * * MON-FRI", End: "0 7 * * MON-FRI", TimeZone: "Europe/Berlin", Paused: new(true)}
+
+	tests := map[string]struct {
+		schedules     []akov2.DeploymentSchedule
+		spec          *akov2.AdvancedDeploymentSpec
+		expectedError string
+	}{
+		"no schedules": {
+			spec: autoscaled,
+		},
+		"pause schedule": {
+			schedules: []akov2.DeploymentSchedule{nightly},
+			spec:      autoscaled,
+		},
+		"scale schedule within the autoscaling range": {
+			schedules: []akov2.DeploymentSchedule{{Name: "peak", Start: "0 8 * * *", End: "0 18 * * *", InstanceSize: "M30"}},
+			spec:      autoscaled,
+		},
+		"schedules without deploymentSpec": {
+			schedules:     []akov2.DeploymentSchedule{nightly},
+			expectedError: "schedules are only supported with deploymentSpec",
+		},
+		"duplicated names": {
+			schedules:     []akov2.DeploymentSchedule{nightly, nightly},
+			spec:          autoscaled,
+			expectedError: `schedule "nightly" is defined more than once`,
+		},
+		"invalid start": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "invalid", Start: "0 25 * * *", End: "0 7 * * *", Paused: new(true)}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "invalid": invalid start: invalid cron expression "0 25 * * *": invalid hour "25": value 25 out of range [0, 23]`,
+		},
+		"end never fires": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "invalid", Start: "0 20 * * *", End: "0 7 31 2 *", Paused: new(true)}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "invalid": invalid end: invalid cron expression "0 7 31 2 *": the cron expression never fires`,
+		},
+		"invalid time zone": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "invalid", Start: "0 20 * * *", End: "0 7 * * *", TimeZone: "Mars/Olympus", Paused: new(true)}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "invalid": invalid time zone: unknown time zone Mars/Olympus`,
+		},
+		"no override": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "empty", Start: "0 20 * * *", End: "0 7 * * *"}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "empty": one of paused, instanceSize or analyticsInstanceSize must be set`,
+		},
+		"paused and scaled": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "both", Start: "0 20 * * *", End: "0 7 * * *", Paused: new(true), InstanceSize: "M30"}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "both": a paused deployment cannot be scaled`,
+		},
+		"instance size above the autoscaling range": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "peak", Start: "0 8 * * *", End: "0 18 * * *", InstanceSize: "M50"}},
+			spec:          autoscaled,
+			expectedError: `invalid schedule "peak": the instance size is above the maximum autoscaling configuration`,
+		},
+		"autoscaled deployment without scale down": {
+			schedules: []akov2.DeploymentSchedule{{Name: "peak", Start: "0 8 * * *", End: "0 18 * * *", InstanceSize: "M30"}},
+			spec: &akov2.AdvancedDeploymentSpec{
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+					{
+						RegionConfigs: []*akov2.AdvancedRegionConfig{
+							{
+								ProviderName: "AWS",
+								AutoScaling: &akov2.AdvancedAutoScalingSpec{
+									Compute: &akov2.ComputeSpec{Enabled: new(true), MaxInstanceSize: "M40"},
+								},
+								ElectableSpecs: &akov2.Specs{InstanceSize: "M10"},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `invalid schedule "peak": the instance size of auto-scaled deployments can only be scheduled with scaleDownEnabled`,
+		},
+		"shared tier deployment scaled": {
+			schedules:     []akov2.DeploymentSchedule{{Name: "peak", Start: "0 8 * * *", End: "0 18 * * *", InstanceSize: "M10"}},
+			spec:          tenant,
+			expectedError: `invalid schedule "peak": the instance size of shared tier deployments cannot be scheduled`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := deploymentSchedules(tt.schedules, tt.spec)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeploymentChangeWindow(t *testing.T) {
+	spec := &akov2.AdvancedDeploymentSpec{}
+
+	tests := map[string]struct {
+		changeWindow  *akov2.ChangeWindow
+		spec          *akov2.AdvancedDeploymentSpec
+		expectedError string
+	}{
+		"no change window": {
+			spec: spec,
+		},
+		"change window": {
+			changeWindow: &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT", TimeZone: "Europe/Berlin"},
+			spec:         spec,
+		},
+		"change window without deploymentSpec": {
+			changeWindow:  &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT"},
+			expectedError: "changeWindow is only supported with deploymentSpec",
+		},
+		"invalid start": {
+			changeWindow:  &akov2.ChangeWindow{Start: "0 2 * * SUNDAY", End: "0 6 * * SAT"},
+			spec:          spec,
+			expectedError: `invalid changeWindow: invalid start: invalid cron expression "0 2 * * SUNDAY": invalid day of week "SUNDAY": invalid value "SUNDAY"`,
+		},
+		"invalid time zone": {
+			changeWindow:  &akov2.ChangeWindow{Start: "0 2 * * SAT", End: "0 6 * * SAT", TimeZone: "Mars/Olympus"},
+			spec:          spec,
+			expectedError: "invalid changeWindow: invalid time zone: unknown time zone Mars/Olympus",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := deploymentChangeWindow(tt.changeWindow, tt.spec)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAdvancedInstanceSizeInRange(t *testing.T) {
 	tests := map[string]struct {
 		currentInstanceSize string
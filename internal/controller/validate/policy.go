@@ -0,0 +1,185 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+)
+
+// DeploymentPolicy returns the violations of the given policy by the deployment.
+func DeploymentPolicy(deployment *akov2.AtlasDeployment, policy *akov2.DeploymentPolicy) error {
+	v := &violations{}
+	switch {
+	case deployment.Spec.DeploymentSpec != nil:
+		dedicatedDeploymentPolicy(v, deployment.Spec.DeploymentSpec, policy)
+		schedulesPolicy(v, deployment.Spec.Schedules, policy)
+	case deployment.Spec.ServerlessSpec != nil && deployment.Spec.ServerlessSpec.ProviderSettings != nil:
+		// Serverless and flex deployments are exempt from RequireBackup:
+		// Atlas always backs them up and the backups can't be configured.
+		settings := deployment.Spec.ServerlessSpec.ProviderSettings
+		locationPolicy(v, settings.BackingProviderName, settings.RegionName, policy)
+	case deployment.Spec.FlexSpec != nil && deployment.Spec.FlexSpec.ProviderSettings != nil:
+		settings := deployment.Spec.FlexSpec.ProviderSettings
+		locationPolicy(v, settings.BackingProviderName, settings.RegionName, policy)
+	}
+
+	return v.err()
+}
+
+func dedicatedDeploymentPolicy(v *violations, spec *akov2.AdvancedDeploymentSpec, policy *akov2.DeploymentPolicy) {
+	for _, replicationSpec := range spec.ReplicationSpecs {
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			providerName := regionConfig.ProviderName
+			if providerName == string(provider.ProviderTenant) {
+				providerName = regionConfig.BackingProviderName
+			}
+			locationPolicy(v, providerName, regionConfig.RegionName, policy)
+
+			for _, specs := range []*akov2.Specs{regionConfig.ElectableSpecs, regionConfig.ReadOnlySpecs, regionConfig.AnalyticsSpecs} {
+				if specs != nil {
+					instanceSizePolicy(v, "instance size", specs.InstanceSize, policy)
+				}
+			}
+			for _, autoScaling := range []*akov2.AdvancedAutoScalingSpec{regionConfig.AutoScaling, regionConfig.AnalyticsAutoScaling} {
+				if autoScaling != nil && autoScaling.Compute != nil && autoScaling.Compute.Enabled != nil && *autoScaling.Compute.Enabled {
+					instanceSizePolicy(v, "autoscaling minInstanceSize", autoScaling.Compute.MinInstanceSize, policy)
+					instanceSizePolicy(v, "autoscaling maxInstanceSize", autoScaling.Compute.MaxInstanceSize, policy)
+				}
+			}
+		}
+	}
+
+	if len(policy.AllowedMongoDBVersions) > 0 && !slices.Contains(policy.AllowedMongoDBVersions, spec.MongoDBMajorVersion) {
+		if spec.MongoDBMajorVersion == "" {
+			v.add(fmt.Sprintf("mongoDBMajorVersion must be set to one of the allowed versions %v", policy.AllowedMongoDBVersions))
+		} else {
+			v.add(fmt.Sprintf("MongoDB version %s is not allowed, allowed versions: %v", spec.MongoDBMajorVersion, policy.AllowedMongoDBVersions))
+		}
+	}
+
+	if policy.RequireBackup && (spec.BackupEnabled == nil || !*spec.BackupEnabled) {
+		v.add("backupEnabled must be true")
+	}
+}
+
+// schedulesPolicy checks the instance sizes the schedule entries scale the deployment to.
+func schedulesPolicy(v *violations, schedules []akov2.DeploymentSchedule, policy *akov2.DeploymentPolicy) {
+	for _, schedule := range schedules {
+		instanceSizePolicy(v, fmt.Sprintf("schedule %s instanceSize", schedule.Name), schedule.InstanceSize, policy)
+		instanceSizePolicy(v, fmt.Sprintf("schedule %s analyticsInstanceSize", schedule.Name), schedule.AnalyticsInstanceSize, policy)
+	}
+}
+
+func locationPolicy(v *violations, providerName, regionName string, policy *akov2.DeploymentPolicy) {
+	if len(policy.AllowedProviders) > 0 && !slices.Contains(policy.AllowedProviders, providerName) {
+		v.add(fmt.Sprintf("provider %s is not allowed, allowed providers: %v", providerName, policy.AllowedProviders))
+	}
+	if len(policy.AllowedRegions) > 0 && !slices.Contains(policy.AllowedRegions, regionName) {
+		v.add(fmt.Sprintf("region %s is not allowed, allowed regions: %v", regionName, policy.AllowedRegions))
+	}
+}
+
+func instanceSizePolicy(v *violations, field, instanceSize string, policy *akov2.DeploymentPolicy) {
+	if instanceSize == "" || (policy.MinInstanceSize == "" && policy.MaxInstanceSize == "") {
+		return
+	}
+
+	size, err := NewFromInstanceSizeName(instanceSize)
+	if err != nil {
+		v.add(fmt.Sprintf("%s %s can't be checked: %s", field, instanceSize, err))
+		return
+	}
+
+	if policy.MinInstanceSize != "" {
+		minSize, err := NewFromInstanceSizeName(policy.MinInstanceSize)
+		switch {
+		case err != nil:
+			v.add(fmt.Sprintf("invalid minInstanceSize %s: %s", policy.MinInstanceSize, err))
+		case CompareInstanceSizes(size, minSize) == -1:
+			v.add(fmt.Sprintf("%s %s is below the minimum instance size %s", field, instanceSize, policy.MinInstanceSize))
+		}
+	}
+
+	if policy.MaxInstanceSize != "" {
+		maxSize, err := NewFromInstanceSizeName(policy.MaxInstanceSize)
+		switch {
+		case err != nil:
+			v.add(fmt.Sprintf("invalid maxInstanceSize %s: %s", policy.MaxInstanceSize, err))
+		case CompareInstanceSizes(size, maxSize) == 1:
+			v.add(fmt.Sprintf("%s %s is above the maximum instance size %s", field, instanceSize, policy.MaxInstanceSize))
+		}
+	}
+}
+
+// IPAccessListPolicy returns the violation of the given policy by an IP access list entry.
+func IPAccessListPolicy(ipAddress, cidrBlock, awsSecurityGroup string, policy *akov2.IPAccessListPolicy) error {
+	var (
+		entry netip.Prefix
+		err   error
+	)
+	switch {
+	case awsSecurityGroup != "":
+		return fmt.Errorf("AWS security group %s is not allowed, entries must be within %v", awsSecurityGroup, policy.AllowedCIDRs)
+	case cidrBlock != "":
+		entry, err = netip.ParsePrefix(cidrBlock)
+		entry = entry.Masked()
+	default:
+		var addr netip.Addr
+		addr, err = netip.ParseAddr(ipAddress)
+		entry = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if err != nil {
+		return fmt.Errorf("entry %s%s can't be checked: %w", ipAddress, cidrBlock, err)
+	}
+
+	for _, cidr := range policy.AllowedCIDRs {
+		allowed, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid allowed CIDR %s: %w", cidr, err)
+		}
+		if allowed.Bits() <= entry.Bits() && allowed.Contains(entry.Addr()) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not within the allowed CIDRs %v", entry, policy.AllowedCIDRs)
+}
+
+// violations collects distinct violations, the same violation is often found in several region configs.
+type violations struct {
+	errs []error
+	seen map[string]struct{}
+}
+
+func (v *violations) add(msg string) {
+	if _, ok := v.seen[msg]; ok {
+		return
+	}
+	if v.seen == nil {
+		v.seen = map[string]struct{}{}
+	}
+	v.seen[msg] = struct{}{}
+	v.errs = append(v.errs, errors.New(msg))
+}
+
+func (v *violations) err() error {
+	return errors.Join(v.errs...)
+}
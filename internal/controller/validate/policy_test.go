@@ -0,0 +1,221 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+)
+
+func TestDeploymentPolicy(t *testing.T) {
+	dedicated := func(regionConfigs ...*akov2.AdvancedRegionConfig) *akov2.AtlasDeployment {
+		return &akov2.AtlasDeployment{
+			Spec: akov2.AtlasDeploymentSpec{
+				DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+					MongoDBMajorVersion: "8.0",
+					BackupEnabled:       new(true),
+					ReplicationSpecs: []*akov2.AdvancedReplicationSpec{
+						{RegionConfigs: regionConfigs},
+					},
+				},
+			},
+		}
+	}
+	regionConfig := func(providerName, regionName, instanceSize string) *akov2.AdvancedRegionConfig {
+		return &akov2.AdvancedRegionConfig{
+			ProviderName:   providerName,
+			RegionName:     regionName,
+			ElectableSpecs: &akov2.Specs{InstanceSize: instanceSize, NodeCount: new(3)},
+		}
+	}
+	policy := &akov2.DeploymentPolicy{
+		AllowedProviders:       []string{"AWS", "AZURE"},
+		AllowedRegions:         []string{"US_EAST_1", "EUROPE_WEST"},
+		MinInstanceSize:        "M10",
+		MaxInstanceSize:        "M60",
+		AllowedMongoDBVersions: []string{"7.0", "8.0"},
+		RequireBackup:          true,
+	}
+
+	tests := map[string]struct {
+		deployment    *akov2.AtlasDeployment
+		policy        *akov2.DeploymentPolicy
+		expectedError string
+	}{
+		"compliant dedicated deployment": {
+			deployment: dedicated(regionConfig("AWS", "US_EAST_1", "M30"), regionConfig("AZURE", "EUROPE_WEST", "M30")),
+			policy:     policy,
+		},
+		"empty policy": {
+			deployment: dedicated(regionConfig("GCP", "CENTRAL_US", "M700")),
+			policy:     &akov2.DeploymentPolicy{},
+		},
+		"provider and region not allowed": {
+			deployment:    dedicated(regionConfig("GCP", "CENTRAL_US", "M30")),
+			policy:        policy,
+			expectedError: "provider GCP is not allowed, allowed providers: [AWS AZURE]\nregion CENTRAL_US is not allowed, allowed regions: [US_EAST_1 EUROPE_WEST]",
+		},
+		"violations are reported once": {
+			deployment:    dedicated(regionConfig("AWS", "US_EAST_1", "M700"), regionConfig("AWS", "US_EAST_1", "M700")),
+			policy:        policy,
+			expectedError: "instance size M700 is above the maximum instance size M60",
+		},
+		"instance size below the minimum": {
+			deployment:    dedicated(regionConfig("AWS", "US_EAST_1", "M0")),
+			policy:        policy,
+			expectedError: "instance size M0 is below the minimum instance size M10",
+		},
+		"autoscaling above the maximum": {
+			deployment: func() *akov2.AtlasDeployment {
+				rc := regionConfig("AWS", "US_EAST_1", "M30")
+				rc.AutoScaling = &akov2.AdvancedAutoScalingSpec{
+					Compute: &akov2.ComputeSpec{Enabled: new(true), MaxInstanceSize: "M200"},
+				}
+				return dedicated(rc)
+			}(),
+			policy:        policy,
+			expectedError: "autoscaling maxInstanceSize M200 is above the maximum instance size M60",
+		},
+		"schedule instance sizes above the maximum": {
+			deployment: func() *akov2.AtlasDeployment {
+				d := dedicated(regionConfig("AWS", "US_EAST_1", "M30"))
+				d.Spec.Schedules = []akov2.DeploymentSchedule{
+					{Name: "peak", InstanceSize: "M80", AnalyticsInstanceSize: "M100"},
+					{Name: "night", InstanceSize: "M20"},
+				}
+				return d
+			}(),
+			policy:        policy,
+			expectedError: "schedule peak instanceSize M80 is above the maximum instance size M60\nschedule peak analyticsInstanceSize M100 is above the maximum instance size M60",
+		},
+		"tenant deployments are checked against their backing provider": {
+			deployment: func() *akov2.AtlasDeployment {
+				rc := regionConfig(string(provider.ProviderTenant), "US_EAST_1", "M0")
+				rc.BackingProviderName = "GCP"
+				return dedicated(rc)
+			}(),
+			policy:        &akov2.DeploymentPolicy{AllowedProviders: []string{"AWS"}},
+			expectedError: "provider GCP is not allowed, allowed providers: [AWS]",
+		},
+		"MongoDB version not allowed": {
+			deployment: func() *akov2.AtlasDeployment {
+				d := dedicated(regionConfig("AWS", "US_EAST_1", "M30"))
+				d.Spec.DeploymentSpec.MongoDBMajorVersion = "6.0"
+				return d
+			}(),
+			policy:        policy,
+			expectedError: "MongoDB version 6.0 is not allowed, allowed versions: [7.0 8.0]",
+		},
+		"MongoDB version not set": {
+			deployment: func() *akov2.AtlasDeployment {
+				d := dedicated(regionConfig("AWS", "US_EAST_1", "M30"))
+				d.Spec.DeploymentSpec.MongoDBMajorVersion = ""
+				return d
+			}(),
+			policy:        policy,
+			expectedError: "mongoDBMajorVersion must be set to one of the allowed versions [7.0 8.0]",
+		},
+		"backup required": {
+			deployment: func() *akov2.AtlasDeployment {
+				d := dedicated(regionConfig("AWS", "US_EAST_1", "M30"))
+				d.Spec.DeploymentSpec.BackupEnabled = nil
+				return d
+			}(),
+			policy:        policy,
+			expectedError: "backupEnabled must be true",
+		},
+		"flex deployment": {
+			deployment: &akov2.AtlasDeployment{
+				Spec: akov2.AtlasDeploymentSpec{
+					FlexSpec: &akov2.FlexSpec{
+						ProviderSettings: &akov2.FlexProviderSettings{BackingProviderName: "GCP", RegionName: "CENTRAL_US"},
+					},
+				},
+			},
+			policy:        policy,
+			expectedError: "provider GCP is not allowed, allowed providers: [AWS AZURE]\nregion CENTRAL_US is not allowed, allowed regions: [US_EAST_1 EUROPE_WEST]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := DeploymentPolicy(tt.deployment, tt.policy)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestIPAccessListPolicy(t *testing.T) {
+	policy := &akov2.IPAccessListPolicy{AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24", "2001:db8::/32"}}
+
+	tests := map[string]struct {
+		ipAddress        string
+		cidrBlock        string
+		awsSecurityGroup string
+		expectedError    string
+	}{
+		"IP address within a range": {
+			ipAddress: "10.1.2.3",
+		},
+		"IPv6 address within a range": {
+			ipAddress: "2001:db8::1",
+		},
+		"CIDR block within a range": {
+			cidrBlock: "192.168.1.128/25",
+		},
+		"CIDR block equal to a range": {
+			cidrBlock: "10.0.0.0/8",
+		},
+		"IP address outside the ranges": {
+			ipAddress:     "8.8.8.8",
+			expectedError: "8.8.8.8/32 is not within the allowed CIDRs [10.0.0.0/8 192.168.1.0/24 2001:db8::/32]",
+		},
+		"CIDR block larger than a range": {
+			cidrBlock:     "192.168.0.0/16",
+			expectedError: "192.168.0.0/16 is not within the allowed CIDRs [10.0.0.0/8 192.168.1.0/24 2001:db8::/32]",
+		},
+		"open to the world": {
+			cidrBlock:     "0.0.0.0/0",
+			expectedError: "0.0.0.0/0 is not within the allowed CIDRs [10.0.0.0/8 192.168.1.0/24 2001:db8::/32]",
+		},
+		"AWS security group": {
+			awsSecurityGroup: "sg-123456",
+			expectedError:    "AWS security group sg-123456 is not allowed, entries must be within [10.0.0.0/8 192.168.1.0/24 2001:db8::/32]",
+		},
+		"invalid entry": {
+			ipAddress:     "10.0.0",
+			expectedError: `entry 10.0.0 can't be checked: ParseAddr("10.0.0"): IPv4 address too short`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := IPAccessListPolicy(tt.ipAddress, tt.cidrBlock, tt.awsSecurityGroup, policy)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
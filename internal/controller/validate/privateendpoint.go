@@ -0,0 +1,69 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/privateendpoint"
+)
+
+func PrivateEndpoint(pe *akov2.AtlasPrivateEndpoint) error {
+	spec := pe.Spec
+
+	if spec.Provider != privateendpoint.ProviderAWS {
+		if len(spec.SupportedRegions) > 0 {
+			return errors.New("supportedRegions can be used only with AWS private endpoints")
+		}
+		if len(spec.AWSConfiguration) > 0 {
+			return errors.New("awsConfiguration can be used only with AWS private endpoints")
+		}
+	}
+
+	if spec.Provider != privateendpoint.ProviderAzure && len(spec.AzureConfiguration) > 0 {
+		return errors.New("azureConfiguration can be used only with Azure private endpoints")
+	}
+
+	if spec.Provider != privateendpoint.ProviderGCP {
+		if spec.PortMappingEnabled {
+			return errors.New("portMappingEnabled can be used only with GCP private endpoints")
+		}
+		if len(spec.GCPConfiguration) > 0 {
+			return errors.New("gcpConfiguration can be used only with GCP private endpoints")
+		}
+	}
+
+	for _, config := range spec.AzureConfiguration {
+		if net.ParseIP(config.IP) == nil {
+			return fmt.Errorf("azure private endpoint %s has an invalid ipAddress %q", config.ID, config.IP)
+		}
+	}
+
+	for _, config := range spec.GCPConfiguration {
+		if len(config.Endpoints) == 0 {
+			return fmt.Errorf("gcp endpoint group %s must define at least one endpoint", config.GroupName)
+		}
+		for _, endpoint := range config.Endpoints {
+			if net.ParseIP(endpoint.IP) == nil {
+				return fmt.Errorf("gcp endpoint %s has an invalid ipAddress %q", endpoint.Name, endpoint.IP)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestPrivateEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		spec    akov2.AtlasPrivateEndpointSpec
+		wantErr string
+	}{
+		{
+			name: "valid AWS endpoint",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:         "AWS",
+				Region:           "US_EAST_1",
+				SupportedRegions: []string{"US_EAST_1", "US_WEST_2"},
+				AWSConfiguration: []akov2.AWSPrivateEndpointConfiguration{{ID: "vpce-123"}},
+			},
+		},
+		{
+			name: "valid GCP endpoint",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:           "GCP",
+				Region:             "CENTRAL_US",
+				PortMappingEnabled: true,
+				GCPConfiguration: []akov2.GCPPrivateEndpointConfiguration{
+					{
+						ProjectID: "gcp-project",
+						GroupName: "group",
+						Endpoints: []akov2.GCPPrivateEndpoint{{Name: "endpoint", IP: "10.0.0.1"}},
+					},
+				},
+			},
+		},
+		{
+			name: "supported regions on Azure",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:         "AZURE",
+				Region:           "EUROPE_NORTH",
+				SupportedRegions: []string{"EUROPE_NORTH"},
+			},
+			wantErr: "supportedRegions can be used only with AWS private endpoints",
+		},
+		{
+			name: "port mapping on AWS",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:           "AWS",
+				Region:             "US_EAST_1",
+				PortMappingEnabled: true,
+			},
+			wantErr: "portMappingEnabled can be used only with GCP private endpoints",
+		},
+		{
+			name: "invalid Azure IP address",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:           "AZURE",
+				Region:             "EUROPE_NORTH",
+				AzureConfiguration: []akov2.AzurePrivateEndpointConfiguration{{ID: "pe", IP: "10.0.0"}},
+			},
+			wantErr: "azure private endpoint pe has an invalid ipAddress \"10.0.0\"",
+		},
+		{
+			name: "GCP group without endpoints",
+			spec: akov2.AtlasPrivateEndpointSpec{
+				Provider:         "GCP",
+				Region:           "CENTRAL_US",
+				GCPConfiguration: []akov2.GCPPrivateEndpointConfiguration{{ProjectID: "gcp-project", GroupName: "group"}},
+			},
+			wantErr: "gcp endpoint group group must define at least one endpoint",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := PrivateEndpoint(&akov2.AtlasPrivateEndpoint{Spec: tc.spec})
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 )
 
@@ -32,6 +33,9 @@ import (
 func DeprecatedCommonPredicates[T metav1.Object]() predicate.TypedPredicate[T] {
 	return predicate.Or(
 		SkipAnnotationRemovedPredicate[T](),
+		ApproveAnnotationChangedPredicate[T](),
+		KeepAnnotationAddedPredicate[T](),
+		ConnectionSecretTemplateAnnotationChangedPredicate[T](),
 		predicate.TypedFuncs[T]{
 			UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
 				if e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion() {
@@ -71,6 +75,39 @@ func SkipAnnotationRemovedPredicate[T metav1.Object]() predicate.TypedPredicate[
 	}
 }
 
+// ApproveAnnotationChangedPredicate reconciles on updates when the approve annotation is set or changed,
+// so that an approved destructive operation proceeds right away
+func ApproveAnnotationChangedPredicate[T metav1.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			token, ok := e.ObjectNew.GetAnnotations()[customresource.ApproveAnnotation]
+			return ok && token != e.ObjectOld.GetAnnotations()[customresource.ApproveAnnotation]
+		},
+	}
+}
+
+// KeepAnnotationAddedPredicate reconciles on updates when the resource policy annotation is set to keep,
+// so that a resource pending deletion is released right away without deleting it from Atlas
+func KeepAnnotationAddedPredicate[T metav1.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return e.ObjectNew.GetAnnotations()[customresource.ResourcePolicyAnnotation] == customresource.ResourcePolicyKeep &&
+				e.ObjectOld.GetAnnotations()[customresource.ResourcePolicyAnnotation] != customresource.ResourcePolicyKeep
+		},
+	}
+}
+
+// ConnectionSecretTemplateAnnotationChangedPredicate reconciles on updates when the connection Secret template
+// annotation of a database user is set, changed or removed, so that its connection Secrets are rendered again
+func ConnectionSecretTemplateAnnotationChangedPredicate[T metav1.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return e.ObjectNew.GetAnnotations()[akov2.ConnectionSecretTemplateAnnotation] !=
+				e.ObjectOld.GetAnnotations()[akov2.ConnectionSecretTemplateAnnotation]
+		},
+	}
+}
+
 // GlobalResyncAwareGenerationChangePredicate reconcile on unfrequent global
 // resyncs or on spec generation changes, but ignore finalizer changes
 func GlobalResyncAwareGenerationChangePredicate[T metav1.Object]() predicate.TypedPredicate[T] {
@@ -96,6 +133,8 @@ func DefaultPredicates[T metav1.Object]() predicate.TypedPredicate[T] {
 		predicate.Or(
 			GlobalResyncAwareGenerationChangePredicate[T](),
 			SkipAnnotationRemovedPredicate[T](),
+			ApproveAnnotationChangedPredicate[T](),
+			KeepAnnotationAddedPredicate[T](),
 		),
 		IgnoreDeletedPredicate[T](),
 	)
@@ -112,6 +112,42 @@ func TestDeprecatedCommonPredicates(t *testing.T) {
 			),
 			want: true,
 		},
+		{
+			title: "approved",
+			old:   sampleObj(resourceVersion("0")),
+			new:   sampleObj(resourceVersion("1"), approveAnnotation("token")),
+			want:  true,
+		},
+		{
+			title: "approval removed",
+			old:   sampleObj(resourceVersion("0"), approveAnnotation("token")),
+			new:   sampleObj(resourceVersion("1")),
+			want:  false,
+		},
+		{
+			title: "kept",
+			old:   sampleObj(resourceVersion("0")),
+			new:   sampleObj(resourceVersion("1"), resourcePolicyAnnotation(customresource.ResourcePolicyKeep)),
+			want:  true,
+		},
+		{
+			title: "keep removed",
+			old:   sampleObj(resourceVersion("0"), resourcePolicyAnnotation(customresource.ResourcePolicyKeep)),
+			new:   sampleObj(resourceVersion("1"), resourcePolicyAnnotation(customresource.ResourcePolicyDelete)),
+			want:  false,
+		},
+		{
+			title: "connection secret template changed",
+			old:   sampleObj(resourceVersion("0"), connectionSecretTemplateAnnotation("spring")),
+			new:   sampleObj(resourceVersion("1"), connectionSecretTemplateAnnotation("jdbc")),
+			want:  true,
+		},
+		{
+			title: "connection secret template removed",
+			old:   sampleObj(resourceVersion("0"), connectionSecretTemplateAnnotation("spring")),
+			new:   sampleObj(resourceVersion("1")),
+			want:  true,
+		},
 	} {
 		t.Run(tc.title, func(t *testing.T) {
 			f := watch.DeprecatedCommonPredicates[client.Object]()
@@ -181,6 +217,15 @@ func TestDefaultPredicates(t *testing.T) {
 			wantDelete:  false,
 			wantGeneric: true,
 		},
+		{
+			title:       "approved",
+			old:         sampleObj(resourceVersion("0"), approveAnnotation("token")),
+			new:         sampleObj(resourceVersion("1"), approveAnnotation("other-token")),
+			wantCreate:  true,
+			wantUpdate:  true,
+			wantDelete:  false,
+			wantGeneric: true,
+		},
 		{
 			title:       "finalizers removed",
 			old:         sampleObj(resourceVersion("0"), finalizers([]string{"finalize"})),
@@ -241,6 +286,27 @@ func skipAnnotation() optionFunc {
 	}
 }
 
+func approveAnnotation(token string) optionFunc {
+	return func(p *akov2.AtlasProject) *akov2.AtlasProject {
+		p.Annotations[customresource.ApproveAnnotation] = token
+		return p
+	}
+}
+
+func connectionSecretTemplateAnnotation(name string) optionFunc {
+	return func(p *akov2.AtlasProject) *akov2.AtlasProject {
+		p.Annotations[akov2.ConnectionSecretTemplateAnnotation] = name
+		return p
+	}
+}
+
+func resourcePolicyAnnotation(policy string) optionFunc {
+	return func(p *akov2.AtlasProject) *akov2.AtlasProject {
+		p.Annotations[customresource.ResourcePolicyAnnotation] = policy
+		return p
+	}
+}
+
 func finalizers(f []string) optionFunc {
 	return func(p *akov2.AtlasProject) *akov2.AtlasProject {
 		p.Finalizers = f
@@ -14,6 +14,8 @@
 
 package workflow
 
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/drift"
+
 type ConditionReason string
 
 // TODO move 'ConditionReason' to 'api' package?
@@ -29,6 +31,14 @@ const (
 	AtlasGovUnsupported           ConditionReason = "AtlasGovUnsupported"
 	AtlasAPIAccessNotConfigured   ConditionReason = "AtlasAPIAccessNotConfigured"
 	AtlasUnsupportedFeature       ConditionReason = "AtlasUnsupportedFeature"
+	AtlasAPIRateLimited           ConditionReason = "AtlasAPIRateLimited"
+	PolicyViolation               ConditionReason = "PolicyViolation"
+	ApprovalRequired              ConditionReason = "ApprovalRequired"
+	OperationApproved             ConditionReason = "OperationApproved"
+	AtlasResourceDrifted          ConditionReason = drift.ReasonDrifted
+	AtlasResourceInSync           ConditionReason = drift.ReasonInSync
+
+	AtlasReconciliationPolicyUnsupported ConditionReason = "AtlasReconciliationPolicyUnsupported"
 )
 
 // Atlas Project reasons
@@ -78,6 +88,10 @@ const (
 	DeploymentNotUpdatedInAtlas           ConditionReason = "DeploymentNotUpdatedInAtlas"
 	DeploymentCreating                    ConditionReason = "DeploymentCreating"
 	DeploymentUpdating                    ConditionReason = "DeploymentUpdating"
+	DeploymentChangesHeld                 ConditionReason = "DeploymentChangesHeld"
+	DeploymentDeletionScheduled           ConditionReason = "DeploymentDeletionScheduled"
+	DeploymentFinalSnapshotInProgress     ConditionReason = "DeploymentFinalSnapshotInProgress"
+	DeploymentFinalSnapshotFailed         ConditionReason = "DeploymentFinalSnapshotFailed"
 	DeploymentConnectionSecretsNotCreated ConditionReason = "DeploymentConnectionSecretsNotCreated"
 	DeploymentAdvancedOptionsReady        ConditionReason = "DeploymentAdvancedOptionsReady"
 	DedicatedMigrationProgressing         ConditionReason = "DedicatedMigrationProgressing"
@@ -149,6 +163,12 @@ const (
 	StreamConnectionNotCreated    ConditionReason = "StreamConnectionNotCreated"
 	StreamConnectionNotRemoved    ConditionReason = "StreamConnectionNotRemoved"
 	StreamConnectionNotUpdated    ConditionReason = "StreamConnectionNotUpdated"
+	StreamProcessorNotConfigured  ConditionReason = "StreamProcessorNotConfigured"
+	StreamProcessorNotCreated     ConditionReason = "StreamProcessorNotCreated"
+	StreamProcessorNotUpdated     ConditionReason = "StreamProcessorNotUpdated"
+	StreamProcessorNotRemoved     ConditionReason = "StreamProcessorNotRemoved"
+	StreamProcessorInProgress     ConditionReason = "StreamProcessorInProgress"
+	StreamProcessorFailed         ConditionReason = "StreamProcessorFailed"
 )
 
 const (
@@ -169,6 +189,7 @@ const (
 	PrivateEndpointConfigurationPending     ConditionReason = "PrivateEndpointConfigurationPending"
 	PrivateEndpointFailedToConfigure        ConditionReason = "PrivateEndpointFailedToConfigure"
 	PrivateEndpointFailedToDelete           ConditionReason = "PrivateEndpointFailedToDelete"
+	PrivateEndpointInvalidSpec              ConditionReason = "PrivateEndpointInvalidSpec"
 )
 
 // Atlas IP Access List reasons
@@ -186,6 +207,43 @@ const (
 	NetworkContainerNotDeleted    ConditionReason = "NetworkContainerNotDeleted"
 )
 
+// Atlas Backup Restore Job reasons
+const (
+	BackupRestoreJobNotConfigured ConditionReason = "BackupRestoreJobNotConfigured"
+	BackupRestoreJobInProgress    ConditionReason = "BackupRestoreJobInProgress"
+	BackupRestoreJobCompleted     ConditionReason = "BackupRestoreJobCompleted"
+	BackupRestoreJobFailed        ConditionReason = "BackupRestoreJobFailed"
+	BackupRestoreJobSpecChanged   ConditionReason = "BackupRestoreJobSpecChanged"
+	BackupRestoreJobNotCancelled  ConditionReason = "BackupRestoreJobNotCancelled"
+)
+
+// Atlas Backup Snapshot reasons
+const (
+	BackupSnapshotNotConfigured ConditionReason = "BackupSnapshotNotConfigured"
+	BackupSnapshotInProgress    ConditionReason = "BackupSnapshotInProgress"
+	BackupSnapshotCompleted     ConditionReason = "BackupSnapshotCompleted"
+	BackupSnapshotFailed        ConditionReason = "BackupSnapshotFailed"
+	BackupSnapshotNotFound      ConditionReason = "BackupSnapshotNotFound"
+	BackupSnapshotNotDeleted    ConditionReason = "BackupSnapshotNotDeleted"
+)
+
+// Atlas Search Index reasons
+const (
+	SearchIndexNotConfigured ConditionReason = "SearchIndexNotConfigured"
+	SearchIndexConflict      ConditionReason = "SearchIndexConflict"
+	SearchIndexInProgress    ConditionReason = "SearchIndexInProgress"
+	SearchIndexFailed        ConditionReason = "SearchIndexFailed"
+	SearchIndexNotDeleted    ConditionReason = "SearchIndexNotDeleted"
+)
+
+// Atlas Online Archive reasons
+const (
+	OnlineArchiveNotConfigured ConditionReason = "OnlineArchiveNotConfigured"
+	OnlineArchiveInProgress    ConditionReason = "OnlineArchiveInProgress"
+	OnlineArchiveOrphaned      ConditionReason = "OnlineArchiveOrphaned"
+	OnlineArchiveNotDeleted    ConditionReason = "OnlineArchiveNotDeleted"
+)
+
 // Atlas Network Peering reasons
 const (
 	NetworkPeeringNotConfigured      ConditionReason = "NetworkPeeringNotConfigured"
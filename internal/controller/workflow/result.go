@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
 )
 
 const (
@@ -58,12 +59,21 @@ func Requeue(period time.Duration) DeprecatedResult {
 // This is not an expected termination of the reconciliation process so 'warning' flag is set to 'true'.
 // 'reason' and 'message' indicate the error state and are supposed to be reflected in the `conditions` for the
 // reconciled Custom Resource.
+//
+// If the error was caused by Atlas API rate limiting, the reason is replaced by AtlasAPIRateLimited
+// and the reconciliation is requeued no earlier than Atlas asked for.
 func Terminate(reason ConditionReason, err error) DeprecatedResult {
 	dryrun.AddTerminationError(err) // TODO: factor this in favor of controller-runtime error handling
 
+	requeueAfter := DefaultRetry
+	if retryAfter, ok := throttle.RetryAfter(err); ok {
+		reason = AtlasAPIRateLimited
+		requeueAfter = max(requeueAfter, retryAfter)
+	}
+
 	return DeprecatedResult{
 		terminated:   true,
-		requeueAfter: DefaultRetry,
+		requeueAfter: requeueAfter,
 		reason:       reason,
 		message:      err.Error(),
 		warning:      true,
@@ -0,0 +1,49 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
+)
+
+func TestTerminate(t *testing.T) {
+	t.Run("keeps the given reason for regular errors", func(t *testing.T) {
+		result := Terminate(DeploymentNotCreatedInAtlas, errors.New("boom"))
+		assert.Equal(t, DeploymentNotCreatedInAtlas, result.reason)
+		assert.Equal(t, DefaultRetry, result.requeueAfter)
+		assert.True(t, result.IsWarning())
+	})
+
+	t.Run("uses a distinct reason for rate limited errors", func(t *testing.T) {
+		err := fmt.Errorf("failed to create deployment: %w", &throttle.RateLimitedError{Method: "POST", Path: "/test", RetryAfter: time.Minute})
+		result := Terminate(DeploymentNotCreatedInAtlas, err)
+		assert.Equal(t, AtlasAPIRateLimited, result.reason)
+		assert.Equal(t, time.Minute, result.requeueAfter)
+	})
+
+	t.Run("never requeues earlier than the default retry", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", &throttle.RateLimitedError{Method: "GET", Path: "/test"})
+		result := Terminate(DeploymentNotCreatedInAtlas, err)
+		assert.Equal(t, AtlasAPIRateLimited, result.reason)
+		assert.Equal(t, DefaultRetry, result.requeueAfter)
+	})
+}
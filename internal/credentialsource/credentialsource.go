@@ -0,0 +1,223 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credentialsource resolves Atlas credentials that are not stored in a
+// Kubernetes Secret. A connection Secret may point to a credential source
+// instead of holding the credentials itself, so that long-lived keys never
+// reach etcd.
+package credentialsource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SourceKey is the connection Secret key selecting the credential source.
+	SourceKey = "credentialSource"
+
+	// FileSource reads credentials from a directory, typically a projected or CSI volume,
+	// holding one file per credential key.
+	FileSource = "file"
+	// VaultSource reads credentials from a Vault compatible HTTP KV endpoint.
+	VaultSource = "vault"
+
+	// FilePathKey is the absolute path of the directory holding the credential files.
+	FilePathKey = "credentialPath"
+
+	// VaultAddressKey is the address of the Vault server, e.g. https://vault.example.com:8200.
+	VaultAddressKey = "vaultAddress"
+	// VaultPathKey is the path of the KV secret, e.g. secret/data/atlas for a KV v2 engine.
+	VaultPathKey = "vaultPath"
+	// VaultNamespaceKey is the optional Vault namespace.
+	VaultNamespaceKey = "vaultNamespace"
+	// VaultRoleKey is the role used to log in with the Kubernetes auth method.
+	VaultRoleKey = "vaultRole"
+	// VaultAuthPathKey is the mount path of the Kubernetes auth method, defaults to kubernetes.
+	VaultAuthPathKey = "vaultAuthPath"
+	// VaultTokenPathKey is the path of a file holding a Vault token, used instead of VaultRoleKey.
+	VaultTokenPathKey = "vaultTokenPath"
+
+	// DefaultVaultTTL is how long a KV secret without a lease is cached.
+	DefaultVaultTTL = 5 * time.Minute
+
+	defaultVaultAuthPath    = "kubernetes"
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+var configKeys = []string{
+	SourceKey,
+	FilePathKey,
+	VaultAddressKey,
+	VaultPathKey,
+	VaultNamespaceKey,
+	VaultRoleKey,
+	VaultAuthPathKey,
+	VaultTokenPathKey,
+}
+
+// IsConfigured returns true if the given Secret data selects a credential source.
+func IsConfigured(data map[string][]byte) bool {
+	return len(data[SourceKey]) > 0
+}
+
+// Policy restricts what a connection Secret may configure. Connection Secrets can be written
+// by the tenants of the operator, so the Vault servers the operator authenticates to and the
+// paths it reads from are set by its administrator only.
+type Policy struct {
+	// VaultAddresses are the addresses of the Vault servers the vault source may read from.
+	// The vault source is disabled when empty.
+	VaultAddresses []string
+	// VaultAuthPaths are the mount paths of the Kubernetes auth methods the vault source may
+	// log in with. Only the default kubernetes mount is allowed when empty.
+	VaultAuthPaths []string
+	// PathRoots are the directories credentialPath and vaultTokenPath must be in.
+	// The file source and vaultTokenPath are disabled when empty.
+	PathRoots []string
+}
+
+// Validate returns an error if an address of the policy is not an HTTP(S) URL,
+// or if a path root is not absolute.
+func (p Policy) Validate() error {
+	for _, address := range p.VaultAddresses {
+		u, err := url.Parse(address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("vault address %q must be an http or https URL", address)
+		}
+	}
+	for _, root := range p.PathRoots {
+		if !filepath.IsAbs(root) {
+			return fmt.Errorf("credential source path %q must be an absolute path", root)
+		}
+	}
+	return nil
+}
+
+func (p Policy) normalize() Policy {
+	normalized := Policy{}
+	for _, address := range p.VaultAddresses {
+		normalized.VaultAddresses = append(normalized.VaultAddresses, strings.TrimSuffix(address, "/"))
+	}
+	for _, authPath := range p.VaultAuthPaths {
+		normalized.VaultAuthPaths = append(normalized.VaultAuthPaths, strings.Trim(authPath, "/"))
+	}
+	if len(normalized.VaultAuthPaths) == 0 {
+		normalized.VaultAuthPaths = []string{defaultVaultAuthPath}
+	}
+	for _, root := range p.PathRoots {
+		normalized.PathRoots = append(normalized.PathRoots, filepath.Clean(root))
+	}
+	return normalized
+}
+
+// allowedPath returns the cleaned path if it is in one of the path roots of the policy.
+func (p Policy) allowedPath(key, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("%s %q must be an absolute path", key, path)
+	}
+	path = filepath.Clean(path)
+	if len(p.PathRoots) == 0 {
+		return "", fmt.Errorf("%s is not allowed: no credential source path is configured for the operator", key)
+	}
+	for _, root := range p.PathRoots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s %q is not in an allowed credential source path %q", key, path, p.PathRoots)
+}
+
+func (p Policy) allowVault(vc *vaultConfig) error {
+	if len(p.VaultAddresses) == 0 {
+		return fmt.Errorf("%s source is not allowed: no Vault address is configured for the operator", VaultSource)
+	}
+	if !slices.Contains(p.VaultAddresses, vc.address) {
+		return fmt.Errorf("%s %q is not allowed, must be one of %q", VaultAddressKey, vc.address, p.VaultAddresses)
+	}
+	if vc.role != "" && !slices.Contains(p.VaultAuthPaths, vc.authPath) {
+		return fmt.Errorf("%s %q is not allowed, must be one of %q", VaultAuthPathKey, vc.authPath, p.VaultAuthPaths)
+	}
+	return nil
+}
+
+// Resolver reads credentials from the source selected by a connection Secret.
+// Resolved credentials are cached: file sources are read again when a file changes,
+// Vault sources when their lease, or DefaultVaultTTL, expires.
+type Resolver struct {
+	policy                  Policy
+	httpClient              *http.Client
+	serviceAccountTokenPath string
+	now                     func() time.Time
+
+	mu     sync.Mutex
+	files  map[string]*fileEntry
+	vault  map[string]*vaultEntry
+	tokens map[string]*vaultEntry
+}
+
+// NewResolver returns a Resolver reading credentials from the sources allowed by the given policy.
+func NewResolver(policy Policy) *Resolver {
+	return &Resolver{
+		policy:                  policy.normalize(),
+		httpClient:              &http.Client{Timeout: 30 * time.Second},
+		serviceAccountTokenPath: serviceAccountTokenPath,
+		now:                     time.Now,
+		files:                   map[string]*fileEntry{},
+		vault:                   map[string]*vaultEntry{},
+		tokens:                  map[string]*vaultEntry{},
+	}
+}
+
+// Resolve returns the connection Secret data with the credentials read from the
+// selected source. Keys read from the source take precedence over the Secret keys,
+// the keys configuring the source are removed.
+// The data is returned as is when no source is selected.
+func (r *Resolver) Resolve(ctx context.Context, data map[string][]byte) (map[string][]byte, error) {
+	if !IsConfigured(data) {
+		return data, nil
+	}
+
+	var (
+		credentials map[string][]byte
+		err         error
+	)
+	switch source := string(data[SourceKey]); source {
+	case FileSource:
+		credentials, err = r.readFile(data)
+	case VaultSource:
+		credentials, err = r.readVault(ctx, data)
+	default:
+		return nil, fmt.Errorf("unsupported %s %q, must be one of %q or %q", SourceKey, source, FileSource, VaultSource)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make(map[string][]byte, len(data)+len(credentials))
+	maps.Copy(resolved, data)
+	for _, key := range configKeys {
+		delete(resolved, key)
+	}
+	maps.Copy(resolved, credentials)
+	return resolved, nil
+}
@@ -0,0 +1,354 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		data          map[string][]byte
+		expected      map[string][]byte
+		expectedError string
+	}{
+		{
+			name:     "no source",
+			data:     map[string][]byte{"orgId": []byte("org"), "publicApiKey": []byte("public")},
+			expected: map[string][]byte{"orgId": []byte("org"), "publicApiKey": []byte("public")},
+		},
+		{
+			name:          "unsupported source",
+			data:          map[string][]byte{SourceKey: []byte("s3")},
+			expectedError: `unsupported credentialSource "s3", must be one of "file" or "vault"`,
+		},
+		{
+			name:          "file source without path",
+			data:          map[string][]byte{SourceKey: []byte(FileSource)},
+			expectedError: "file source requires credentialPath",
+		},
+		{
+			name:          "file source with relative path",
+			data:          map[string][]byte{SourceKey: []byte(FileSource), FilePathKey: []byte("creds")},
+			expectedError: `credentialPath "creds" must be an absolute path`,
+		},
+		{
+			name:          "vault source without config",
+			data:          map[string][]byte{SourceKey: []byte(VaultSource)},
+			expectedError: "vault source requires vaultAddress, vaultPath, vaultRole or vaultTokenPath",
+		},
+		{
+			name: "vault source with role and token",
+			data: map[string][]byte{
+				SourceKey:         []byte(VaultSource),
+				VaultAddressKey:   []byte("https://vault"),
+				VaultPathKey:      []byte("secret/data/atlas"),
+				VaultRoleKey:      []byte("operator"),
+				VaultTokenPathKey: []byte("/token"),
+			},
+			expectedError: "only one of vaultRole or vaultTokenPath can be set",
+		},
+		{
+			name:          "file source outside of the allowed paths",
+			data:          map[string][]byte{SourceKey: []byte(FileSource), FilePathKey: []byte("/var/run/secrets/kubernetes.io/serviceaccount")},
+			expectedError: `credentialPath "/var/run/secrets/kubernetes.io/serviceaccount" is not in an allowed credential source path ["/etc/atlas-credentials"]`,
+		},
+		{
+			name:          "file source escaping the allowed paths",
+			data:          map[string][]byte{SourceKey: []byte(FileSource), FilePathKey: []byte("/etc/atlas-credentials/../passwd")},
+			expectedError: `credentialPath "/etc/passwd" is not in an allowed credential source path ["/etc/atlas-credentials"]`,
+		},
+		{
+			name: "vault address not allowed",
+			data: map[string][]byte{
+				SourceKey:       []byte(VaultSource),
+				VaultAddressKey: []byte("https://attacker.example.com"),
+				VaultPathKey:    []byte("secret/data/atlas"),
+				VaultRoleKey:    []byte("operator"),
+			},
+			expectedError: `vaultAddress "https://attacker.example.com" is not allowed, must be one of ["https://vault"]`,
+		},
+		{
+			name: "vault auth path not allowed",
+			data: map[string][]byte{
+				SourceKey:        []byte(VaultSource),
+				VaultAddressKey:  []byte("https://vault/"),
+				VaultPathKey:     []byte("secret/data/atlas"),
+				VaultRoleKey:     []byte("operator"),
+				VaultAuthPathKey: []byte("other"),
+			},
+			expectedError: `vaultAuthPath "other" is not allowed, must be one of ["kubernetes"]`,
+		},
+		{
+			name: "vault token path outside of the allowed paths",
+			data: map[string][]byte{
+				SourceKey:         []byte(VaultSource),
+				VaultAddressKey:   []byte("https://vault"),
+				VaultPathKey:      []byte("secret/data/atlas"),
+				VaultTokenPathKey: []byte("/var/run/secrets/kubernetes.io/serviceaccount/token"),
+			},
+			expectedError: `vaultTokenPath "/var/run/secrets/kubernetes.io/serviceaccount/token" is not in an allowed credential source path ["/etc/atlas-credentials"]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewResolver(Policy{VaultAddresses: []string{"https://vault"}, PathRoots: []string{"/etc/atlas-credentials/"}})
+			data, err := r.Resolve(context.Background(), tc.data)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, data)
+		})
+	}
+}
+
+func TestResolveFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "publicApiKey", "public\n")
+	writeFile(t, dir, "privateApiKey", "private")
+	// projected volumes keep their data in hidden directories
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0o700))
+	writeFile(t, dir, "..data/publicApiKey", "ignored")
+
+	r := NewResolver(Policy{PathRoots: []string{os.TempDir()}})
+	secretData := map[string][]byte{
+		"orgId":     []byte("org"),
+		SourceKey:   []byte(FileSource),
+		FilePathKey: []byte(dir),
+	}
+
+	data, err := r.Resolve(context.Background(), secretData)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"orgId":         []byte("org"),
+		"publicApiKey":  []byte("public"),
+		"privateApiKey": []byte("private"),
+	}, data)
+
+	t.Run("cached until a file changes", func(t *testing.T) {
+		r.files[dir].data["privateApiKey"] = []byte("cached")
+		data, err := r.Resolve(context.Background(), secretData)
+		require.NoError(t, err)
+		assert.Equal(t, "cached", string(data["privateApiKey"]))
+
+		writeFile(t, dir, "privateApiKey", "rotated-private")
+		data, err = r.Resolve(context.Background(), secretData)
+		require.NoError(t, err)
+		assert.Equal(t, "rotated-private", string(data["privateApiKey"]))
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		missing := filepath.Join(dir, "missing")
+		_, err := r.Resolve(context.Background(), map[string][]byte{
+			SourceKey:   []byte(FileSource),
+			FilePathKey: []byte(missing),
+		})
+		require.ErrorContains(t, err, "failed to read credentials from "+missing)
+	})
+
+	t.Run("empty directory", func(t *testing.T) {
+		empty := t.TempDir()
+		_, err := r.Resolve(context.Background(), map[string][]byte{
+			SourceKey:   []byte(FileSource),
+			FilePathKey: []byte(empty),
+		})
+		require.EqualError(t, err, "no credential files found in "+empty)
+	})
+}
+
+func TestResolveVault(t *testing.T) {
+	var logins, reads atomic.Int32
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			logins.Add(1)
+			body := map[string]string{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			if body["role"] != "operator" || body["jwt"] != "sa-token" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["invalid role or jwt"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"login-token","lease_duration":3600}}`))
+		case "/v1/secret/data/atlas":
+			reads.Add(1)
+			if token := req.Header.Get("X-Vault-Token"); token != "login-token" && token != "file-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+				return
+			}
+			assert.Equal(t, "team-a", req.Header.Get("X-Vault-Namespace"))
+			_, _ = w.Write([]byte(`{"data":{"data":{"publicApiKey":"public","privateApiKey":"private"},"metadata":{"version":3}}}`))
+		case "/v1/kv/atlas":
+			reads.Add(1)
+			_, _ = w.Write([]byte(`{"lease_duration":60,"data":{"publicApiKey":"public-v1","privateApiKey":"private-v1"}}`))
+		case "/v1/kv/invalid":
+			_, _ = w.Write([]byte(`{"data":{"publicApiKey":42}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer vault.Close()
+
+	dir := t.TempDir()
+	writeFile(t, dir, "sa-token", "sa-token\n")
+	writeFile(t, dir, "vault-token", "file-token")
+	writeFile(t, dir, "bad-token", "bad-token")
+
+	now := time.Now()
+	newResolver := func() *Resolver {
+		r := NewResolver(Policy{VaultAddresses: []string{vault.URL}, PathRoots: []string{dir}})
+		r.serviceAccountTokenPath = filepath.Join(dir, "sa-token")
+		r.now = func() time.Time { return now }
+		return r
+	}
+	vaultData := func(path string, extra ...string) map[string][]byte {
+		data := map[string][]byte{
+			"orgId":           []byte("org"),
+			SourceKey:         []byte(VaultSource),
+			VaultAddressKey:   []byte(vault.URL + "/"),
+			VaultPathKey:      []byte(path),
+			VaultNamespaceKey: []byte("team-a"),
+		}
+		for i := 0; i < len(extra); i += 2 {
+			data[extra[i]] = []byte(extra[i+1])
+		}
+		return data
+	}
+
+	t.Run("kv v2 with kubernetes auth", func(t *testing.T) {
+		logins.Store(0)
+		reads.Store(0)
+		r := newResolver()
+		data, err := r.Resolve(context.Background(), vaultData("secret/data/atlas", VaultRoleKey, "operator"))
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{
+			"orgId":         []byte("org"),
+			"publicApiKey":  []byte("public"),
+			"privateApiKey": []byte("private"),
+		}, data)
+
+		// cached for DefaultVaultTTL as KV v2 secrets have no lease
+		_, err = r.Resolve(context.Background(), vaultData("secret/data/atlas", VaultRoleKey, "operator"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), logins.Load())
+		assert.Equal(t, int32(1), reads.Load())
+
+		// the secret expires before the login token
+		now = now.Add(DefaultVaultTTL)
+		_, err = r.Resolve(context.Background(), vaultData("secret/data/atlas", VaultRoleKey, "operator"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), logins.Load())
+		assert.Equal(t, int32(2), reads.Load())
+	})
+
+	t.Run("kv v1 with token file", func(t *testing.T) {
+		reads.Store(0)
+		r := newResolver()
+		path := filepath.Join(dir, "vault-token")
+		data, err := r.Resolve(context.Background(), vaultData("kv/atlas", VaultTokenPathKey, path))
+		require.NoError(t, err)
+		assert.Equal(t, "public-v1", string(data["publicApiKey"]))
+
+		// cached for the lease duration
+		now = now.Add(59 * time.Second)
+		_, err = r.Resolve(context.Background(), vaultData("kv/atlas", VaultTokenPathKey, path))
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), reads.Load())
+
+		now = now.Add(time.Second)
+		_, err = r.Resolve(context.Background(), vaultData("kv/atlas", VaultTokenPathKey, path))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), reads.Load())
+	})
+
+	for _, tc := range []struct {
+		name          string
+		data          map[string][]byte
+		expectedError string
+	}{
+		{
+			name:          "login rejected",
+			data:          vaultData("secret/data/atlas", VaultRoleKey, "unknown"),
+			expectedError: `failed to authenticate to ` + vault.URL + `: failed to log in with role "unknown": unexpected status 400: invalid role or jwt`,
+		},
+		{
+			name:          "permission denied",
+			data:          vaultData("secret/data/atlas", VaultTokenPathKey, filepath.Join(dir, "bad-token")),
+			expectedError: "failed to read credentials from " + vault.URL + "/v1/secret/data/atlas: forbidden (403): permission denied",
+		},
+		{
+			name:          "missing token file",
+			data:          vaultData("secret/data/atlas", VaultTokenPathKey, filepath.Join(dir, "missing")),
+			expectedError: "failed to authenticate to " + vault.URL + ": failed to read Vault token: open " + filepath.Join(dir, "missing") + ": no such file or directory",
+		},
+		{
+			name:          "missing secret",
+			data:          vaultData("kv/missing", VaultTokenPathKey, filepath.Join(dir, "vault-token")),
+			expectedError: "failed to read credentials from " + vault.URL + "/v1/kv/missing: unexpected status 404",
+		},
+		{
+			name:          "non string value",
+			data:          vaultData("kv/invalid", VaultTokenPathKey, filepath.Join(dir, "vault-token")),
+			expectedError: "failed to read credentials from " + vault.URL + `/v1/kv/invalid: value of key "publicApiKey" is not a string`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newResolver().Resolve(context.Background(), tc.data)
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestResolveNotConfigured(t *testing.T) {
+	r := NewResolver(Policy{})
+
+	_, err := r.Resolve(context.Background(), map[string][]byte{SourceKey: []byte(FileSource), FilePathKey: []byte("/etc/atlas-credentials")})
+	require.EqualError(t, err, "credentialPath is not allowed: no credential source path is configured for the operator")
+
+	_, err = r.Resolve(context.Background(), map[string][]byte{
+		SourceKey:       []byte(VaultSource),
+		VaultAddressKey: []byte("https://vault"),
+		VaultPathKey:    []byte("secret/data/atlas"),
+		VaultRoleKey:    []byte("operator"),
+	})
+	require.EqualError(t, err, "vault source is not allowed: no Vault address is configured for the operator")
+}
+
+func TestPolicyValidate(t *testing.T) {
+	require.NoError(t, Policy{VaultAddresses: []string{"https://vault:8200"}, PathRoots: []string{"/etc/atlas-credentials"}}.Validate())
+	require.EqualError(t, Policy{VaultAddresses: []string{"vault:8200"}}.Validate(), `vault address "vault:8200" must be an http or https URL`)
+	require.EqualError(t, Policy{PathRoots: []string{"etc"}}.Validate(), `credential source path "etc" must be an absolute path`)
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsource
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+type fileEntry struct {
+	fingerprint map[string]fileVersion
+	data        map[string][]byte
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+func (r *Resolver) readFile(config map[string][]byte) (map[string][]byte, error) {
+	dir := string(config[FilePathKey])
+	if dir == "" {
+		return nil, fmt.Errorf("%s source requires %s", FileSource, FilePathKey)
+	}
+	dir, err := r.policy.allowedPath(FilePathKey, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := fingerprintDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials from %s: %w", dir, err)
+	}
+
+	r.mu.Lock()
+	entry, ok := r.files[dir]
+	r.mu.Unlock()
+	if ok && maps.Equal(entry.fingerprint, fingerprint) {
+		return entry.data, nil
+	}
+
+	data := make(map[string][]byte, len(fingerprint))
+	for _, name := range slices.Sorted(maps.Keys(fingerprint)) {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read credentials from %s: %w", dir, err)
+		}
+		data[name] = []byte(strings.TrimSpace(string(content)))
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no credential files found in %s", dir)
+	}
+	r.mu.Lock()
+	r.files[dir] = &fileEntry{fingerprint: fingerprint, data: data}
+	r.mu.Unlock()
+	return data, nil
+}
+
+// fingerprintDir returns the modification time and size of each credential file in dir.
+// Entries starting with a dot are skipped, projected volumes keep their data there and
+// swap it atomically, which changes the modification time of the files pointing to it.
+func fingerprintDir(dir string) (map[string]fileVersion, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := make(map[string]fileVersion, len(entries))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, entry.Name()))
+		if errors.Is(err, os.ErrNotExist) {
+			// a dangling link while the volume is being updated
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		fingerprint[entry.Name()] = fileVersion{modTime: info.ModTime(), size: info.Size()}
+	}
+	return fingerprint, nil
+}
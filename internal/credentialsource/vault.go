@@ -0,0 +1,271 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+type vaultEntry struct {
+	data    map[string][]byte
+	token   string
+	expires time.Time
+}
+
+type vaultConfig struct {
+	address   string
+	path      string
+	namespace string
+	role      string
+	authPath  string
+	tokenPath string
+}
+
+type vaultResponse struct {
+	LeaseDuration int             `json:"lease_duration"`
+	Data          json.RawMessage `json:"data"`
+	Auth          *vaultAuth      `json:"auth"`
+	Errors        []string        `json:"errors"`
+}
+
+type vaultAuth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int    `json:"lease_duration"`
+}
+
+func newVaultConfig(config map[string][]byte, policy Policy) (*vaultConfig, error) {
+	vc := &vaultConfig{
+		address:   strings.TrimSuffix(string(config[VaultAddressKey]), "/"),
+		path:      strings.Trim(string(config[VaultPathKey]), "/"),
+		namespace: string(config[VaultNamespaceKey]),
+		role:      string(config[VaultRoleKey]),
+		authPath:  strings.Trim(string(config[VaultAuthPathKey]), "/"),
+		tokenPath: string(config[VaultTokenPathKey]),
+	}
+	if vc.authPath == "" {
+		vc.authPath = defaultVaultAuthPath
+	}
+
+	var missing []string
+	if vc.address == "" {
+		missing = append(missing, VaultAddressKey)
+	}
+	if vc.path == "" {
+		missing = append(missing, VaultPathKey)
+	}
+	if vc.role == "" && vc.tokenPath == "" {
+		missing = append(missing, VaultRoleKey+" or "+VaultTokenPathKey)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%s source requires %s", VaultSource, strings.Join(missing, ", "))
+	}
+	if vc.role != "" && vc.tokenPath != "" {
+		return nil, fmt.Errorf("only one of %s or %s can be set", VaultRoleKey, VaultTokenPathKey)
+	}
+	if vc.tokenPath != "" {
+		tokenPath, err := policy.allowedPath(VaultTokenPathKey, vc.tokenPath)
+		if err != nil {
+			return nil, err
+		}
+		vc.tokenPath = tokenPath
+	}
+	if err := policy.allowVault(vc); err != nil {
+		return nil, err
+	}
+	return vc, nil
+}
+
+func (vc *vaultConfig) String() string {
+	return vc.address + "/v1/" + vc.path
+}
+
+func (vc *vaultConfig) cacheKey() string {
+	return strings.Join([]string{vc.address, vc.namespace, vc.path, vc.authPath, vc.role, vc.tokenPath}, "|")
+}
+
+func (r *Resolver) readVault(ctx context.Context, config map[string][]byte) (map[string][]byte, error) {
+	vc, err := newVaultConfig(config, r.policy)
+	if err != nil {
+		return nil, err
+	}
+
+	key := vc.cacheKey()
+	if entry, ok := r.cachedVaultEntry(r.vault, key); ok {
+		return entry.data, nil
+	}
+
+	// the lock is not held across requests to Vault, a slow server must not block the other credential sources
+	token, err := r.vaultToken(ctx, vc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to %s: %w", vc.address, err)
+	}
+	resp, err := r.vaultRequest(ctx, vc, http.MethodGet, vc.path, token, nil)
+	if err != nil {
+		if errors.Is(err, errVaultForbidden) {
+			// the token may have been revoked, log in again on the next attempt
+			r.mu.Lock()
+			delete(r.tokens, key)
+			r.mu.Unlock()
+		}
+		return nil, fmt.Errorf("failed to read credentials from %s: %w", vc, err)
+	}
+	data, err := kvData(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials from %s: %w", vc, err)
+	}
+
+	ttl := DefaultVaultTTL
+	if resp.LeaseDuration > 0 {
+		ttl = time.Duration(resp.LeaseDuration) * time.Second
+	}
+	r.mu.Lock()
+	r.vault[key] = &vaultEntry{data: data, expires: r.now().Add(ttl)}
+	r.mu.Unlock()
+	return data, nil
+}
+
+func (r *Resolver) vaultToken(ctx context.Context, vc *vaultConfig) (string, error) {
+	if vc.tokenPath != "" {
+		token, err := os.ReadFile(vc.tokenPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read Vault token: %w", err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+
+	key := vc.cacheKey()
+	if entry, ok := r.cachedVaultEntry(r.tokens, key); ok {
+		return entry.token, nil
+	}
+
+	jwt, err := os.ReadFile(r.serviceAccountTokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read service account token: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{"role": vc.role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.vaultRequest(ctx, vc, http.MethodPost, "auth/"+vc.authPath+"/login", "", body)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in with role %q: %w", vc.role, err)
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("failed to log in with role %q: no client token returned", vc.role)
+	}
+
+	lease := DefaultVaultTTL
+	if resp.Auth.LeaseDuration > 0 {
+		lease = time.Duration(resp.Auth.LeaseDuration) * time.Second
+	}
+	// log in again ahead of expiry, a token about to expire may not last the whole request
+	r.mu.Lock()
+	r.tokens[key] = &vaultEntry{token: resp.Auth.ClientToken, expires: r.now().Add(lease - lease/10)}
+	r.mu.Unlock()
+	return resp.Auth.ClientToken, nil
+}
+
+// cachedVaultEntry returns the entry of the given cache if it has not expired.
+func (r *Resolver) cachedVaultEntry(cache map[string]*vaultEntry, key string) (*vaultEntry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := cache[key]
+	if !ok || !r.now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry, true
+}
+
+var errVaultForbidden = errors.New("forbidden")
+
+func (r *Resolver) vaultRequest(ctx context.Context, vc *vaultConfig, method, path, token string, body []byte) (*vaultResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, method, vc.address+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if vc.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", vc.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	result := &vaultResponse{}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, result); err != nil && resp.StatusCode < 300 {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	if resp.StatusCode >= 300 {
+		err := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		if resp.StatusCode == http.StatusForbidden {
+			err = fmt.Errorf("%w (%d)", errVaultForbidden, resp.StatusCode)
+		}
+		if len(result.Errors) > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.Join(result.Errors, ", "))
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// kvData returns the string values of a KV v1 secret, or of the data of a KV v2 secret.
+func kvData(raw json.RawMessage) (map[string][]byte, error) {
+	var values map[string]any
+	if err := json.Unmarshal(raw, &values); err != nil || values == nil {
+		return nil, errors.New("response has no data")
+	}
+	if nested, ok := values["data"].(map[string]any); ok {
+		if _, ok := values["metadata"]; ok {
+			values = nested
+		}
+	}
+
+	data := make(map[string][]byte, len(values))
+	for key, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of key %q is not a string", key)
+		}
+		data[key] = []byte(s)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("secret is empty")
+	}
+	return data, nil
+}
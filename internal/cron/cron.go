@@ -0,0 +1,230 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cron parses the standard five field cron expressions, e.g. "0 20 * * MON-FRI",
+// and computes when they next fire.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds the search of the next activation, expressions like "0 0 30 2 *" never fire.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// anyDayOfMonth and anyDayOfWeek record whether the day fields start with "*". As in the
+	// standard cron, a day matches either of the day fields when both are restricted.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// day of week 7 is Sunday, as 0
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// Parse parses a cron expression made of the minute, hour, day of month, month and day of week fields.
+// Fields accept "*", values, ranges "a-b", steps "*/n" or "a-b/n" and comma separated lists of those.
+// Months and days of week accept the three letter English names, e.g. "JAN" or "MON".
+func Parse(expression string) (*Schedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expression, len(fields))
+	}
+
+	schedule := &Schedule{
+		anyDayOfMonth: strings.HasPrefix(fields[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(fields[4], "*"),
+	}
+	for i, target := range []struct {
+		set   *uint64
+		field field
+	}{
+		{set: &schedule.minute, field: minuteField},
+		{set: &schedule.hour, field: hourField},
+		{set: &schedule.dayOfMonth, field: dayOfMonthField},
+		{set: &schedule.month, field: monthField},
+		{set: &schedule.dayOfWeek, field: dayOfWeekField},
+	} {
+		set, err := parseField(fields[i], target.field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+		}
+		*target.set = set
+	}
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek = schedule.dayOfWeek&^(1<<7) | 1
+	}
+	return schedule, nil
+}
+
+func parseField(value string, f field) (uint64, error) {
+	var set uint64
+	for item := range strings.SplitSeq(value, ",") {
+		bits, err := parseItem(item, f)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: %w", f.name, value, err)
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+func parseItem(item string, f field) (uint64, error) {
+	rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepValue)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q", stepValue)
+		}
+	}
+
+	var low, high int
+	switch {
+	case rangeValue == "*":
+		low, high = f.min, f.max
+	case strings.Contains(rangeValue, "-"):
+		lowValue, highValue, _ := strings.Cut(rangeValue, "-")
+		var err error
+		if low, err = parseValue(lowValue, f); err != nil {
+			return 0, err
+		}
+		if high, err = parseValue(highValue, f); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid range %q", rangeValue)
+		}
+	default:
+		var err error
+		if low, err = parseValue(rangeValue, f); err != nil {
+			return 0, err
+		}
+		high = low
+		if hasStep {
+			// "a/n" is "a-max/n"
+			high = f.max
+		}
+	}
+
+	var bits uint64
+	for i := low; i <= high; i += step {
+		bits |= 1 << i
+	}
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// Next returns the first time after t the schedule fires, in the location of t.
+// It returns the zero time when the schedule never fires, e.g. for "0 0 30 2 *".
+func (s *Schedule) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.Add(searchLimit)
+	loc := next.Location()
+	for next.Before(limit) {
+		switch {
+		case !has(s.month, int(next.Month())):
+			next = advance(next, time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc))
+		case !s.dayMatches(next):
+			next = advance(next, time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc))
+		case !has(s.hour, next.Hour()):
+			next = advance(next, time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, loc))
+		case !has(s.minute, next.Minute()):
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dayOfMonth := has(s.dayOfMonth, t.Day())
+	dayOfWeek := has(s.dayOfWeek, int(t.Weekday()))
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// advance moves to the given time, or by a minute when daylight saving time changes
+// would otherwise move back in time.
+func advance(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from.Add(time.Minute)
+	}
+	return to
+}
+
+func has(set uint64, value int) bool {
+	return set&(1<<value) != 0
+}
+
+// ErrNeverFires is returned by Validate for expressions that never fire.
+var ErrNeverFires = errors.New("the cron expression never fires")
+
+// Validate parses the cron expression and checks it fires.
+func Validate(expression string) error {
+	schedule, err := Parse(expression)
+	if err != nil {
+		return err
+	}
+	if schedule.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return fmt.Errorf("invalid cron expression %q: %w", expression, ErrNeverFires)
+	}
+	return nil
+}
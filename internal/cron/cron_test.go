@@ -0,0 +1,127 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		expression  string
+		expectedErr string
+	}{
+		{expression: "* * * *", expectedErr: "expected 5 fields, got 4"},
+		{expression: "60 * * * *", expectedErr: `invalid minute "60": value 60 out of range [0, 59]`},
+		{expression: "* 5-2 * * *", expectedErr: `invalid hour "5-2": invalid range "5-2"`},
+		{expression: "* * 0 * *", expectedErr: `invalid day of month "0": value 0 out of range [1, 31]`},
+		{expression: "* * * FOO *", expectedErr: `invalid month "FOO": invalid value "FOO"`},
+		{expression: "*/0 * * * *", expectedErr: `invalid minute "*/0": invalid step "0"`},
+		{expression: "* * * * 8", expectedErr: `invalid day of week "8": value 8 out of range [0, 7]`},
+	} {
+		t.Run(tc.expression, func(t *testing.T) {
+			_, err := Parse(tc.expression)
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name       string
+		expression string
+		from       time.Time
+		expected   time.Time
+	}{
+		{
+			name:       "every minute",
+			expression: "* * * * *",
+			from:       time.Date(2025, 3, 10, 12, 30, 45, 0, time.UTC),
+			expected:   time.Date(2025, 3, 10, 12, 31, 0, 0, time.UTC),
+		},
+		{
+			name:       "fires strictly after the given time",
+			expression: "30 12 * * *",
+			from:       time.Date(2025, 3, 10, 12, 30, 0, 0, time.UTC),
+			expected:   time.Date(2025, 3, 11, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "week days evening",
+			expression: "0 20 * * MON-FRI",
+			from:       time.Date(2025, 3, 14, 21, 0, 0, 0, time.UTC), // Friday
+			expected:   time.Date(2025, 3, 17, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "sunday as 7",
+			expression: "0 0 * * 7",
+			from:       time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "steps and lists",
+			expression: "*/20 8,18 * * *",
+			from:       time.Date(2025, 3, 10, 8, 45, 0, 0, time.UTC),
+			expected:   time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of month or day of week when both are restricted",
+			expression: "0 0 1 * MON",
+			from:       time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 FEB *",
+			from:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "in the location of the given time",
+			expression: "0 7 * * *",
+			from:       time.Date(2025, 3, 10, 12, 0, 0, 0, berlin),
+			expected:   time.Date(2025, 3, 11, 7, 0, 0, 0, berlin),
+		},
+		{
+			name:       "skips times missing on daylight saving time change",
+			expression: "30 2 * * *",
+			from:       time.Date(2025, 3, 30, 0, 0, 0, 0, berlin),
+			expected:   time.Date(2025, 3, 31, 2, 30, 0, 0, berlin),
+		},
+		{
+			name:       "never",
+			expression: "0 0 30 2 *",
+			from:       time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := Parse(tc.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(tc.from))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("0 8 * * MON-FRI"))
+	require.ErrorIs(t, Validate("0 0 31 4 *"), ErrNeverFires)
+	require.ErrorContains(t, Validate("0 8 * *"), "expected 5 fields")
+}
@@ -18,6 +18,8 @@ import (
 	"net/http"
 
 	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
 )
 
 type Transport struct {
@@ -39,11 +41,15 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		deprecation := resp.Header.Get("Deprecation")
 		sunset := resp.Header.Get("Sunset")
 
+		endpoint := metrics.EndpointTemplate(req.URL.Path)
+
 		if deprecation != "" {
+			metrics.AtlasDeprecations.WithLabelValues("deprecation", req.Method, endpoint).Inc()
 			t.logger.Warn("deprecation", zap.String("type", "deprecation"), zap.String("date", deprecation), zap.String("javaMethod", javaMethod), zap.String("path", req.URL.Path), zap.String("method", req.Method))
 		}
 
 		if sunset != "" {
+			metrics.AtlasDeprecations.WithLabelValues("sunset", req.Method, endpoint).Inc()
 			t.logger.Warn("sunset", zap.String("type", "sunset"), zap.String("date", sunset), zap.String("javaMethod", javaMethod), zap.String("path", req.URL.Path), zap.String("method", req.Method))
 		}
 	}
@@ -0,0 +1,64 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+)
+
+const (
+	// ReasonDrifted is the reason of the Drifted condition when Atlas differs from the spec.
+	ReasonDrifted = "AtlasResourceDrifted"
+	// ReasonInSync is the reason of the Drifted condition when Atlas matches the spec.
+	ReasonInSync = "AtlasResourceInSync"
+)
+
+// SetCondition sets the Drifted condition of resources using metav1.Condition, i.e. the generated API kinds.
+// It returns true if the drift changed since the condition was last set.
+func SetCondition(conditions **[]metav1.Condition, generation int64, report *Report) bool {
+	if *conditions == nil {
+		*conditions = &[]metav1.Condition{}
+	}
+
+	condition := metav1.Condition{
+		Type:               string(api.DriftedType),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             ReasonInSync,
+		Message:            "Atlas matches the spec.",
+	}
+	if report.Drifted() {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonDrifted
+		condition.Message = report.Summary()
+	}
+
+	previous := meta.FindStatusCondition(**conditions, condition.Type)
+	changed := previous == nil || previous.Status != condition.Status || previous.Message != condition.Message
+	meta.SetStatusCondition(*conditions, condition)
+	return changed
+}
+
+// RemoveCondition removes the Drifted condition once the detect-only policy is removed.
+// It returns true if the condition was set.
+func RemoveCondition(conditions *[]metav1.Condition) bool {
+	if conditions == nil {
+		return false
+	}
+	return meta.RemoveStatusCondition(conditions, string(api.DriftedType))
+}
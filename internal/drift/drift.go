@@ -0,0 +1,153 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drift computes the differences between the state of a resource declared in Kubernetes
+// and the state observed in Atlas, for resources reconciled with the detect-only policy.
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+)
+
+const (
+	// MaxSummaryOperations is the number of patch operations included in a summary.
+	MaxSummaryOperations = 10
+
+	// maxSummaryValueLength truncates long values, e.g. whole replication specs, in a summary.
+	maxSummaryValueLength = 80
+)
+
+// Report holds the JSON patch operations that would turn the observed Atlas state into the desired state.
+type Report struct {
+	Operations []jsonpatch.Operation
+}
+
+// Compute compares the desired and the observed state of a resource.
+// Only the fields set in the desired state are compared, so fields defaulted or computed by Atlas
+// and left unset in the custom resource are never reported as drift.
+func Compute(desired, observed any) (*Report, error) {
+	desiredJSON, err := toJSONValue(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert desired state: %w", err)
+	}
+	observedJSON, err := toJSONValue(observed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert observed state: %w", err)
+	}
+
+	observedBytes, err := json.Marshal(prune(observedJSON, desiredJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal observed state: %w", err)
+	}
+	desiredBytes, err := json.Marshal(desiredJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal desired state: %w", err)
+	}
+
+	operations, err := jsonpatch.CreatePatch(observedBytes, desiredBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute patch: %w", err)
+	}
+	sort.Sort(jsonpatch.ByPath(operations))
+	return &Report{Operations: operations}, nil
+}
+
+// Drifted returns true if the observed state differs from the desired state.
+func (r *Report) Drifted() bool {
+	return r != nil && len(r.Operations) > 0
+}
+
+// Paths returns the JSON pointers of the drifted fields.
+func (r *Report) Paths() []string {
+	if r == nil {
+		return nil
+	}
+	paths := make([]string, 0, len(r.Operations))
+	for _, op := range r.Operations {
+		paths = append(paths, op.Path)
+	}
+	return paths
+}
+
+// Summary renders the first MaxSummaryOperations operations as a JSON patch, with long values truncated.
+func (r *Report) Summary() string {
+	if !r.Drifted() {
+		return ""
+	}
+
+	ops := r.Operations
+	if len(ops) > MaxSummaryOperations {
+		ops = ops[:MaxSummaryOperations]
+	}
+	rendered := make([]string, 0, len(ops))
+	for _, op := range ops {
+		rendered = append(rendered, summarize(op))
+	}
+
+	summary := "[" + strings.Join(rendered, ",") + "]"
+	if more := len(r.Operations) - len(ops); more > 0 {
+		summary = fmt.Sprintf("%s and %d more", summary, more)
+	}
+	return summary
+}
+
+func summarize(op jsonpatch.Operation) string {
+	if op.Operation == "remove" {
+		return op.Json()
+	}
+	value, err := json.Marshal(op.Value)
+	if err != nil || len(value) <= maxSummaryValueLength {
+		return op.Json()
+	}
+	truncated := string(value[:maxSummaryValueLength]) + "..."
+	return fmt.Sprintf(`{"op":%q,"path":%q,"value":%q}`, op.Operation, op.Path, truncated)
+}
+
+func toJSONValue(obj any) (any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// prune drops from the observed value all object keys not present in the desired value.
+// Arrays are compared as a whole, as elements are matched by position.
+func prune(observed, desired any) any {
+	observedMap, ok := observed.(map[string]any)
+	if !ok {
+		return observed
+	}
+	desiredMap, ok := desired.(map[string]any)
+	if !ok {
+		return observed
+	}
+
+	pruned := make(map[string]any, len(desiredMap))
+	for key, desiredValue := range desiredMap {
+		if observedValue, ok := observedMap[key]; ok {
+			pruned[key] = prune(observedValue, desiredValue)
+		}
+	}
+	return pruned
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+)
+
+type spec struct {
+	Name   string            `json:"name,omitempty"`
+	Paused *bool             `json:"paused,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Tags   []string          `json:"tags,omitempty"`
+}
+
+func TestCompute(t *testing.T) {
+	paused, running := true, false
+	for _, tc := range []struct {
+		title     string
+		desired   spec
+		observed  spec
+		wantPaths []string
+	}{
+		{
+			title:    "equal",
+			desired:  spec{Name: "cluster0", Paused: &paused},
+			observed: spec{Name: "cluster0", Paused: &paused},
+		},
+		{
+			title:    "fields unset in the desired state are ignored",
+			desired:  spec{Name: "cluster0"},
+			observed: spec{Name: "cluster0", Paused: &running, Labels: map[string]string{"team": "a"}},
+		},
+		{
+			title:     "changed field",
+			desired:   spec{Name: "cluster0", Paused: &paused},
+			observed:  spec{Name: "cluster0", Paused: &running},
+			wantPaths: []string{"/paused"},
+		},
+		{
+			title:     "field missing in Atlas",
+			desired:   spec{Name: "cluster0", Labels: map[string]string{"team": "a"}},
+			observed:  spec{Name: "cluster0"},
+			wantPaths: []string{"/labels"},
+		},
+		{
+			title:     "nested field",
+			desired:   spec{Labels: map[string]string{"team": "a"}},
+			observed:  spec{Labels: map[string]string{"team": "b", "env": "prod"}},
+			wantPaths: []string{"/labels/team"},
+		},
+		{
+			title:     "array element",
+			desired:   spec{Tags: []string{"a", "b"}},
+			observed:  spec{Tags: []string{"a", "c"}},
+			wantPaths: []string{"/tags/1"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			report, err := Compute(tc.desired, tc.observed)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.wantPaths) > 0, report.Drifted())
+			if len(tc.wantPaths) > 0 {
+				assert.Equal(t, tc.wantPaths, report.Paths())
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	desired := map[string]string{}
+	observed := map[string]string{}
+	for i := range MaxSummaryOperations + 2 {
+		desired[fmt.Sprintf("field%02d", i)] = "desired"
+		observed[fmt.Sprintf("field%02d", i)] = "observed"
+	}
+	desired["long"] = strings.Repeat("x", 200)
+
+	report, err := Compute(desired, observed)
+	require.NoError(t, err)
+
+	summary := report.Summary()
+	assert.True(t, strings.HasPrefix(summary, `[{"op":"replace","path":"/field00","value":"desired"}`), summary)
+	assert.True(t, strings.HasSuffix(summary, "and 3 more"), summary)
+	assert.Empty(t, (&Report{}).Summary())
+}
+
+func TestSummaryTruncatesValues(t *testing.T) {
+	report, err := Compute(map[string]string{"long": strings.Repeat("x", 200)}, map[string]string{"long": "short"})
+	require.NoError(t, err)
+
+	assert.Contains(t, report.Summary(), `"value":"\"`+strings.Repeat("x", maxSummaryValueLength-1)+`..."`)
+}
+
+func TestSetCondition(t *testing.T) {
+	var conditions *[]metav1.Condition
+
+	drifted, err := Compute(spec{Name: "cluster0"}, spec{Name: "cluster1"})
+	require.NoError(t, err)
+	assert.True(t, SetCondition(&conditions, 2, drifted))
+	condition := meta.FindStatusCondition(*conditions, string(api.DriftedType))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonDrifted, condition.Reason)
+	assert.Equal(t, int64(2), condition.ObservedGeneration)
+
+	assert.False(t, SetCondition(&conditions, 2, drifted))
+
+	inSync, err := Compute(spec{Name: "cluster0"}, spec{Name: "cluster0"})
+	require.NoError(t, err)
+	assert.True(t, SetCondition(&conditions, 3, inSync))
+	assert.True(t, meta.IsStatusConditionFalse(*conditions, string(api.DriftedType)))
+
+	assert.True(t, RemoveCondition(conditions))
+	assert.Empty(t, *conditions)
+	assert.False(t, RemoveCondition(conditions))
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -70,26 +71,49 @@ func (t *terminationAwareReconciler) Reconcile(ctx context.Context, req ctrl.Req
 // but executing dry-run functionality.
 type Manager struct {
 	cluster.Cluster
-	reconcilers  []reconciler
-	logger       *zap.Logger
-	instanceUID  string
-	eventsClient corev1client.EventsGetter
-	namespaces   []string
+	reconcilers []reconciler
+	logger      *zap.Logger
+	instanceUID string
+	coreClient  coreClient
+	namespaces  []string
+	planOutputs []PlanOutput
+	stdout      io.Writer
 }
 
-func NewManager(c cluster.Cluster, eventsClient corev1client.EventsGetter, logger *zap.Logger, namespaces []string) (*Manager, error) {
+type coreClient interface {
+	corev1client.EventsGetter
+	corev1client.ConfigMapsGetter
+}
+
+// ManagerOpt configures optional behavior of the dry-run Manager.
+type ManagerOpt func(*Manager)
+
+// WithPlanOutputs configures where the structured dry-run plan is written to
+// in addition to the emitted events.
+func WithPlanOutputs(outputs ...PlanOutput) ManagerOpt {
+	return func(m *Manager) {
+		m.planOutputs = outputs
+	}
+}
+
+func NewManager(c cluster.Cluster, coreClient coreClient, logger *zap.Logger, namespaces []string, opts ...ManagerOpt) (*Manager, error) {
 	mgr := &Manager{
-		Cluster:      c,
-		logger:       logger.Named("dry-run-manager"),
-		instanceUID:  uuid.New().String(),
-		eventsClient: eventsClient,
-		namespaces:   []string{metav1.NamespaceAll},
+		Cluster:     c,
+		logger:      logger.Named("dry-run-manager"),
+		instanceUID: uuid.New().String(),
+		coreClient:  coreClient,
+		namespaces:  []string{metav1.NamespaceAll},
+		stdout:      os.Stdout,
 	}
 
 	if len(namespaces) > 0 {
 		mgr.namespaces = namespaces
 	}
 
+	for _, opt := range opts {
+		opt(mgr)
+	}
+
 	return mgr, nil
 }
 
@@ -133,7 +157,7 @@ func (m *Manager) eventf(ctx context.Context, object runtime.Object, eventType,
 		},
 	}
 
-	_, err = m.eventsClient.Events(ev.GetNamespace()).Create(ctx, ev, metav1.CreateOptions{})
+	_, err = m.coreClient.Events(ev.GetNamespace()).Create(ctx, ev, metav1.CreateOptions{})
 	if err != nil {
 		return fmt.Errorf("unable to create event: %w", err)
 	}
@@ -146,6 +170,10 @@ func (m *Manager) executeDryRun(ctx context.Context) error {
 		return err
 	}
 
+	if err := m.reportPlan(ctx); err != nil {
+		return err
+	}
+
 	if err := m.eventf(ctx, m.object(), corev1.EventTypeNormal, DryRunReason, DryRunFinishedMsg); err != nil {
 		return err
 	}
@@ -155,6 +183,8 @@ func (m *Manager) executeDryRun(ctx context.Context) error {
 
 func (m *Manager) dryRunReconcilers(ctx context.Context) error {
 	enableErrors()
+	enablePlan()
+	clearPlan()
 
 	if !m.Cluster.GetCache().WaitForCacheSync(ctx) {
 		return errors.New("cluster cache sync failed")
@@ -182,6 +212,7 @@ func (m *Manager) dryRunReconcilers(ctx context.Context) error {
 
 			for _, item := range list.Items {
 				req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&item)}
+				setPlanResource(ResourceRef{Kind: gvk.Kind, Namespace: item.GetNamespace(), Name: item.GetName()})
 				_, err := reconciler.Reconcile(ctx, req)
 				if err != nil {
 					if err := m.reportError(ctx, &item, err); err != nil {
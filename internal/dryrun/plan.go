@@ -0,0 +1,225 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionExecute = "execute"
+)
+
+var actionMap = map[string]string{
+	http.MethodPost:   ActionCreate,
+	http.MethodPut:    ActionUpdate,
+	http.MethodPatch:  ActionUpdate,
+	http.MethodDelete: ActionDelete,
+}
+
+// ResourceRef identifies the custom resource whose reconciliation issued a planned request.
+type ResourceRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+func (r ResourceRef) String() string {
+	if r.Namespace == "" {
+		return r.Kind + "/" + r.Name
+	}
+	return r.Kind + "/" + r.Namespace + "/" + r.Name
+}
+
+// PlannedRequest is a single mutating Atlas API request intercepted during dry-run.
+type PlannedRequest struct {
+	Resource ResourceRef     `json:"resource"`
+	Action   string          `json:"action"`
+	Method   string          `json:"method"`
+	Path     string          `json:"path"`
+	Body     json.RawMessage `json:"body,omitempty"`
+	// Diff is the difference between the current state in Atlas and the request body.
+	// It is only computed for update requests.
+	Diff string `json:"diff,omitempty"`
+}
+
+// ResourceSummary counts the planned requests per action for a single custom resource.
+type ResourceSummary struct {
+	Resource ResourceRef `json:"resource"`
+	Creates  int         `json:"creates"`
+	Updates  int         `json:"updates"`
+	Deletes  int         `json:"deletes"`
+	Others   int         `json:"others"`
+}
+
+// Plan is the structured report of all changes the operator would apply to Atlas.
+type Plan struct {
+	InstanceUID string            `json:"instanceUID"`
+	Requests    []PlannedRequest  `json:"requests"`
+	Summary     []ResourceSummary `json:"summary"`
+}
+
+type planRecorder struct {
+	mu sync.Mutex // protects fields below
+
+	active   bool
+	resource ResourceRef
+	requests []PlannedRequest
+}
+
+var plannedRequests = &planRecorder{}
+
+func enablePlan() {
+	plannedRequests.mu.Lock()
+	defer plannedRequests.mu.Unlock()
+
+	plannedRequests.active = true
+}
+
+// setPlanResource sets the custom resource to which subsequently recorded requests are attributed.
+// Dry-run reconcilers are executed sequentially, hence there is only one resource being reconciled at any time.
+func setPlanResource(ref ResourceRef) {
+	plannedRequests.mu.Lock()
+	defer plannedRequests.mu.Unlock()
+
+	plannedRequests.resource = ref
+}
+
+func recordRequest(req *http.Request, body []byte, diff string) {
+	plannedRequests.mu.Lock()
+	defer plannedRequests.mu.Unlock()
+
+	if !plannedRequests.active {
+		return
+	}
+
+	action, ok := actionMap[req.Method]
+	if !ok {
+		action = ActionExecute
+	}
+
+	planned := PlannedRequest{
+		Resource: plannedRequests.resource,
+		Action:   action,
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Diff:     diff,
+	}
+	if len(body) > 0 && json.Valid(body) {
+		planned.Body = json.RawMessage(body)
+	}
+
+	plannedRequests.requests = append(plannedRequests.requests, planned)
+}
+
+func currentPlan(instanceUID string) *Plan {
+	plannedRequests.mu.Lock()
+	defer plannedRequests.mu.Unlock()
+
+	requests := make([]PlannedRequest, 0, len(plannedRequests.requests))
+	requests = append(requests, plannedRequests.requests...)
+
+	return &Plan{
+		InstanceUID: instanceUID,
+		Requests:    requests,
+		Summary:     summarize(requests),
+	}
+}
+
+func clearPlan() {
+	plannedRequests.mu.Lock()
+	defer plannedRequests.mu.Unlock()
+
+	plannedRequests.resource = ResourceRef{}
+	plannedRequests.requests = nil
+}
+
+func summarize(requests []PlannedRequest) []ResourceSummary {
+	byResource := map[ResourceRef]*ResourceSummary{}
+	for _, req := range requests {
+		summary, ok := byResource[req.Resource]
+		if !ok {
+			summary = &ResourceSummary{Resource: req.Resource}
+			byResource[req.Resource] = summary
+		}
+		switch req.Action {
+		case ActionCreate:
+			summary.Creates++
+		case ActionUpdate:
+			summary.Updates++
+		case ActionDelete:
+			summary.Deletes++
+		default:
+			summary.Others++
+		}
+	}
+
+	result := make([]ResourceSummary, 0, len(byResource))
+	for _, summary := range byResource {
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Resource.String() < result[j].Resource.String()
+	})
+	return result
+}
+
+// JSON returns the indented JSON representation of the plan.
+func (p *Plan) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dry-run plan: %w", err)
+	}
+	return data, nil
+}
+
+// Table returns a human-readable representation of the plan.
+func (p *Plan) Table() string {
+	var buf bytes.Buffer
+	p.writeTable(&buf)
+	return buf.String()
+}
+
+func (p *Plan) writeTable(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tACTION\tMETHOD\tPATH")
+	for _, req := range p.Requests {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", req.Resource, req.Action, req.Method, req.Path)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "RESOURCE\tCREATES\tUPDATES\tDELETES\tOTHERS")
+	for _, s := range p.Summary {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", s.Resource, s.Creates, s.Updates, s.Deletes, s.Others)
+	}
+	_ = w.Flush()
+
+	for _, req := range p.Requests {
+		if req.Diff == "" {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s %s %s:\n%s\n", req.Resource, req.Method, req.Path, strings.TrimRight(req.Diff, "\n"))
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDryRunTransportRecordsPlan(t *testing.T) {
+	enablePlan()
+	clearPlan()
+	t.Cleanup(clearPlan)
+
+	delegate := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodGet, req.Method)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"name":"cluster0","paused":false}`)),
+		}, nil
+	})
+	transport := NewDryRunTransport(delegate)
+
+	setPlanResource(ResourceRef{Kind: "AtlasDeployment", Namespace: "ns", Name: "my-deployment"})
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, "https://cloud.mongodb.com/api/atlas/v2/groups/123/clusters/cluster0", strings.NewReader(`{"name":"cluster0","paused":true}`))
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorContains(t, err, "Would update (PATCH) /api/atlas/v2/groups/123/clusters/cluster0")
+
+	setPlanResource(ResourceRef{Kind: "AtlasProject", Namespace: "ns", Name: "my-project"})
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodPost, "https://cloud.mongodb.com/api/atlas/v2/groups", strings.NewReader(`{"name":"project"}`))
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorContains(t, err, "Would create (POST) /api/atlas/v2/groups")
+
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodDelete, "https://cloud.mongodb.com/api/atlas/v2/groups/123/accessList/10.0.0.1", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorContains(t, err, "Would delete (DELETE) /api/atlas/v2/groups/123/accessList/10.0.0.1")
+
+	plan := currentPlan("uid")
+	require.Len(t, plan.Requests, 3)
+
+	update := plan.Requests[0]
+	assert.Equal(t, ResourceRef{Kind: "AtlasDeployment", Namespace: "ns", Name: "my-deployment"}, update.Resource)
+	assert.Equal(t, ActionUpdate, update.Action)
+	assert.JSONEq(t, `{"name":"cluster0","paused":true}`, string(update.Body))
+	assert.Contains(t, update.Diff, `"paused": false`)
+	assert.Contains(t, update.Diff, `"paused": true`)
+
+	create := plan.Requests[1]
+	assert.Equal(t, ActionCreate, create.Action)
+	assert.Empty(t, create.Diff)
+
+	deletion := plan.Requests[2]
+	assert.Equal(t, ActionDelete, deletion.Action)
+	assert.Nil(t, deletion.Body)
+
+	assert.Equal(t, []ResourceSummary{
+		{Resource: ResourceRef{Kind: "AtlasDeployment", Namespace: "ns", Name: "my-deployment"}, Updates: 1},
+		{Resource: ResourceRef{Kind: "AtlasProject", Namespace: "ns", Name: "my-project"}, Creates: 1, Deletes: 1},
+	}, plan.Summary)
+
+	table := plan.Table()
+	assert.Contains(t, table, "AtlasProject/ns/my-project")
+	assert.Contains(t, table, "RESOURCE")
+	assert.Contains(t, table, "CREATES")
+}
+
+func TestRecordRequestInactive(t *testing.T) {
+	plannedRequests.mu.Lock()
+	plannedRequests.active = false
+	plannedRequests.mu.Unlock()
+	t.Cleanup(enablePlan)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://cloud.mongodb.com/test", nil)
+	require.NoError(t, err)
+	recordRequest(req, nil, "")
+
+	assert.Empty(t, currentPlan("uid").Requests)
+}
+
+func TestParsePlanOutputs(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		value   string
+		want    []PlanOutput
+		wantErr string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "all outputs",
+			value: "configmap, json,table",
+			want:  []PlanOutput{PlanOutputConfigMap, PlanOutputJSON, PlanOutputTable},
+		},
+		{
+			name:    "unknown output",
+			value:   "configmap,yaml",
+			wantErr: `unsupported dry-run plan output "yaml"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParsePlanOutputs(tc.value)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReportPlan(t *testing.T) {
+	t.Setenv("JOB_NAME", "dry-run")
+	t.Setenv("JOB_NAMESPACE", "mongodb-atlas-system")
+	enablePlan()
+	clearPlan()
+	t.Cleanup(clearPlan)
+
+	setPlanResource(ResourceRef{Kind: "AtlasProject", Namespace: "ns", Name: "my-project"})
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://cloud.mongodb.com/api/atlas/v2/groups", nil)
+	require.NoError(t, err)
+	recordRequest(req, []byte(`{"name":"project"}`), "")
+
+	coreClient := fake.NewClientset().CoreV1()
+	m, err := NewManager(&mockCluster{}, coreClient, zaptest.NewLogger(t), nil, WithPlanOutputs(PlanOutputConfigMap, PlanOutputJSON))
+	require.NoError(t, err)
+	stdout := &bytes.Buffer{}
+	m.stdout = stdout
+
+	// writing twice must update the existing config map
+	require.NoError(t, m.reportPlan(context.Background()))
+	require.NoError(t, m.reportPlan(context.Background()))
+
+	cm, err := coreClient.ConfigMaps("mongodb-atlas-system").Get(context.Background(), "dry-run-plan", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, cm.Data[PlanTableKey], "AtlasProject/ns/my-project")
+
+	plan := Plan{}
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[PlanJSONKey]), &plan))
+	assert.Equal(t, m.instanceUID, plan.InstanceUID)
+	require.Len(t, plan.Requests, 1)
+	assert.Equal(t, ActionCreate, plan.Requests[0].Action)
+
+	assert.Contains(t, stdout.String(), `"action": "create"`)
+}
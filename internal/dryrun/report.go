@@ -0,0 +1,132 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlanOutput defines a destination of the structured dry-run plan.
+type PlanOutput string
+
+const (
+	// PlanOutputConfigMap writes the plan as JSON and as a table into a ConfigMap
+	// named after the dry-run Job.
+	PlanOutputConfigMap PlanOutput = "configmap"
+	// PlanOutputJSON writes the plan as JSON to stdout.
+	PlanOutputJSON PlanOutput = "json"
+	// PlanOutputTable writes the plan as a human-readable table to stdout.
+	PlanOutputTable PlanOutput = "table"
+
+	PlanJSONKey  = "plan.json"
+	PlanTableKey = "plan.txt"
+
+	planConfigMapSuffix  = "-plan"
+	defaultPlanConfigMap = "ako-dry-run"
+)
+
+// ParsePlanOutputs parses a comma separated list of plan outputs.
+func ParsePlanOutputs(value string) ([]PlanOutput, error) {
+	var outputs []PlanOutput
+	for output := range strings.SplitSeq(value, ",") {
+		output = strings.TrimSpace(output)
+		switch PlanOutput(output) {
+		case "":
+			continue
+		case PlanOutputConfigMap, PlanOutputJSON, PlanOutputTable:
+			outputs = append(outputs, PlanOutput(output))
+		default:
+			return nil, fmt.Errorf("unsupported dry-run plan output %q, expected one of: %s, %s, %s",
+				output, PlanOutputConfigMap, PlanOutputJSON, PlanOutputTable)
+		}
+	}
+	return outputs, nil
+}
+
+func (m *Manager) reportPlan(ctx context.Context) error {
+	if len(m.planOutputs) == 0 {
+		return nil
+	}
+
+	plan := currentPlan(m.instanceUID)
+	planJSON, err := plan.JSON()
+	if err != nil {
+		return err
+	}
+
+	for _, output := range m.planOutputs {
+		switch output {
+		case PlanOutputJSON:
+			if _, err := fmt.Fprintln(m.stdout, string(planJSON)); err != nil {
+				return fmt.Errorf("failed to write dry-run plan: %w", err)
+			}
+		case PlanOutputTable:
+			if _, err := fmt.Fprint(m.stdout, plan.Table()); err != nil {
+				return fmt.Errorf("failed to write dry-run plan: %w", err)
+			}
+		case PlanOutputConfigMap:
+			if err := m.writePlanConfigMap(ctx, planJSON, plan.Table()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) writePlanConfigMap(ctx context.Context, planJSON []byte, planTable string) error {
+	name := defaultPlanConfigMap
+	if jobName := os.Getenv("JOB_NAME"); jobName != "" {
+		name = jobName
+	}
+	namespace := os.Getenv("JOB_NAMESPACE")
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + planConfigMapSuffix,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				DryRunInstance: m.instanceUID,
+			},
+		},
+		Data: map[string]string{
+			PlanJSONKey:  string(planJSON),
+			PlanTableKey: planTable,
+		},
+	}
+
+	configMaps := m.coreClient.ConfigMaps(namespace)
+	_, err := configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write dry-run plan config map %s/%s: %w", namespace, cm.Name, err)
+	}
+
+	m.logger.Info("dry-run plan written", zap.String("configMap", namespace+"/"+cm.Name))
+	return nil
+}
@@ -15,7 +15,11 @@
 package dryrun
 
 import (
+	"bytes"
+	"io"
 	"net/http"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 )
 
 var verbMap = map[string]string{
@@ -48,8 +52,29 @@ func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		}
 		msg := "Would %v %v"
 
+		t.record(req)
+
 		return nil, NewDryRunError(msg, verb, req.URL.Path)
 	}
 
 	return t.Delegate.RoundTrip(req)
 }
+
+// record adds the intercepted request to the dry-run plan.
+// For updates the current state is fetched from Atlas in order to compute a diff against the request payload.
+func (t *DryRunTransport) record(req *http.Request) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+
+	diff := ""
+	if req.Method == http.MethodPut || req.Method == http.MethodPatch {
+		// best effort only: a failing GET must not prevent the request from being planned
+		diff, _ = httputil.CalculateDiff(t.Delegate, req)
+	}
+
+	recordRequest(req, body, diff)
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/crd2go/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+type ClusterExporter struct {
+	identifiers []string
+
+	client     *admin.APIClient
+	translator crapi.Translator
+}
+
+func (e *ClusterExporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	atlasClusters, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.ClusterDescription20240805], *http.Response, error) {
+		return e.client.ClustersAPI.ListClusters(ctx, e.identifiers[0]).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Clusters from Atlas: %w", err)
+	}
+
+	resources := make([]client.Object, 0, len(atlasClusters))
+	for i := range atlasClusters {
+		atlasCluster := &atlasClusters[i]
+		resource := &akov2generated.Cluster{}
+		translatedResources, err := e.translator.FromAPI(resource, atlasCluster, referencedObjects...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate Cluster: %w", err)
+		}
+
+		resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("Cluster"))
+		setIdentity(resource, atlasCluster.GetName(), atlasCluster.GetName())
+		resources = appendTranslated(resources, resource, translatedResources)
+	}
+
+	return resources, nil
+}
+
+func NewClusterExporter(client *admin.APIClient, translator crapi.Translator, identifiers []string) *ClusterExporter {
+	return &ClusterExporter{
+		client:      client,
+		identifiers: identifiers,
+		translator:  translator,
+	}
+}
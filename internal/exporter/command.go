@@ -0,0 +1,134 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/serviceaccounttoken"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/operator"
+)
+
+const (
+	publicKeyEnvVar    = "MCLI_PUBLIC_API_KEY"
+	privateKeyEnvVar   = "MCLI_PRIVATE_API_KEY"
+	clientIDEnvVar     = "MCLI_CLIENT_ID"
+	clientSecretEnvVar = "MCLI_CLIENT_SECRET"
+)
+
+type config struct {
+	Options
+	OutputDir   string
+	AtlasDomain string
+}
+
+// Run runs the export command: it exports the Atlas resources selected by the arguments
+// and writes them as a kustomize directory tree.
+// Atlas credentials are read from the MCLI_PUBLIC_API_KEY and MCLI_PRIVATE_API_KEY environment variables,
+// or MCLI_CLIENT_ID and MCLI_CLIENT_SECRET for a service account.
+func Run(ctx context.Context, fs *flag.FlagSet, args []string, out io.Writer) error {
+	cfg, err := parseConfiguration(fs, args)
+	if err != nil {
+		return fmt.Errorf("error parsing configuration: %w", err)
+	}
+
+	creds, err := credentials(ctx, cfg.AtlasDomain)
+	if err != nil {
+		return err
+	}
+	clientSet, err := atlas.NewProductionProvider(cfg.AtlasDomain, false, false).SdkClientSet(ctx, creds, zap.NewNop().Sugar())
+	if err != nil {
+		return fmt.Errorf("failed to create Atlas client: %w", err)
+	}
+
+	exportScheme := apiruntime.NewScheme()
+	utilruntime.Must(scheme.AddToScheme(exportScheme))
+	utilruntime.Must(akov2generated.AddToScheme(exportScheme))
+	translators, err := NewTranslators(exportScheme)
+	if err != nil {
+		return err
+	}
+
+	projects, err := Export(ctx, clientSet.SdkClient20250312, translators, cfg.Options)
+	if err != nil {
+		return err
+	}
+	if err := Write(cfg.OutputDir, projects, cfg.Namespace); err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		fmt.Fprintf(out, "exported project %s: %d resources\n", project.Name, len(project.Resources))
+		for _, warning := range project.Warnings {
+			fmt.Fprintf(out, "  warning: %s\n", warning)
+		}
+	}
+	return nil
+}
+
+func parseConfiguration(fs *flag.FlagSet, args []string) (*config, error) {
+	cfg := &config{}
+	fs.StringVar(&cfg.OrgID, "org", "", "the ID of the Atlas organization to export.")
+	fs.Func("project", "the ID of an Atlas project to export, can be repeated or comma separated. All projects of the organization are exported when not set.", func(value string) error {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				cfg.ProjectIDs = append(cfg.ProjectIDs, id)
+			}
+		}
+		return nil
+	})
+	fs.StringVar(&cfg.OutputDir, "output", "export", "the directory the resources are written to.")
+	fs.StringVar(&cfg.Namespace, "namespace", "", "the namespace set on the exported resources.")
+	fs.StringVar(&cfg.ConnectionSecret, "connection-secret", "", "the name of the Secret with Atlas credentials referenced by the exported resources. The operator global secret is used when not set.")
+	fs.StringVar(&cfg.AtlasDomain, "atlas-domain", operator.DefaultAtlasDomain, "the Atlas URL domain name (with slash in the end).")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if cfg.OrgID == "" && len(cfg.ProjectIDs) == 0 {
+		return nil, errors.New("either --org or --project must be set")
+	}
+	return cfg, nil
+}
+
+func credentials(ctx context.Context, atlasDomain string) (*atlas.Credentials, error) {
+	if clientID, clientSecret := os.Getenv(clientIDEnvVar), os.Getenv(clientSecretEnvVar); clientID != "" && clientSecret != "" {
+		token, _, err := serviceaccounttoken.NewAtlasTokenProvider(atlasDomain).FetchToken(ctx, clientID, clientSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch service account token: %w", err)
+		}
+		return &atlas.Credentials{ServiceAccount: &atlas.ServiceAccountToken{ClientID: clientID, BearerToken: token}}, nil
+	}
+
+	publicKey, privateKey := os.Getenv(publicKeyEnvVar), os.Getenv(privateKeyEnvVar)
+	if publicKey == "" || privateKey == "" {
+		return nil, fmt.Errorf("missing Atlas credentials: set %s and %s, or %s and %s",
+			publicKeyEnvVar, privateKeyEnvVar, clientIDEnvVar, clientSecretEnvVar)
+	}
+	return &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: publicKey, PrivateKey: privateKey}}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/crd2go/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+type DatabaseUserExporter struct {
+	identifiers []string
+
+	client     *admin.APIClient
+	translator crapi.Translator
+}
+
+func (e *DatabaseUserExporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	atlasDatabaseUsers, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.CloudDatabaseUser], *http.Response, error) {
+		return e.client.DatabaseUsersAPI.ListDatabaseUsers(ctx, e.identifiers[0]).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DatabaseUsers from Atlas: %w", err)
+	}
+
+	resources := make([]client.Object, 0, len(atlasDatabaseUsers))
+	for i := range atlasDatabaseUsers {
+		atlasDatabaseUser := &atlasDatabaseUsers[i]
+		resource := &akov2generated.DatabaseUser{}
+		translatedResources, err := e.translator.FromAPI(resource, atlasDatabaseUser, referencedObjects...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate DatabaseUser: %w", err)
+		}
+
+		resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("DatabaseUser"))
+		setIdentity(resource, databaseUserName(atlasDatabaseUser.DatabaseName, atlasDatabaseUser.Username), atlasDatabaseUser.DatabaseName+":"+atlasDatabaseUser.Username)
+		resources = appendTranslated(resources, resource, translatedResources)
+	}
+
+	return resources, nil
+}
+
+// databaseUserName includes the authentication database, users with the same name may exist in several of them,
+// e.g. in admin and in $external.
+func databaseUserName(databaseName, username string) string {
+	return databaseName + "-" + username
+}
+
+func NewDatabaseUserExporter(client *admin.APIClient, translator crapi.Translator, identifiers []string) *DatabaseUserExporter {
+	return &DatabaseUserExporter{
+		client:      client,
+		identifiers: identifiers,
+		translator:  translator,
+	}
+}
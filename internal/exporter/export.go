@@ -0,0 +1,276 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/crd2go/crapi"
+	"github.com/crd2go/crd2go/k8s"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/crds"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+const (
+	crdVersion = "v1"
+	sdkVersion = "v20250312"
+
+	// noAuthType is the value of the authentication type fields of database users authenticating with a password.
+	noAuthType = "NONE"
+)
+
+// Kinds are the kinds of the generated API the exporter supports, in export order.
+var Kinds = []string{"Group", "Cluster", "FlexCluster", "DatabaseUser", "IPAccessListEntry"}
+
+// Options configure which Atlas resources are exported and how the custom resources are rendered.
+type Options struct {
+	// OrgID selects the organization to export. All its projects are exported unless ProjectIDs is set.
+	OrgID string
+	// ProjectIDs restricts the export to the given projects.
+	ProjectIDs []string
+	// Namespace is set on all exported resources when not empty.
+	Namespace string
+	// ConnectionSecret is the name of the Secret holding the Atlas credentials
+	// the exported resources reference, the global operator secret is used when empty.
+	ConnectionSecret string
+}
+
+// Project is the set of custom resources exported from a single Atlas project.
+type Project struct {
+	// Name is the name of the exported Group, used as directory name.
+	Name      string
+	Resources []client.Object
+	// Warnings lists manual steps required before applying the resources.
+	Warnings []string
+}
+
+// NewTranslators returns the translators for all exported kinds, indexed by kind.
+func NewTranslators(scheme *runtime.Scheme) (map[string]crapi.Translator, error) {
+	translators := make(map[string]crapi.Translator, len(Kinds))
+	for _, kind := range Kinds {
+		crd, err := crds.EmbeddedCRD(kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CRD for %s: %w", kind, err)
+		}
+		perVersion, err := crapi.NewPerVersionTranslators(scheme, crd, crdVersion, sdkVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get translator set for %s: %w", kind, err)
+		}
+		translator, ok := perVersion[sdkVersion]
+		if !ok {
+			return nil, fmt.Errorf("no translator for %s in version %s", kind, sdkVersion)
+		}
+		translators[kind] = translator
+	}
+	return translators, nil
+}
+
+// Export exports the projects of an organization with all their supported resources.
+func Export(ctx context.Context, atlasClient *admin.APIClient, translators map[string]crapi.Translator, opts Options) ([]Project, error) {
+	projectIDs := opts.ProjectIDs
+	if len(projectIDs) == 0 {
+		ids, err := listOrgProjectIDs(ctx, atlasClient, opts.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		projectIDs = ids
+	}
+
+	projects := make([]Project, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		project, err := exportProject(ctx, atlasClient, translators, projectID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export project %s: %w", projectID, err)
+		}
+		projects = append(projects, *project)
+	}
+	return projects, nil
+}
+
+func listOrgProjectIDs(ctx context.Context, atlasClient *admin.APIClient, orgID string) ([]string, error) {
+	groups, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.Group], *http.Response, error) {
+		return atlasClient.ProjectsAPI.ListGroups(ctx).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects from Atlas: %w", err)
+	}
+
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		if group.OrgId == orgID {
+			ids = append(ids, group.GetId())
+		}
+	}
+	return ids, nil
+}
+
+func exportProject(ctx context.Context, atlasClient *admin.APIClient, translators map[string]crapi.Translator, projectID string, opts Options) (*Project, error) {
+	identifiers := []string{projectID}
+
+	groupResources, err := NewGroupExporter(atlasClient, translators["Group"], identifiers).Export(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	group := findGroup(groupResources)
+	if group == nil {
+		return nil, errors.New("translation did not produce a Group")
+	}
+	if opts.OrgID != "" && group.Spec.V20250312 != nil && group.Spec.V20250312.Entry != nil &&
+		group.Spec.V20250312.Entry.OrgId != opts.OrgID {
+		return nil, fmt.Errorf("project belongs to organization %s, not %s", group.Spec.V20250312.Entry.OrgId, opts.OrgID)
+	}
+
+	exporters := []Exporter{
+		NewClusterExporter(atlasClient, translators["Cluster"], identifiers),
+		NewFlexClusterExporter(atlasClient, translators["FlexCluster"], identifiers),
+		NewDatabaseUserExporter(atlasClient, translators["DatabaseUser"], identifiers),
+		NewIPAccessListEntryExporter(atlasClient, translators["IPAccessListEntry"], identifiers),
+	}
+	resources := groupResources
+	for _, exporter := range exporters {
+		exported, err := exporter.Export(ctx, groupResources)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, exported...)
+	}
+	if err := setGroupVersionKinds(translators["Group"].Scheme(), resources); err != nil {
+		return nil, err
+	}
+
+	return newProject(group, resources, opts), nil
+}
+
+// setGroupVersionKinds sets the kind of objects created by the translators, such as Secrets,
+// which is needed to render them as manifests.
+func setGroupVersionKinds(scheme *runtime.Scheme, resources []client.Object) error {
+	for _, obj := range resources {
+		if !obj.GetObjectKind().GroupVersionKind().Empty() {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return fmt.Errorf("failed to get kind of %T %s: %w", obj, obj.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	return nil
+}
+
+func findGroup(resources []client.Object) *akov2generated.Group {
+	for _, obj := range resources {
+		if group, ok := obj.(*akov2generated.Group); ok {
+			return group
+		}
+	}
+	return nil
+}
+
+// newProject turns the translated resources into a set of resources that can be applied as they are:
+// the Atlas project ID is replaced by a reference to the exported Group, resource names are prefixed
+// with the Group name to keep them unique across projects, and the resources are set to be kept in Atlas
+// when deleted from Kubernetes.
+func newProject(group *akov2generated.Group, resources []client.Object, opts Options) *Project {
+	project := &Project{Name: group.GetName(), Resources: resources}
+	groupRef := &k8s.LocalReference{Name: group.GetName()}
+
+	for _, obj := range resources {
+		if opts.Namespace != "" {
+			obj.SetNamespace(opts.Namespace)
+		}
+
+		switch resource := obj.(type) {
+		case *akov2generated.Group:
+			setConnectionSecretRef(&resource.Spec.ConnectionSecretRef, opts.ConnectionSecret)
+		case *akov2generated.Cluster:
+			resource.SetName(prefixed(group, resource))
+			setConnectionSecretRef(&resource.Spec.ConnectionSecretRef, opts.ConnectionSecret)
+			if resource.Spec.V20250312 != nil {
+				resource.Spec.V20250312.GroupId = nil
+				resource.Spec.V20250312.GroupRef = groupRef
+			}
+		case *akov2generated.FlexCluster:
+			resource.SetName(prefixed(group, resource))
+			setConnectionSecretRef(&resource.Spec.ConnectionSecretRef, opts.ConnectionSecret)
+			if resource.Spec.V20250312 != nil {
+				resource.Spec.V20250312.GroupId = nil
+				resource.Spec.V20250312.GroupRef = groupRef
+			}
+		case *akov2generated.DatabaseUser:
+			resource.SetName(prefixed(group, resource))
+			setConnectionSecretRef(&resource.Spec.ConnectionSecretRef, opts.ConnectionSecret)
+			if resource.Spec.V20250312 != nil {
+				resource.Spec.V20250312.GroupId = nil
+				resource.Spec.V20250312.GroupRef = groupRef
+				if warning := passwordWarning(resource); warning != "" {
+					project.Warnings = append(project.Warnings, warning)
+				}
+			}
+		case *akov2generated.IPAccessListEntry:
+			resource.SetName(prefixed(group, resource))
+			setConnectionSecretRef(&resource.Spec.ConnectionSecretRef, opts.ConnectionSecret)
+			if resource.Spec.V20250312 != nil {
+				resource.Spec.V20250312.GroupId = nil
+				resource.Spec.V20250312.GroupRef = groupRef
+			}
+		default:
+			continue
+		}
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[customresource.ResourcePolicyAnnotation] = customresource.ResourcePolicyKeep
+		obj.SetAnnotations(annotations)
+	}
+
+	return project
+}
+
+func prefixed(group *akov2generated.Group, obj client.Object) string {
+	return fmt.Sprintf("%s-%s", group.GetName(), obj.GetName())
+}
+
+func setConnectionSecretRef(ref **k8s.LocalReference, name string) {
+	if name != "" {
+		*ref = &k8s.LocalReference{Name: name}
+	}
+}
+
+// passwordWarning returns a warning for database users authenticating with a password,
+// as Atlas never returns the password and the Secret holding it must be created manually.
+func passwordWarning(user *akov2generated.DatabaseUser) string {
+	entry := user.Spec.V20250312.Entry
+	if entry == nil || entry.PasswordSecretRef != nil {
+		return ""
+	}
+	for _, authType := range []*string{entry.X509Type, entry.AwsIAMType, entry.OidcAuthType, entry.LdapAuthType} {
+		if authType != nil && *authType != "" && *authType != noAuthType {
+			return ""
+		}
+	}
+	return fmt.Sprintf("DatabaseUser %q authenticates with a password: create a Secret with the password and set spec.v20250312.entry.passwordSecretRef", user.GetName())
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crd2go/crd2go/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+)
+
+func TestNewProject(t *testing.T) {
+	group := &akov2generated.Group{}
+	setIdentity(group, "My Project", "6523a8b5c4e2f8a1b3d9e7f0")
+	cluster := &akov2generated.Cluster{
+		Spec: akov2generated.ClusterSpec{
+			V20250312: &akov2generated.ClusterSpecV20250312{GroupId: ptr.To("6523a8b5c4e2f8a1b3d9e7f0")},
+		},
+	}
+	setIdentity(cluster, "Cluster0", "Cluster0")
+	scramUser := &akov2generated.DatabaseUser{
+		Spec: akov2generated.DatabaseUserSpec{
+			V20250312: &akov2generated.DatabaseUserSpecV20250312{
+				Entry: &akov2generated.DatabaseUserSpecV20250312Entry{DatabaseName: "admin", Username: "app", X509Type: ptr.To("NONE")},
+			},
+		},
+	}
+	setIdentity(scramUser, databaseUserName("admin", "app"), "admin:app")
+	x509User := &akov2generated.DatabaseUser{
+		Spec: akov2generated.DatabaseUserSpec{
+			V20250312: &akov2generated.DatabaseUserSpecV20250312{
+				Entry: &akov2generated.DatabaseUserSpecV20250312Entry{DatabaseName: "$external", Username: "CN=app", X509Type: ptr.To("MANAGED")},
+			},
+		},
+	}
+	setIdentity(x509User, databaseUserName("$external", "CN=app"), "$external:CN=app")
+
+	project := newProject(group, []client.Object{group, cluster, scramUser, x509User}, Options{
+		Namespace:        "atlas",
+		ConnectionSecret: "atlas-credentials",
+	})
+
+	assert.Equal(t, "my-project", project.Name)
+	assert.Equal(t, "my-project-cluster0", cluster.GetName())
+	assert.Equal(t, "my-project-admin-app", scramUser.GetName())
+	assert.Equal(t, "my-project-external-cn-app", x509User.GetName())
+	assert.Nil(t, cluster.Spec.V20250312.GroupId)
+	assert.Equal(t, &k8s.LocalReference{Name: "my-project"}, cluster.Spec.V20250312.GroupRef)
+	assert.Equal(t, &k8s.LocalReference{Name: "my-project"}, scramUser.Spec.V20250312.GroupRef)
+	for _, obj := range project.Resources {
+		assert.Equal(t, "atlas", obj.GetNamespace())
+		assert.Equal(t, customresource.ResourcePolicyKeep, obj.GetAnnotations()[customresource.ResourcePolicyAnnotation])
+	}
+	assert.Equal(t, &k8s.LocalReference{Name: "atlas-credentials"}, group.Spec.ConnectionSecretRef)
+	assert.Equal(t, "Cluster0", cluster.GetAnnotations()[ExternalIDAnnotation])
+	assert.Equal(t, "admin:app", scramUser.GetAnnotations()[ExternalIDAnnotation])
+	require.Len(t, project.Warnings, 1)
+	assert.Contains(t, project.Warnings[0], "my-project-admin-app")
+}
+
+func TestEntryValue(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		entry admin.NetworkPermissionEntry
+		want  string
+	}{
+		{
+			title: "IP address",
+			entry: admin.NetworkPermissionEntry{IpAddress: ptr.To("10.0.0.1"), CidrBlock: ptr.To("10.0.0.1/32")},
+			want:  "10.0.0.1",
+		},
+		{
+			title: "CIDR block",
+			entry: admin.NetworkPermissionEntry{CidrBlock: ptr.To("10.0.0.0/24")},
+			want:  "10.0.0.0/24",
+		},
+		{
+			title: "AWS security group",
+			entry: admin.NetworkPermissionEntry{AwsSecurityGroup: ptr.To("sg-12345")},
+			want:  "sg-12345",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.want, entryValue(&tc.entry))
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	group := &akov2generated.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-project",
+			ResourceVersion: "12",
+			UID:             "uid",
+			Annotations:     map[string]string{ExternalIDAnnotation: "6523a8b5c4e2f8a1b3d9e7f0"},
+		},
+		Status: akov2generated.GroupStatus{V20250312: &akov2generated.GroupStatusV20250312{Id: ptr.To("6523a8b5c4e2f8a1b3d9e7f0")}},
+	}
+	group.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("Group"))
+	dir := t.TempDir()
+
+	require.NoError(t, Write(dir, []Project{{Name: "my-project", Resources: []client.Object{group}}}, "atlas"))
+
+	content, err := os.ReadFile(filepath.Join(dir, "my-project", "group-my-project.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "kind: Group")
+	assert.Contains(t, string(content), "mongodb.com/external-id: 6523a8b5c4e2f8a1b3d9e7f0")
+	assert.NotContains(t, string(content), "status")
+	assert.NotContains(t, string(content), "resourceVersion")
+	assert.NotContains(t, string(content), "uid")
+
+	kustomization, err := os.ReadFile(filepath.Join(dir, "my-project", kustomizationFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(kustomization), "- group-my-project.yaml")
+
+	root, err := os.ReadFile(filepath.Join(dir, kustomizationFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(root), "namespace: atlas")
+	assert.Contains(t, string(root), "- my-project")
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exporter reads existing Atlas resources and translates them into custom resources
+// of the generated API, annotated so that the operator imports them instead of creating them.
+package exporter
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+)
+
+const (
+	// ExternalIDAnnotation holds the Atlas identifier of an existing resource to be imported.
+	ExternalIDAnnotation = "mongodb.com/external-id"
+)
+
+// Exporter lists Atlas resources and translates them into custom resources.
+// The referenced objects are passed to the translator to resolve references between the resources.
+type Exporter interface {
+	Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error)
+}
+
+// setIdentity names the exported resource after its Atlas name
+// and sets the external ID the importing handler resolves the Atlas resource with.
+func setIdentity(obj client.Object, name, externalID string) {
+	obj.SetName(kube.NormalizeIdentifier(name))
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ExternalIDAnnotation] = externalID
+	obj.SetAnnotations(annotations)
+}
+
+// appendTranslated appends the objects created by the translator next to the resource,
+// e.g. Secrets holding sensitive fields, skipping the resource itself.
+func appendTranslated(resources []client.Object, resource client.Object, translated []client.Object) []client.Object {
+	resources = append(resources, resource)
+	for _, obj := range translated {
+		if obj != resource {
+			resources = append(resources, obj)
+		}
+	}
+	return resources
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crd2go/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+)
+
+type GroupExporter struct {
+	identifiers []string
+
+	client     *admin.APIClient
+	translator crapi.Translator
+}
+
+func (e *GroupExporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	atlasGroup, _, err := e.client.ProjectsAPI.GetGroup(ctx, e.identifiers[0]).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Group from Atlas: %w", err)
+	}
+
+	resource := &akov2generated.Group{}
+	translatedResources, err := e.translator.FromAPI(resource, atlasGroup, referencedObjects...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate Group: %w", err)
+	}
+
+	resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("Group"))
+	setIdentity(resource, atlasGroup.GetName(), atlasGroup.GetId())
+
+	return appendTranslated(nil, resource, translatedResources), nil
+}
+
+func NewGroupExporter(client *admin.APIClient, translator crapi.Translator, identifiers []string) *GroupExporter {
+	return &GroupExporter{
+		client:      client,
+		identifiers: identifiers,
+		translator:  translator,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/crd2go/crapi"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+type IPAccessListEntryExporter struct {
+	identifiers []string
+
+	client     *admin.APIClient
+	translator crapi.Translator
+}
+
+func (e *IPAccessListEntryExporter) Export(ctx context.Context, referencedObjects []client.Object) ([]client.Object, error) {
+	atlasEntries, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.NetworkPermissionEntry], *http.Response, error) {
+		return e.client.ProjectIPAccessListAPI.ListAccessListEntries(ctx, e.identifiers[0]).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP access list entries from Atlas: %w", err)
+	}
+
+	resources := make([]client.Object, 0, len(atlasEntries))
+	for i := range atlasEntries {
+		atlasEntry := &atlasEntries[i]
+		resource := &akov2generated.IPAccessListEntry{}
+		translatedResources, err := e.translator.FromAPI(resource, atlasEntry, referencedObjects...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate IPAccessListEntry: %w", err)
+		}
+
+		resource.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind("IPAccessListEntry"))
+		setIdentity(resource, entryValue(atlasEntry), entryValue(atlasEntry))
+		resources = appendTranslated(resources, resource, translatedResources)
+	}
+
+	return resources, nil
+}
+
+func NewIPAccessListEntryExporter(client *admin.APIClient, translator crapi.Translator, identifiers []string) *IPAccessListEntryExporter {
+	return &IPAccessListEntryExporter{
+		client:      client,
+		identifiers: identifiers,
+		translator:  translator,
+	}
+}
+
+// entryValue returns the value identifying the entry in Atlas:
+// the IP address, the CIDR block or the AWS security group.
+func entryValue(entry *admin.NetworkPermissionEntry) string {
+	switch {
+	case entry.GetAwsSecurityGroup() != "":
+		return entry.GetAwsSecurityGroup()
+	case entry.GetIpAddress() != "":
+		return entry.GetIpAddress()
+	default:
+		return entry.GetCidrBlock()
+	}
+}
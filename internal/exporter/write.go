@@ -0,0 +1,109 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const kustomizationFile = "kustomization.yaml"
+
+// readOnlyMetadataFields are set by the API server and must not be part of applied manifests.
+var readOnlyMetadataFields = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "uid"}
+
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Namespace  string   `json:"namespace,omitempty"`
+	Resources  []string `json:"resources"`
+}
+
+// Write renders the exported projects as a kustomize directory tree:
+// one directory per project with a file per resource, and a top-level kustomization including all projects.
+func Write(dir string, projects []Project, namespace string) error {
+	projectDirs := make([]string, 0, len(projects))
+	for _, project := range projects {
+		projectDir := filepath.Join(dir, project.Name)
+		if err := os.MkdirAll(projectDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", projectDir, err)
+		}
+
+		files := make([]string, 0, len(project.Resources))
+		for _, obj := range project.Resources {
+			content, err := Marshal(obj)
+			if err != nil {
+				return err
+			}
+			file := fileName(obj)
+			if err := os.WriteFile(filepath.Join(projectDir, file), content, 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", file, err)
+			}
+			files = append(files, file)
+		}
+		if err := writeKustomization(projectDir, kustomization{Resources: files}); err != nil {
+			return err
+		}
+		projectDirs = append(projectDirs, project.Name)
+	}
+
+	return writeKustomization(dir, kustomization{Namespace: namespace, Resources: projectDirs})
+}
+
+// Marshal renders the object as YAML without its status and server-populated metadata.
+func Marshal(obj client.Object) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+
+	delete(content, "status")
+	if metadata, ok := content["metadata"].(map[string]any); ok {
+		for _, field := range readOnlyMetadataFields {
+			delete(metadata, field)
+		}
+	}
+
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+	return data, nil
+}
+
+func fileName(obj client.Object) string {
+	kind := strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind)
+	return fmt.Sprintf("%s-%s.yaml", kind, obj.GetName())
+}
+
+func writeKustomization(dir string, k kustomization) error {
+	k.APIVersion = "kustomize.config.k8s.io/v1beta1"
+	k.Kind = "Kustomization"
+	data, err := yaml.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+	path := filepath.Join(dir, kustomizationFile)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
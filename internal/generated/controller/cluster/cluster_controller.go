@@ -21,6 +21,7 @@ import (
 	crapi "github.com/crd2go/crapi"
 	v20250312sdk "go.mongodb.org/atlas-sdk/v20250312023/admin"
 	zap "go.uber.org/zap"
+	record "k8s.io/client-go/tools/record"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 	cluster "sigs.k8s.io/controller-runtime/pkg/cluster"
 	predicate "sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -88,13 +89,17 @@ func NewClusterReconciler(
 			Log:             logger.Named("controllers").Named("AtlasCluster").Sugar(),
 		},
 		deletionProtection: deletionProtection,
-		handlerv20250312:   handlerv20250312Func,
+		handlerv20250312:   handlerv20250312Func(c.GetEventRecorderFor("Cluster")),
 		predicates:         predicates,
 		translators:        translators,
 	}
 
 	return ctrlstate.NewStateReconciler(clusterHandler, ctrlstate.WithCluster[akov2generated.Cluster](c), ctrlstate.WithReapplySupport[akov2generated.Cluster](reapplySupport)), nil
 }
-func handlerv20250312Func(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) ctrlstate.StateHandler[akov2generated.Cluster] {
-	return NewHandlerv20250312(kubeClient, atlasClient, translator, deletionProtection)
+func handlerv20250312Func(eventRecorder record.EventRecorder) handler.VersionedHandlerFunc[v20250312sdk.APIClient, akov2generated.Cluster] {
+	return func(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) ctrlstate.StateHandler[akov2generated.Cluster] {
+		versionedHandler := NewHandlerv20250312(kubeClient, atlasClient, translator, deletionProtection)
+		versionedHandler.eventRecorder = eventRecorder
+		return versionedHandler
+	}
 }
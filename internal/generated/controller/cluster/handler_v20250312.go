@@ -22,6 +22,8 @@ import (
 	state "github.com/crd2go/constate/state"
 	crapi "github.com/crd2go/crapi"
 	v20250312sdk "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	corev1 "k8s.io/api/core/v1"
+	record "k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	builder "sigs.k8s.io/controller-runtime/pkg/builder"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +32,9 @@ import (
 
 	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/drift"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
 	result "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 )
 
@@ -47,6 +52,8 @@ type Handlerv20250312 struct {
 	atlasClient        *v20250312sdk.APIClient
 	translator         crapi.Translator
 	deletionProtection bool
+	// eventRecorder reports drift with the detect-only reconciliation policy, it is optional
+	eventRecorder record.EventRecorder
 }
 
 func NewHandlerv20250312(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) *Handlerv20250312 {
@@ -60,6 +67,14 @@ func NewHandlerv20250312(kubeClient client.Client, atlasClient *v20250312sdk.API
 
 // HandleInitial handles the initial state for version v20250312
 func (h *Handlerv20250312) HandleInitial(ctx context.Context, cluster *akov2generated.Cluster) (ctrlstate.Result, error) {
+	if customresource.ReconciliationIsDetectOnly(cluster) {
+		return result.NextState(state.StateInitial, "Cluster is not created in Atlas with the detect-only reconciliation policy.")
+	}
+
+	if err := policy.CheckCluster(ctx, h.kubeClient, cluster); err != nil {
+		return result.Error(state.StateInitial, err)
+	}
+
 	deps, err := h.getDependencies(ctx, cluster)
 	if err != nil {
 		return result.Error(state.StateInitial, fmt.Errorf("failed to resolve Cluster dependencies: %w", err))
@@ -196,7 +211,7 @@ func (h *Handlerv20250312) HandleUpdated(ctx context.Context, cluster *akov2gene
 
 // HandleDeletionRequested handles the deletionrequested state for version v20250312
 func (h *Handlerv20250312) HandleDeletionRequested(ctx context.Context, cluster *akov2generated.Cluster) (ctrlstate.Result, error) {
-	if customresource.IsResourcePolicyKeepOrDefault(cluster, h.deletionProtection) {
+	if customresource.IsResourcePolicyKeepOrDefault(cluster, h.deletionProtection) || customresource.ReconciliationIsDetectOnly(cluster) {
 		return result.NextState(state.StateDeleted, "Cluster deleted.")
 	}
 
@@ -262,6 +277,13 @@ func (h *Handlerv20250312) handleUpserted(ctx context.Context, currentState stat
 		return result.Error(currentState, fmt.Errorf("failed to resolve Cluster dependencies: %w", err))
 	}
 
+	if customresource.ReconciliationIsDetectOnly(cluster) {
+		return h.detectDrift(ctx, currentState, cluster, deps...)
+	}
+	if err := h.clearDrift(ctx, cluster); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	update, err := ctrlstate.ShouldUpdate(cluster, deps...)
 	if err != nil {
 		return result.Error(currentState, reconcile.TerminalError(err))
@@ -271,6 +293,10 @@ func (h *Handlerv20250312) handleUpserted(ctx context.Context, currentState stat
 		return result.NextState(currentState, "Cluster is up to date. No update required.")
 	}
 
+	if err := policy.CheckCluster(ctx, h.kubeClient, cluster); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	body := &v20250312sdk.ClusterDescription20240805{}
 	params := &v20250312sdk.UpdateClusterApiParams{
 		ClusterName:                        *cluster.Spec.V20250312.Entry.Name,
@@ -316,6 +342,64 @@ func (h *Handlerv20250312) SetupWithManager(_ controllerruntime.Manager, _ recon
 	return nil
 }
 
+// detectDrift compares the Cluster in Atlas with the spec and reports the differences
+// in the Drifted condition without updating Atlas.
+func (h *Handlerv20250312) detectDrift(ctx context.Context, currentState state.ResourceState, cluster *akov2generated.Cluster, deps ...client.Object) (ctrlstate.Result, error) {
+	params := &v20250312sdk.GetClusterApiParams{
+		ClusterName:                        *cluster.Spec.V20250312.Entry.Name,
+		UseEffectiveInstanceFields:         new(true),
+		UseEffectiveFieldsReplicationSpecs: new(true),
+	}
+	if err := h.translator.ToAPI(params, cluster, deps...); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate cluster API parameters to Atlas: %w", err))
+	}
+
+	atlasCluster, _, err := h.atlasClient.ClustersAPI.GetClusterWithParams(ctx, params).Execute()
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to get Cluster with name %s: %w", params.ClusterName, err))
+	}
+
+	observed := &akov2generated.Cluster{}
+	if _, err := h.translator.FromAPI(observed, atlasCluster, deps...); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate Cluster from Atlas: %w", err))
+	}
+	report, err := drift.Compute(cluster.Spec.V20250312.Entry, observed.Spec.V20250312.Entry)
+	if err != nil {
+		return result.Error(currentState, reconcile.TerminalError(err))
+	}
+	metrics.ObserveDrift("Cluster", client.ObjectKeyFromObject(cluster), len(report.Operations))
+
+	clusterCopy := cluster.DeepCopy()
+	if _, err := h.translator.FromAPI(clusterCopy, atlasCluster); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate Cluster from Atlas: %w", err))
+	}
+	changed := drift.SetCondition(&clusterCopy.Status.Conditions, cluster.GetGeneration(), report)
+	if err := ctrlstate.NewPatcher(clusterCopy).UpdateStatus().Patch(ctx, h.kubeClient); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to patch Cluster status: %w", err))
+	}
+
+	if report.Drifted() {
+		if changed && h.eventRecorder != nil {
+			h.eventRecorder.Event(cluster, corev1.EventTypeWarning, drift.ReasonDrifted, report.Summary())
+		}
+		return result.NextState(currentState, "Cluster drifted from Atlas. No update performed with the detect-only reconciliation policy.")
+	}
+	return result.NextState(currentState, "Cluster is up to date. No update required.")
+}
+
+// clearDrift removes the Drifted condition once the detect-only policy is removed.
+func (h *Handlerv20250312) clearDrift(ctx context.Context, cluster *akov2generated.Cluster) error {
+	clusterCopy := cluster.DeepCopy()
+	if !drift.RemoveCondition(clusterCopy.Status.Conditions) {
+		return nil
+	}
+	metrics.ObserveDrift("Cluster", client.ObjectKeyFromObject(cluster), 0)
+	if err := ctrlstate.NewPatcher(clusterCopy).UpdateStatus().Patch(ctx, h.kubeClient); err != nil {
+		return fmt.Errorf("failed to patch Cluster status: %w", err)
+	}
+	return nil
+}
+
 func (h *Handlerv20250312) patchStatus(ctx context.Context, cluster *akov2generated.Cluster, atlasCluster *v20250312sdk.ClusterDescription20240805, deps ...client.Object) error {
 	clusterCopy := cluster.DeepCopy()
 	_, err := h.translator.FromAPI(clusterCopy, atlasCluster)
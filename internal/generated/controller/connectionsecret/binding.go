@@ -0,0 +1,91 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionsecret
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/crd2go/crd2go/k8s"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/cluster"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/target"
+)
+
+// userBinding returns the connection Secret exposed as the Service Binding of the user. The user is a
+// Provisioned Service only when it has a single connection Secret, there is no way to choose between several.
+func userBinding(secrets []string, pending bool) *k8s.LocalReference {
+	if pending || len(secrets) != 1 {
+		return nil
+	}
+	return &k8s.LocalReference{Name: secrets[0]}
+}
+
+func (r *ConnectionSecretReconciler) updateUserBinding(ctx context.Context, user *generatedv1.DatabaseUser, binding *k8s.LocalReference) error {
+	if reflect.DeepEqual(user.Status.Binding, binding) {
+		return nil
+	}
+	patch := client.MergeFrom(user.DeepCopy())
+	user.Status.Binding = binding
+	if err := r.Client.Status().Patch(ctx, user, patch); err != nil {
+		return fmt.Errorf("failed to update the binding of the database user: %w", err)
+	}
+	return nil
+}
+
+// updateClusterBindings exposes the connection Secret of the clusters of the project as their Service Binding.
+// A cluster is a Provisioned Service only when a single database user of its namespace connects to it.
+func (r *ConnectionSecretReconciler) updateClusterBindings(ctx context.Context, projectID string, connectionTargets []target.ConnectionTargetInstance) error {
+	for _, connectionTarget := range connectionTargets {
+		instance, ok := connectionTarget.(*cluster.ClusterInstance_v20250312)
+		if !ok {
+			continue
+		}
+
+		secrets := &corev1.SecretList{}
+		if err := r.Client.List(ctx, secrets, client.InNamespace(instance.Cluster.Namespace), client.MatchingLabels{
+			TypeLabelKey:    CredLabelVal,
+			ProjectLabelKey: projectID,
+			TargetLabelKey:  instance.GetName(),
+		}); err != nil {
+			return fmt.Errorf("failed to list the connection secrets of cluster %s: %w", instance.GetName(), err)
+		}
+
+		var names []string
+		for _, secret := range secrets.Items {
+			if secret.Annotations[ConnectionTypelKey] == instance.GetConnectionTargetType() {
+				names = append(names, secret.Name)
+			}
+		}
+
+		var binding *k8s.LocalReference
+		if len(names) == 1 {
+			binding = &k8s.LocalReference{Name: names[0]}
+		}
+		if reflect.DeepEqual(instance.Cluster.Status.Binding, binding) {
+			continue
+		}
+		patch := client.MergeFrom(instance.Cluster.DeepCopy())
+		instance.Cluster.Status.Binding = binding
+		if err := r.Client.Status().Patch(ctx, instance.Cluster, patch); err != nil {
+			return fmt.Errorf("failed to update the binding of cluster %s: %w", instance.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/cluster"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/target"
+)
+
+func TestUpdateClusterBindings(t *testing.T) {
+	const projectID = "62b6e34b3d91647abb20e7b8"
+
+	connectionSecret := func(name, user string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels: map[string]string{
+					TypeLabelKey:         CredLabelVal,
+					ProjectLabelKey:      projectID,
+					TargetLabelKey:       "cluster0",
+					DatabaseUserLabelKey: user,
+				},
+				Annotations: map[string]string{ConnectionTypelKey: "cluster"},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name        string
+		secrets     []client.Object
+		wantBinding string
+	}{
+		{
+			name: "no connection secret",
+		},
+		{
+			name:        "single connection secret",
+			secrets:     []client.Object{connectionSecret("project-cluster0-app", "app")},
+			wantBinding: "project-cluster0-app",
+		},
+		{
+			name: "several connection secrets",
+			secrets: []client.Object{
+				connectionSecret("project-cluster0-app", "app"),
+				connectionSecret("project-cluster0-admin", "admin"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, generatedv1.AddToScheme(scheme))
+
+			clusterObj := &generatedv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "ns"},
+				Spec: generatedv1.ClusterSpec{
+					V20250312: &generatedv1.ClusterSpecV20250312{
+						Entry: &generatedv1.V20250312Entry{Name: new("cluster0")},
+					},
+				},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.secrets, clusterObj)...).
+				WithStatusSubresource(clusterObj).
+				Build()
+			r := &ConnectionSecretReconciler{Client: fakeClient, Scheme: scheme}
+
+			targets := []target.ConnectionTargetInstance{&cluster.ClusterInstance_v20250312{Cluster: clusterObj}}
+			require.NoError(t, r.updateClusterBindings(context.Background(), projectID, targets))
+
+			got := &generatedv1.Cluster{}
+			require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(clusterObj), got))
+			if tc.wantBinding == "" {
+				assert.Nil(t, got.Status.Binding)
+				return
+			}
+			require.NotNil(t, got.Status.Binding)
+			assert.Equal(t, tc.wantBinding, got.Status.Binding.Name)
+		})
+	}
+}
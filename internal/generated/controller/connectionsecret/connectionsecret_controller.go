@@ -41,9 +41,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/cluster"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/data"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/flexcluster"
@@ -51,6 +53,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/target"
 	generatedindexer "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/indexers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/servicebinding"
 	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
@@ -120,7 +123,10 @@ func NewConnectionSecretReconciler(c ctrlcluster.Cluster, predicates []predicate
 
 func (r *ConnectionSecretReconciler) For() (client.Object, builder.Predicates) {
 	return &generatedv1.DatabaseUser{}, builder.WithPredicates(
-		predicate.GenerationChangedPredicate{},
+		predicate.Or[client.Object](
+			predicate.GenerationChangedPredicate{},
+			watch.ConnectionSecretTemplateAnnotationChangedPredicate[client.Object](),
+		),
 		mckpredicate.IgnoreDeletedPredicate[client.Object](),
 	)
 }
@@ -145,6 +151,11 @@ func (r *ConnectionSecretReconciler) SetupWithManager(mgr ctrl.Manager, skipName
 			handler.EnqueueRequestsFromMapFunc(generatedindexer.NewDatabaseUserBySecretMapFunc(r.Client)),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
+		Watches(
+			&akov2.AtlasConnectionSecretTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.connectionSecretTemplateMapFunc),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		WithOptions(controller.TypedOptions[reconcile.Request]{
 			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
 			SkipNameValidation: new(skipNameValidation),
@@ -352,14 +363,14 @@ func (r *ConnectionSecretReconciler) getSDKClientSet(ctx context.Context, databa
 	return clientSet, nil
 }
 
-// ensureSecret creates or updates the Secret for the given identifiers and connection data
+// ensureSecret creates or updates the Secret for the given identifiers and connection data and returns its name
 func (r *ConnectionSecretReconciler) ensureSecret(
 	ctx context.Context,
 	ids *ConnectionSecretIdentifiers,
 	user *generatedv1.DatabaseUser,
 	connectionTarget target.ConnectionTargetInstance,
 	connData *data.ConnectionSecret,
-) error {
+) (string, error) {
 	namespace := user.GetNamespace()
 	name := K8sConnectionSecretName(ids.ProjectName, ids.TargetName, ids.DatabaseUsername, connectionTarget.GetConnectionTargetType())
 
@@ -376,30 +387,30 @@ func (r *ConnectionSecretReconciler) ensureSecret(
 
 	// Fills the secret.stringData with the information stored in ConnectionSecretData
 	if err := fillConnSecretData(secret, ids, connData, connectionTarget.GetConnectionTargetType()); err != nil {
-		return err
+		return "", err
 	}
 
 	// Add the owner to be the AtlasDatabaseUser for garbage collection
 	if err := controllerutil.SetControllerReference(user, secret, r.Scheme); err != nil {
-		return err
+		return "", err
 	}
 
 	currentSecret := &corev1.Secret{}
 	err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, currentSecret)
 	if err != nil && !apierrors.IsNotFound(err) {
-		return err
+		return "", err
 	}
 
 	if reflect.DeepEqual(secret.Data, currentSecret.Data) {
-		return nil // nothing to do
+		return name, nil // nothing to do
 	}
 
 	// Apply the secret
 	if err := r.Client.Patch(ctx, secret, client.Apply, client.ForceOwnership, ConnectionSecretGoFieldOwner); err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return name, nil
 }
 
 //nolint:unparam
@@ -499,5 +510,12 @@ func fillConnSecretData(secret *corev1.Secret, ids *ConnectionSecretIdentifiers,
 		secret.Data[privateShardKey+suffix] = []byte(pe.ShardConnectionURL)
 	}
 
+	if err := servicebinding.Fill(secret.Data, data.ConnectionURL, data.SrvConnectionURL); err != nil {
+		return err
+	}
+
+	if len(data.Templates) > 0 {
+		return applyConnectionSecretTemplates(secret, ids, data)
+	}
 	return nil
 }
@@ -22,6 +22,11 @@ type ConnectionSecret struct {
 	ConnectionURL         string
 	SrvConnectionURL      string
 	PrivateConnectionURLs []PrivateLinkConnectionURLs
+
+	// AuthDatabase is the database the user authenticates against
+	AuthDatabase string
+	// Templates render additional keys into the Secret, see AtlasConnectionSecretTemplate
+	Templates map[string]string
 }
 
 type PrivateLinkConnectionURLs struct {
@@ -25,17 +25,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/target"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 )
 
-func (r *ConnectionSecretReconciler) handleUpsert(ctx context.Context, ids *ConnectionSecretIdentifiers, user *generatedv1.DatabaseUser, connectionTarget target.ConnectionTargetInstance) (reconcile.Result, error) {
+// handleUpsert ensures the connection Secret of the user for the connection target and returns its name,
+// empty when there is no Secret to create yet.
+func (r *ConnectionSecretReconciler) handleUpsert(ctx context.Context, ids *ConnectionSecretIdentifiers, user *generatedv1.DatabaseUser, connectionTarget target.ConnectionTargetInstance) (string, error) {
 	if user == nil || user.Spec.V20250312 == nil || user.Spec.V20250312.Entry == nil || user.Spec.V20250312.Entry.PasswordSecretRef == nil {
-		return reconcile.Result{}, nil // nothing to do
+		return "", nil // nothing to do
 	}
 
 	secret := &corev1.Secret{}
@@ -44,28 +46,28 @@ func (r *ConnectionSecretReconciler) handleUpsert(ctx context.Context, ids *Conn
 		Namespace: user.GetNamespace(),
 	}, secret)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to get password secret: %w", err)
+		return "", fmt.Errorf("failed to get password secret: %w", err)
 	}
 
 	password, exists := secret.Data[*user.Spec.V20250312.Entry.PasswordSecretRef.Key] // key is defaulted so cannot be nil
 	if !exists {
-		return reconcile.Result{}, fmt.Errorf("secret does not contain key %q", *user.Spec.V20250312.Entry.PasswordSecretRef.Key)
+		return "", fmt.Errorf("secret does not contain key %q", *user.Spec.V20250312.Entry.PasswordSecretRef.Key)
 	}
 
 	// create the connection data that will populate secret.stringData
 	data := connectionTarget.BuildConnectionData(ctx)
 	if data == nil {
-		return ctrl.Result{}, nil // nothing to do
+		return "", nil // nothing to do
 	}
 
 	data.DBUserName = user.Spec.V20250312.Entry.Username
 	data.Password = string(password)
-
-	if err := r.ensureSecret(ctx, ids, user, connectionTarget, data); err != nil {
-		return ctrl.Result{}, err
+	data.AuthDatabase = user.Spec.V20250312.Entry.DatabaseName
+	if data.Templates, err = akov2.ReadConnectionSecretTemplates(ctx, r.Client, user); err != nil {
+		return "", err
 	}
 
-	return ctrl.Result{}, nil
+	return r.ensureSecret(ctx, ids, user, connectionTarget, data)
 }
 
 func (r *ConnectionSecretReconciler) handleBatchUpsert(
@@ -99,7 +101,8 @@ func (r *ConnectionSecretReconciler) handleBatchUpsert(
 		groupName = group.GetName()
 	}
 
-	activeTargets := 0
+	var secrets []string
+	pending := false
 	for _, connectionTarget := range connectionTargetInstances {
 		// Construct connection secret identifier for the current connection target.
 		connectionSecretIdentifier := ConnectionSecretIdentifiers{
@@ -136,24 +139,36 @@ func (r *ConnectionSecretReconciler) handleBatchUpsert(
 
 		// Ensure connectionTarget readiness
 		if !(connectionTarget.IsReady()) {
+			pending = true
 			continue
 		}
 
 		// Handle the upsert of the connection secret.
-		result, err := r.handleUpsert(ctx, &connectionSecretIdentifier, user, connectionTarget)
+		secretName, err := r.handleUpsert(ctx, &connectionSecretIdentifier, user, connectionTarget)
 		if err != nil {
-			return result, err
+			return ctrl.Result{}, err
+		}
+		if secretName == "" {
+			pending = true
+			continue
 		}
-		activeTargets++
+		secrets = append(secrets, secretName)
+	}
+
+	if err := r.updateUserBinding(ctx, user, userBinding(secrets, pending)); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.updateClusterBindings(ctx, projectID, connectionTargetInstances); err != nil {
+		return ctrl.Result{}, err
 	}
 
 	var connectionSecretCondition metav1.Condition
-	if activeTargets > 0 {
+	if len(secrets) > 0 {
 		connectionSecretCondition = metav1.Condition{
 			Type:               ConnectionSecretReady,
 			Status:             metav1.ConditionTrue,
 			Reason:             "Settled",
-			Message:            fmt.Sprintf("%v connection secrets are ready", activeTargets),
+			Message:            fmt.Sprintf("%v connection secrets are ready", len(secrets)),
 			LastTransitionTime: metav1.Now(),
 		}
 	}
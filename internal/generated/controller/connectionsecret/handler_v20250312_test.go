@@ -600,6 +600,7 @@ func TestHandleBatchUpsert(t *testing.T) {
 		wantErr             string
 		wantSecretCreated   bool
 		wantConditionStatus metav1.ConditionStatus
+		wantBinding         string
 	}{
 		{
 			name: "user with nil spec entry returns error",
@@ -634,6 +635,7 @@ func TestHandleBatchUpsert(t *testing.T) {
 			atlasProvider:       newMockAtlasProvider(t, testProjectID, testProjectName),
 			wantSecretCreated:   true,
 			wantConditionStatus: metav1.ConditionTrue,
+			wantBinding:         K8sConnectionSecretName(testProjectName, testClusterName, testUserName, "cluster"),
 		},
 		{
 			name: "target not ready skips secret creation",
@@ -696,6 +698,7 @@ func TestHandleBatchUpsert(t *testing.T) {
 			atlasProvider:       newMockAtlasProvider(t, testProjectID, testProjectName),
 			wantSecretCreated:   true,
 			wantConditionStatus: metav1.ConditionTrue,
+			wantBinding:         K8sConnectionSecretName(testProjectName, testClusterName, testUserName, "cluster"),
 		},
 		{
 			name: "multiple targets with mixed readiness",
@@ -807,6 +810,16 @@ func TestHandleBatchUpsert(t *testing.T) {
 				assert.Empty(t, connSecrets, "expected no connection secret to be created")
 			}
 
+			// Check the Service Binding of the user, only set when the user has a single connection secret
+			boundUser := &generatedv1.DatabaseUser{}
+			require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(tc.user), boundUser))
+			if tc.wantBinding == "" {
+				assert.Nil(t, boundUser.Status.Binding)
+			} else {
+				require.NotNil(t, boundUser.Status.Binding)
+				assert.Equal(t, tc.wantBinding, boundUser.Status.Binding.Name)
+			}
+
 			// Check condition on user
 			if tc.wantConditionStatus != "" {
 				updatedUser := &generatedv1.DatabaseUser{}
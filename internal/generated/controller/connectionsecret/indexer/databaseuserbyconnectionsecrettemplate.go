@@ -0,0 +1,56 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	zap "go.uber.org/zap"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+)
+
+const DatabaseUserByConnectionSecretTemplateIndex = "databaseuser.connectionSecretTemplate"
+
+type DatabaseUserByConnectionSecretTemplateIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewDatabaseUserByConnectionSecretTemplateIndexer(logger *zap.Logger) *DatabaseUserByConnectionSecretTemplateIndexer {
+	return &DatabaseUserByConnectionSecretTemplateIndexer{logger: logger.Named(DatabaseUserByConnectionSecretTemplateIndex).Sugar()}
+}
+
+func (*DatabaseUserByConnectionSecretTemplateIndexer) Object() client.Object {
+	return &generatedv1.DatabaseUser{}
+}
+
+func (*DatabaseUserByConnectionSecretTemplateIndexer) Name() string {
+	return DatabaseUserByConnectionSecretTemplateIndex
+}
+
+func (i *DatabaseUserByConnectionSecretTemplateIndexer) Keys(object client.Object) []string {
+	user, ok := object.(*generatedv1.DatabaseUser)
+	if !ok {
+		i.logger.Errorf("expected *generatedv1.DatabaseUser but got %T", object)
+		return nil
+	}
+
+	key := akov2.ConnectionSecretTemplateKey(user)
+	if key == nil {
+		return nil
+	}
+
+	return []string{key.String()}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionsecret
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/data"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/controller/connectionsecret/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/secrettemplate"
+)
+
+// connectionSecretTemplateMapFunc maps an AtlasConnectionSecretTemplate to the DatabaseUsers annotated with it
+func (r *ConnectionSecretReconciler) connectionSecretTemplateMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	template, ok := obj.(*akov2.AtlasConnectionSecretTemplate)
+	if !ok {
+		return nil
+	}
+
+	users := &generatedv1.DatabaseUserList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.DatabaseUserByConnectionSecretTemplateIndex,
+			client.ObjectKeyFromObject(template).String(),
+		),
+	}
+	if err := r.Client.List(ctx, users, listOpts); err != nil {
+		return nil
+	}
+	return r.generateConnectionSecretRequests(users.Items)
+}
+
+// applyConnectionSecretTemplates renders the templates of the connection data into the secret
+func applyConnectionSecretTemplates(secret *corev1.Secret, ids *ConnectionSecretIdentifiers, data *data.ConnectionSecret) error {
+	templateData := &secrettemplate.Data{
+		ProjectID:      ids.ProjectID,
+		DeploymentName: ids.TargetName,
+		Username:       data.DBUserName,
+		Password:       data.Password,
+		AuthDatabase:   data.AuthDatabase,
+		URI:            data.ConnectionURL,
+		SrvURI:         data.SrvConnectionURL,
+	}
+	for _, pe := range data.PrivateConnectionURLs {
+		templateData.PrivateURIs = append(templateData.PrivateURIs, secrettemplate.PrivateURI{
+			URI:      pe.ConnectionURL,
+			SrvURI:   pe.SrvConnectionURL,
+			ShardURI: pe.ShardConnectionURL,
+		})
+	}
+	return secrettemplate.ApplyConnection(secret.Data, data.Templates, templateData)
+}
@@ -21,6 +21,7 @@ import (
 	crapi "github.com/crd2go/crapi"
 	v20250312sdk "go.mongodb.org/atlas-sdk/v20250312023/admin"
 	zap "go.uber.org/zap"
+	record "k8s.io/client-go/tools/record"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 	cluster "sigs.k8s.io/controller-runtime/pkg/cluster"
 	predicate "sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -88,13 +89,17 @@ func NewFlexClusterReconciler(
 			Log:             logger.Named("controllers").Named("AtlasFlexCluster").Sugar(),
 		},
 		deletionProtection: deletionProtection,
-		handlerv20250312:   handlerv20250312Func,
+		handlerv20250312:   handlerv20250312Func(c.GetEventRecorderFor("FlexCluster")),
 		predicates:         predicates,
 		translators:        translators,
 	}
 
 	return ctrlstate.NewStateReconciler(flexclusterHandler, ctrlstate.WithCluster[akov2generated.FlexCluster](c), ctrlstate.WithReapplySupport[akov2generated.FlexCluster](reapplySupport)), nil
 }
-func handlerv20250312Func(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) ctrlstate.StateHandler[akov2generated.FlexCluster] {
-	return NewHandlerv20250312(kubeClient, atlasClient, translator, deletionProtection)
+func handlerv20250312Func(eventRecorder record.EventRecorder) handler.VersionedHandlerFunc[v20250312sdk.APIClient, akov2generated.FlexCluster] {
+	return func(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) ctrlstate.StateHandler[akov2generated.FlexCluster] {
+		versionedHandler := NewHandlerv20250312(kubeClient, atlasClient, translator, deletionProtection)
+		versionedHandler.eventRecorder = eventRecorder
+		return versionedHandler
+	}
 }
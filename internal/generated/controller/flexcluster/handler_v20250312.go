@@ -22,6 +22,8 @@ import (
 	state "github.com/crd2go/constate/state"
 	crapi "github.com/crd2go/crapi"
 	v20250312sdk "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	corev1 "k8s.io/api/core/v1"
+	record "k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	builder "sigs.k8s.io/controller-runtime/pkg/builder"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +32,9 @@ import (
 
 	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/drift"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/metrics"
 	result "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 )
 
@@ -38,6 +43,8 @@ type Handlerv20250312 struct {
 	atlasClient        *v20250312sdk.APIClient
 	translator         crapi.Translator
 	deletionProtection bool
+	// eventRecorder reports drift with the detect-only reconciliation policy, it is optional
+	eventRecorder record.EventRecorder
 }
 
 func NewHandlerv20250312(kubeClient client.Client, atlasClient *v20250312sdk.APIClient, translator crapi.Translator, deletionProtection bool) *Handlerv20250312 {
@@ -51,6 +58,14 @@ func NewHandlerv20250312(kubeClient client.Client, atlasClient *v20250312sdk.API
 
 // HandleInitial handles the initial state for version v20250312
 func (h *Handlerv20250312) HandleInitial(ctx context.Context, flexcluster *akov2generated.FlexCluster) (ctrlstate.Result, error) {
+	if customresource.ReconciliationIsDetectOnly(flexcluster) {
+		return result.NextState(state.StateInitial, "Flex Cluster is not created in Atlas with the detect-only reconciliation policy.")
+	}
+
+	if err := policy.CheckFlexCluster(ctx, h.kubeClient, flexcluster); err != nil {
+		return result.Error(state.StateInitial, err)
+	}
+
 	deps, err := h.getDependencies(ctx, flexcluster)
 	if err != nil {
 		return result.Error(state.StateInitial, fmt.Errorf("failed to get dependencies: %w", err))
@@ -161,7 +176,7 @@ func (h *Handlerv20250312) HandleUpdated(ctx context.Context, flexcluster *akov2
 
 // HandleDeletionRequested handles the deletionrequested state for version v20250312
 func (h *Handlerv20250312) HandleDeletionRequested(ctx context.Context, flexcluster *akov2generated.FlexCluster) (ctrlstate.Result, error) {
-	if customresource.IsResourcePolicyKeepOrDefault(flexcluster, h.deletionProtection) {
+	if customresource.IsResourcePolicyKeepOrDefault(flexcluster, h.deletionProtection) || customresource.ReconciliationIsDetectOnly(flexcluster) {
 		return result.NextState(state.StateDeleted, "Flex Cluster deleted.")
 	}
 
@@ -250,6 +265,13 @@ func (h *Handlerv20250312) handleIdle(ctx context.Context, flexcluster *akov2gen
 		return result.Error(currentState, fmt.Errorf("failed to get dependencies: %w", err))
 	}
 
+	if customresource.ReconciliationIsDetectOnly(flexcluster) {
+		return h.detectDrift(ctx, currentState, flexcluster, deps...)
+	}
+	if err := h.clearDrift(ctx, flexcluster); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	update, err := ctrlstate.ShouldUpdate(flexcluster, deps...)
 	if err != nil {
 		return result.Error(currentState, reconcile.TerminalError(err))
@@ -259,6 +281,10 @@ func (h *Handlerv20250312) handleIdle(ctx context.Context, flexcluster *akov2gen
 		return result.NextState(currentState, "Flex cluster up to date. No update required.")
 	}
 
+	if err := policy.CheckFlexCluster(ctx, h.kubeClient, flexcluster); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	body := &v20250312sdk.FlexClusterDescriptionUpdate20241113{}
 	params := &v20250312sdk.UpdateFlexClusterApiParams{
 		FlexClusterDescriptionUpdate20241113: body,
@@ -295,6 +321,60 @@ func (h *Handlerv20250312) handleIdle(ctx context.Context, flexcluster *akov2gen
 	return result.NextState(finalState, "Updating Flex Cluster.")
 }
 
+// detectDrift compares the flex cluster in Atlas with the spec and reports the differences
+// in the Drifted condition without updating Atlas.
+func (h *Handlerv20250312) detectDrift(ctx context.Context, currentState state.ResourceState, flexcluster *akov2generated.FlexCluster, deps ...client.Object) (ctrlstate.Result, error) {
+	params := &v20250312sdk.GetFlexClusterApiParams{}
+	if err := h.translator.ToAPI(params, flexcluster, deps...); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate get flex cluster parameters: %w", err))
+	}
+
+	atlasFlexCluster, _, err := h.atlasClient.FlexClustersAPI.GetFlexClusterWithParams(ctx, params).Execute()
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to get cluster: %w", err))
+	}
+
+	observed := &akov2generated.FlexCluster{}
+	if _, err := h.translator.FromAPI(observed, atlasFlexCluster, deps...); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate get cluster response: %w", err))
+	}
+	report, err := drift.Compute(flexcluster.Spec.V20250312.Entry, observed.Spec.V20250312.Entry)
+	if err != nil {
+		return result.Error(currentState, reconcile.TerminalError(err))
+	}
+	metrics.ObserveDrift("FlexCluster", client.ObjectKeyFromObject(flexcluster), len(report.Operations))
+
+	flexclusterCopy := flexcluster.DeepCopy()
+	if _, err := h.translator.FromAPI(flexclusterCopy, atlasFlexCluster, deps...); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to translate get cluster response: %w", err))
+	}
+	changed := drift.SetCondition(&flexclusterCopy.Status.Conditions, flexcluster.GetGeneration(), report)
+	if err := ctrlstate.NewPatcher(flexclusterCopy).UpdateStatus().Patch(ctx, h.kubeClient); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to patch cluster: %w", err))
+	}
+
+	if report.Drifted() {
+		if changed && h.eventRecorder != nil {
+			h.eventRecorder.Event(flexcluster, corev1.EventTypeWarning, drift.ReasonDrifted, report.Summary())
+		}
+		return result.NextState(currentState, "Flex cluster drifted from Atlas. No update performed with the detect-only reconciliation policy.")
+	}
+	return result.NextState(currentState, "Flex cluster up to date. No update required.")
+}
+
+// clearDrift removes the Drifted condition once the detect-only policy is removed.
+func (h *Handlerv20250312) clearDrift(ctx context.Context, flexcluster *akov2generated.FlexCluster) error {
+	flexclusterCopy := flexcluster.DeepCopy()
+	if !drift.RemoveCondition(flexclusterCopy.Status.Conditions) {
+		return nil
+	}
+	metrics.ObserveDrift("FlexCluster", client.ObjectKeyFromObject(flexcluster), 0)
+	if err := ctrlstate.NewPatcher(flexclusterCopy).UpdateStatus().Patch(ctx, h.kubeClient); err != nil {
+		return fmt.Errorf("failed to patch cluster: %w", err)
+	}
+	return nil
+}
+
 func (h *Handlerv20250312) patchStatus(ctx context.Context, flexcluster *akov2generated.FlexCluster) (*v20250312sdk.FlexClusterDescription20241113, error) {
 	deps, err := h.getDependencies(ctx, flexcluster)
 	if err != nil {
@@ -29,12 +29,16 @@ import (
 
 	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	atlas "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	customresource "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	reconciler "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	result "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 )
 
 // getHandlerForResource selects the appropriate version-specific handler based on which resource spec version is set
 func (h *Handler) getHandlerForResource(ctx context.Context, group *akov2generated.Group) (ctrlstate.StateHandler[akov2generated.Group], error) {
+	if err := customresource.UnsupportedReconciliationPolicy(group, "Group"); err != nil {
+		return nil, err
+	}
 	atlasClients, err := h.getSDKClientSet(ctx, group)
 	if err != nil {
 		return nil, err
@@ -31,6 +31,7 @@ import (
 
 	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	atlas "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	customresource "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	reconciler "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	indexers "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/generated/indexers"
 	result "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
@@ -38,6 +39,9 @@ import (
 
 // getHandlerForResource selects the appropriate version-specific handler based on which resource spec version is set
 func (h *Handler) getHandlerForResource(ctx context.Context, ipaccesslistentry *akov2generated.IPAccessListEntry) (ctrlstate.StateHandler[akov2generated.IPAccessListEntry], error) {
+	if err := customresource.UnsupportedReconciliationPolicy(ipaccesslistentry, "IPAccessListEntry"); err != nil {
+		return nil, err
+	}
 	atlasClients, err := h.getSDKClientSet(ctx, ipaccesslistentry)
 	if err != nil {
 		return nil, err
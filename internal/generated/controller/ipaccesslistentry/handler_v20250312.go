@@ -35,6 +35,7 @@ import (
 
 	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/policy"
 	result "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 )
 
@@ -64,6 +65,10 @@ func NewHandlerv20250312(kubeClient client.Client, atlasClient *v20250312sdk.API
 
 // HandleInitial creates a new IP access list entry in Atlas.
 func (h *Handlerv20250312) HandleInitial(ctx context.Context, ipaccesslistentry *akov2generated.IPAccessListEntry) (ctrlstate.Result, error) {
+	if err := policy.CheckIPAccessListEntry(ctx, h.kubeClient, ipaccesslistentry); err != nil {
+		return result.Error(state.StateInitial, err)
+	}
+
 	groupID, entryValue, err := h.resolveIdentity(ctx, ipaccesslistentry)
 	if err != nil {
 		return result.Error(state.StateInitial, fmt.Errorf("failed to resolve IPAccessListEntry identity: %w", err))
@@ -303,6 +308,10 @@ func (h *Handlerv20250312) handleSteadyState(ctx context.Context, currentState s
 		return result.NextState(currentState, "IP access list entry is up to date. No update required.")
 	}
 
+	if err := policy.CheckIPAccessListEntry(ctx, h.kubeClient, ipaccesslistentry); err != nil {
+		return result.Error(currentState, err)
+	}
+
 	groupID, oldEntryValue, err := h.identityFromStatusOrDeps(ctx, ipaccesslistentry)
 	if err != nil {
 		return result.Error(currentState, err)
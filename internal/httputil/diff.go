@@ -17,6 +17,7 @@ package httputil
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -67,6 +68,17 @@ func cleanCreatedField(data map[string]any) {
 }
 
 func (t *TransportWithDiff) tryCalculateDiff(req *http.Request, cleanupFuncs ...cleanupFunc) (string, error) {
+	return calculateDiff(t.transport, req, cleanupFuncs...)
+}
+
+// CalculateDiff fetches the current state of the resource targeted by req using a GET request
+// sent through the given transport and returns a human-readable diff between it and the request payload.
+// The request body is left intact so that the request can still be sent afterwards.
+func CalculateDiff(transport http.RoundTripper, req *http.Request) (string, error) {
+	return calculateDiff(transport, req, cleanLinksField, cleanCreatedField)
+}
+
+func calculateDiff(transport http.RoundTripper, req *http.Request, cleanupFuncs ...cleanupFunc) (string, error) {
 	var bodyCopy []byte
 	if req.Body != nil {
 		bodyCopy, _ = io.ReadAll(req.Body)
@@ -83,10 +95,13 @@ func (t *TransportWithDiff) tryCalculateDiff(req *http.Request, cleanupFuncs ...
 	getReq.GetBody = nil
 	getReq.ContentLength = 0
 
-	getResp, err := t.transport.RoundTrip(getReq)
+	getResp, err := transport.RoundTrip(getReq)
 	if err != nil {
 		return "", fmt.Errorf("failed to GET original resource: %w", err)
 	}
+	if getResp == nil || getResp.Body == nil {
+		return "", errors.New("failed to GET original resource: empty response")
+	}
 	defer getResp.Body.Close()
 
 	payloadFromGet, _ := io.ReadAll(getResp.Body)
@@ -0,0 +1,59 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestAtlasDatabaseUserByConnectionSecretTemplateIndexer(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		object   client.Object
+		wantKeys []string
+	}{
+		{
+			name:   "should return nil on wrong type",
+			object: &akov2.AtlasProject{},
+		},
+		{
+			name:   "should return nil when there is no annotation",
+			object: &akov2.AtlasDatabaseUser{},
+		},
+		{
+			name: "should return the template in the user namespace",
+			object: &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user",
+					Namespace:   "ns",
+					Annotations: map[string]string{akov2.ConnectionSecretTemplateAnnotation: "spring"},
+				},
+			},
+			wantKeys: []string{"ns/spring"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := NewAtlasDatabaseUserByConnectionSecretTemplateIndexer(zaptest.NewLogger(t))
+			assert.Equal(t, tc.wantKeys, indexer.Keys(tc.object))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasProjectByIDIndex = "atlasproject.status.id"
+)
+
+type AtlasProjectByIDIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasProjectByIDIndexer(logger *zap.Logger) *AtlasProjectByIDIndexer {
+	return &AtlasProjectByIDIndexer{
+		logger: logger.Named(AtlasProjectByIDIndex).Sugar(),
+	}
+}
+
+func (*AtlasProjectByIDIndexer) Object() client.Object {
+	return &akov2.AtlasProject{}
+}
+
+func (*AtlasProjectByIDIndexer) Name() string {
+	return AtlasProjectByIDIndex
+}
+
+func (a *AtlasProjectByIDIndexer) Keys(object client.Object) []string {
+	project, ok := object.(*akov2.AtlasProject)
+	if !ok {
+		a.logger.Errorf("expected *v1.AtlasProject but got %T", object)
+		return nil
+	}
+
+	if project.ID() == "" {
+		return nil
+	}
+
+	return []string{project.ID()}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func TestAtlasProjectByIDIndexer(t *testing.T) {
+	tests := map[string]struct {
+		object       client.Object
+		expectedKeys []string
+		expectedLogs []observer.LoggedEntry
+	}{
+		"should return nil on wrong type": {
+			object: &akov2.AtlasDeployment{},
+			expectedLogs: []observer.LoggedEntry{
+				{
+					Context: []zapcore.Field{},
+					Entry:   zapcore.Entry{LoggerName: AtlasProjectByIDIndex, Level: zap.ErrorLevel, Message: "expected *v1.AtlasProject but got *v1.AtlasDeployment"},
+				},
+			},
+		},
+		"should return nil when the project was not created in Atlas yet": {
+			object:       &akov2.AtlasProject{},
+			expectedLogs: []observer.LoggedEntry{},
+		},
+		"should return the Atlas project ID": {
+			object: &akov2.AtlasProject{
+				Status: status.AtlasProjectStatus{
+					ID: "external-project-id",
+				},
+			},
+			expectedKeys: []string{"external-project-id"},
+			expectedLogs: []observer.LoggedEntry{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			core, logs := observer.New(zap.DebugLevel)
+			indexer := NewAtlasProjectByIDIndexer(zap.New(core))
+			keys := indexer.Keys(tt.object)
+
+			assert.Equal(t, tt.expectedKeys, keys)
+			assert.Equal(t, tt.expectedLogs, logs.AllUntimed())
+		})
+	}
+}
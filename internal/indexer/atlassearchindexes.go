@@ -0,0 +1,98 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasSearchIndexBySearchIndexConfigIndex = "atlassearchindex.spec.search.searchConfigurationRef"
+	AtlasSearchIndexByDeploymentNameIndex    = "atlassearchindex.deploymentName"
+)
+
+type AtlasSearchIndexBySearchIndexConfigIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasSearchIndexBySearchIndexConfigIndexer(logger *zap.Logger) *AtlasSearchIndexBySearchIndexConfigIndexer {
+	return &AtlasSearchIndexBySearchIndexConfigIndexer{
+		logger: logger.Named(AtlasSearchIndexBySearchIndexConfigIndex).Sugar(),
+	}
+}
+
+func (*AtlasSearchIndexBySearchIndexConfigIndexer) Object() client.Object {
+	return &akov2.AtlasSearchIndex{}
+}
+
+func (*AtlasSearchIndexBySearchIndexConfigIndexer) Name() string {
+	return AtlasSearchIndexBySearchIndexConfigIndex
+}
+
+func (a *AtlasSearchIndexBySearchIndexConfigIndexer) Keys(object client.Object) []string {
+	searchIndex, ok := object.(*akov2.AtlasSearchIndex)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasSearchIndex but got %T", object)
+		return nil
+	}
+
+	if searchIndex.Spec.Search == nil {
+		return nil
+	}
+
+	// searchIndexConfigKey -> searchIndexName
+	return []string{searchIndex.Spec.Search.SearchConfigurationRef.GetObject(searchIndex.GetNamespace()).String()}
+}
+
+type AtlasSearchIndexByDeploymentNameIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasSearchIndexByDeploymentNameIndexer(logger *zap.Logger) *AtlasSearchIndexByDeploymentNameIndexer {
+	return &AtlasSearchIndexByDeploymentNameIndexer{
+		logger: logger.Named(AtlasSearchIndexByDeploymentNameIndex).Sugar(),
+	}
+}
+
+func (*AtlasSearchIndexByDeploymentNameIndexer) Object() client.Object {
+	return &akov2.AtlasSearchIndex{}
+}
+
+func (*AtlasSearchIndexByDeploymentNameIndexer) Name() string {
+	return AtlasSearchIndexByDeploymentNameIndex
+}
+
+// Keys returns the Atlas name of the deployment of the index. Indexes referencing an AtlasDeployment
+// only know it once reconciled, they record it in their status.
+func (a *AtlasSearchIndexByDeploymentNameIndexer) Keys(object client.Object) []string {
+	searchIndex, ok := object.(*akov2.AtlasSearchIndex)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasSearchIndex but got %T", object)
+		return nil
+	}
+
+	if searchIndex.Spec.ExternalDeploymentRef != nil && searchIndex.Spec.ExternalDeploymentRef.Name != "" {
+		return []string{searchIndex.Spec.ExternalDeploymentRef.Name}
+	}
+
+	if searchIndex.Status.ClusterName != "" {
+		return []string{searchIndex.Status.ClusterName}
+	}
+
+	return nil
+}
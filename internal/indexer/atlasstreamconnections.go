@@ -61,6 +61,11 @@ func (a *AtlasStreamConnectionBySecretIndexer) Keys(object client.Object) []stri
 		indexes = append(indexes, key)
 	}
 
+	key, found = headersSecretKey(streamConnection)
+	if found {
+		indexes = append(indexes, key)
+	}
+
 	return indexes
 }
 
@@ -83,3 +88,13 @@ func certificateSecretKey(connection *akov2.AtlasStreamConnection) (string, bool
 
 	return certificateKey.String(), true
 }
+
+func headersSecretKey(connection *akov2.AtlasStreamConnection) (string, bool) {
+	if connection == nil || connection.Spec.HTTPSConfig == nil || connection.Spec.HTTPSConfig.HeadersSecret == nil || connection.Spec.HTTPSConfig.HeadersSecret.Name == "" {
+		return "", false
+	}
+
+	headersKey := connection.Spec.HTTPSConfig.HeadersSecret.GetObject(connection.GetNamespace())
+
+	return headersKey.String(), true
+}
@@ -21,6 +21,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 	"go.uber.org/zap/zaptest/observer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
@@ -171,4 +172,31 @@ func TestAtlasStreamConnectionsBySecretIndices(t *testing.T) {
 			keys,
 		)
 	})
+	t.Run("should return indexes slice when https connection has a headers secret", func(t *testing.T) {
+		connection := &akov2.AtlasStreamConnection{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+			},
+			Spec: akov2.AtlasStreamConnectionSpec{
+				Name:           "connection-0",
+				ConnectionType: "Https",
+				HTTPSConfig: &akov2.StreamsHTTPSConnection{
+					URL: "https://example.com/ingest",
+					HeadersSecret: &common.ResourceRefNamespaced{
+						Name: "connection-headers",
+					},
+				},
+			},
+		}
+
+		indexer := NewAtlasStreamConnectionBySecretIndexer(zaptest.NewLogger(t))
+		keys := indexer.Keys(connection)
+		assert.Equal(
+			t,
+			[]string{
+				"default/connection-headers",
+			},
+			keys,
+		)
+	})
 }
@@ -44,6 +44,8 @@ func RegisterAll(ctx context.Context, c cluster.Cluster, logger *zap.Logger) err
 		NewAtlasBackupScheduleByBackupPolicyIndexer(logger),
 		NewAtlasDeploymentByBackupScheduleIndexer(logger),
 		NewAtlasDeploymentBySearchIndexIndexer(logger),
+		NewAtlasSearchIndexBySearchIndexConfigIndexer(logger),
+		NewAtlasSearchIndexByDeploymentNameIndexer(logger),
 		NewAtlasStreamConnectionBySecretIndexer(logger),
 		NewAtlasStreamInstanceByProjectIndexer(logger),
 		NewAtlasStreamInstanceByConnectionIndexer(logger),
@@ -52,9 +54,12 @@ func RegisterAll(ctx context.Context, c cluster.Cluster, logger *zap.Logger) err
 		NewAtlasProjectByTeamIndexer(logger),
 		NewAtlasFederatedAuthBySecretsIndexer(logger),
 		NewAtlasDatabaseUserBySecretsIndexer(logger),
+		NewAtlasDatabaseUserByConnectionSecretTemplateIndexer(logger),
 		NewAtlasDatabaseUserByCredentialIndexer(logger),
 		NewAtlasDeploymentByCredentialIndexer(logger),
 		NewAtlasDatabaseUserByProjectIndexer(ctx, c.GetClient(), logger),
+		NewAtlasDeploymentByProjectIndexer(ctx, c.GetClient(), logger),
+		NewAtlasProjectByIDIndexer(logger),
 		NewAtlasDataFederationByProjectIndexer(logger),
 		NewAtlasCustomRoleByCredentialIndexer(logger),
 		NewAtlasCustomRoleByProjectIndexer(logger),
@@ -79,6 +84,7 @@ func RegisterAll(ctx context.Context, c cluster.Cluster, logger *zap.Logger) err
 		connectionsecretindexer.NewClusterByGroupIdIndexer(logger),
 		connectionsecretindexer.NewFlexClusterByGroupIdIndexer(logger),
 		connectionsecretindexer.NewDatabaseUserBySecretIndexer(ctx, c.GetClient(), logger),
+		connectionsecretindexer.NewDatabaseUserByConnectionSecretTemplateIndexer(logger),
 	)
 	if version.IsExperimental() {
 		// add experimental indexers here
@@ -0,0 +1,97 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	paramPlaceholder = "{}"
+	byName           = "byName"
+)
+
+// parameterizedCollections are the Atlas API path segments followed by the given number of path parameters.
+var parameterizedCollections = map[string]int{
+	"accessList":          1,
+	"alertConfigs":        1,
+	"alerts":              1,
+	"apiKeys":             1,
+	"archives":            1,
+	"cloudProviderAccess": 1,
+	"clusters":            1,
+	"connections":         1,
+	"containers":          1,
+	"dataFederation":      1,
+	"databaseUsers":       2,
+	"endpoint":            1,
+	"endpointService":     1,
+	"flexClusters":        1,
+	"groups":              1,
+	"integrations":        1,
+	"invites":             1,
+	"orgs":                1,
+	"peers":               1,
+	"privateEndpoint":     1,
+	"processors":          1,
+	"processor":           1,
+	"restoreJobs":         1,
+	"roles":               1,
+	"indexes":             1,
+	"serviceAccounts":     1,
+	"snapshots":           1,
+	"streams":             1,
+	"teams":               1,
+	"users":               1,
+}
+
+var (
+	objectIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+	numberRegexp   = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// EndpointTemplate replaces path parameters of an Atlas API path with placeholders
+// to keep the cardinality of metric labels bounded, for example
+// /api/atlas/v2/groups/5f1b.../clusters/cluster0 becomes /api/atlas/v2/groups/{}/clusters/{}.
+func EndpointTemplate(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	params := 0
+	for i, segment := range segments {
+		if segment == byName {
+			// e.g. /groups/byName/{groupName} or /teams/byName/{teamName}
+			params = 1
+			continue
+		}
+		if params > 0 {
+			segments[i] = paramPlaceholder
+			params--
+			continue
+		}
+		if looksLikeParameter(segment) {
+			segments[i] = paramPlaceholder
+			continue
+		}
+		params = parameterizedCollections[segment]
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// looksLikeParameter detects identifiers in segments not covered by parameterizedCollections.
+func looksLikeParameter(segment string) bool {
+	return objectIDRegexp.MatchString(segment) ||
+		numberRegexp.MatchString(segment) ||
+		strings.ContainsAny(segment, ".:%@")
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics contains the Prometheus collectors of the operator.
+// All collectors are registered with the controller-runtime registry
+// and are exposed on the --metrics-bind-address endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "ako"
+
+	StatusError = "error"
+)
+
+var (
+	// AtlasRequests counts the Atlas API requests by HTTP verb, endpoint template and response status.
+	AtlasRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "atlas_requests_total",
+			Help:      "Number of Atlas API requests by HTTP verb, endpoint template and response status code",
+		},
+		[]string{"method", "endpoint", "status"},
+	)
+
+	// AtlasRequestDuration observes the latency of Atlas API requests by HTTP verb and endpoint template.
+	AtlasRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "atlas_request_duration_seconds",
+			Help:      "Latency of Atlas API requests by HTTP verb and endpoint template",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+		},
+		[]string{"method", "endpoint"},
+	)
+
+	// AtlasDeprecations counts Atlas API responses carrying a Deprecation or Sunset header.
+	AtlasDeprecations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "atlas_deprecated_requests_total",
+			Help:      "Number of Atlas API responses with a Deprecation or Sunset header by type, HTTP verb and endpoint template",
+		},
+		[]string{"type", "method", "endpoint"},
+	)
+
+	// TokenRefreshFailures counts failed attempts to obtain a service account access token.
+	TokenRefreshFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "service_account_token_refresh_failures_total",
+			Help:      "Number of failed attempts to obtain an Atlas service account access token by credentials Secret namespace",
+		},
+		[]string{"namespace"},
+	)
+
+	// Resources reports the number of custom resources by kind, Ready condition status and reason.
+	Resources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "resources",
+			Help:      "Number of custom resources by kind, Ready condition status and reason",
+		},
+		[]string{"kind", "ready", "reason"},
+	)
+
+	// DriftedFields reports the number of fields differing between custom resources reconciled
+	// with the detect-only policy and Atlas.
+	DriftedFields = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "drifted_fields",
+			Help:      "Number of fields differing from Atlas for custom resources reconciled with the detect-only policy",
+		},
+		[]string{"kind", "namespace", "name"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		AtlasRequests,
+		AtlasRequestDuration,
+		AtlasDeprecations,
+		TokenRefreshFailures,
+		Resources,
+		DriftedFields,
+	)
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		want string
+	}{
+		{
+			path: "/api/atlas/v2/groups",
+			want: "/api/atlas/v2/groups",
+		},
+		{
+			path: "/api/atlas/v2/groups/5f1b2c3d4e5f6a7b8c9d0e1f/clusters/cluster0",
+			want: "/api/atlas/v2/groups/{}/clusters/{}",
+		},
+		{
+			path: "/api/atlas/v2/groups/5f1b2c3d4e5f6a7b8c9d0e1f/databaseUsers/admin/my-user",
+			want: "/api/atlas/v2/groups/{}/databaseUsers/{}/{}",
+		},
+		{
+			path: "/api/atlas/v2/groups/5f1b2c3d4e5f6a7b8c9d0e1f/accessList/10.0.0.0%2F24",
+			want: "/api/atlas/v2/groups/{}/accessList/{}",
+		},
+		{
+			path: "/api/atlas/v2/groups/byName/my-project",
+			want: "/api/atlas/v2/groups/byName/{}",
+		},
+		{
+			path: "/api/atlas/v2/unknown/5f1b2c3d4e5f6a7b8c9d0e1f/12345",
+			want: "/api/atlas/v2/unknown/{}/{}",
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.want, EndpointTemplate(tc.path))
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	AtlasRequests.Reset()
+	AtlasRequestDuration.Reset()
+
+	ok := NewTransport(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	failing := NewTransport(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://cloud.mongodb.com/api/atlas/v2/groups/5f1b2c3d4e5f6a7b8c9d0e1f", nil)
+	require.NoError(t, err)
+
+	_, err = ok.RoundTrip(req)
+	require.NoError(t, err)
+	_, err = ok.RoundTrip(req)
+	require.NoError(t, err)
+	_, err = failing.RoundTrip(req)
+	require.Error(t, err)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(AtlasRequests.WithLabelValues(http.MethodGet, "/api/atlas/v2/groups/{}", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(AtlasRequests.WithLabelValues(http.MethodGet, "/api/atlas/v2/groups/{}", StatusError)))
+	assert.Equal(t, 1, testutil.CollectAndCount(AtlasRequestDuration))
+}
+
+func TestObserveResource(t *testing.T) {
+	Resources.Reset()
+	first := types.NamespacedName{Namespace: "ns", Name: "first"}
+	second := types.NamespacedName{Namespace: "ns", Name: "second"}
+
+	ObserveResource("AtlasDeployment", first, "False", "DeploymentCreating")
+	ObserveResource("AtlasDeployment", second, "False", "DeploymentCreating")
+	assert.Equal(t, 2.0, testutil.ToFloat64(Resources.WithLabelValues("AtlasDeployment", "False", "DeploymentCreating")))
+
+	// observing the same state twice must not count twice
+	ObserveResource("AtlasDeployment", first, "True", "")
+	ObserveResource("AtlasDeployment", first, "True", "")
+	assert.Equal(t, 1.0, testutil.ToFloat64(Resources.WithLabelValues("AtlasDeployment", "False", "DeploymentCreating")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(Resources.WithLabelValues("AtlasDeployment", "True", "")))
+
+	ForgetResource("AtlasDeployment", first)
+	ForgetResource("AtlasDeployment", first)
+	assert.Equal(t, 0.0, testutil.ToFloat64(Resources.WithLabelValues("AtlasDeployment", "True", "")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(Resources.WithLabelValues("AtlasDeployment", "False", "DeploymentCreating")))
+}
+
+func TestObserveDrift(t *testing.T) {
+	DriftedFields.Reset()
+	name := types.NamespacedName{Namespace: "ns", Name: "cluster0"}
+
+	ObserveDrift("AtlasDeployment", name, 3)
+	assert.Equal(t, 3.0, testutil.ToFloat64(DriftedFields.WithLabelValues("AtlasDeployment", "ns", "cluster0")))
+
+	ObserveDrift("AtlasDeployment", name, 0)
+	assert.Equal(t, 0, testutil.CollectAndCount(DriftedFields))
+
+	ObserveDrift("AtlasDeployment", name, 3)
+	ForgetResource("AtlasDeployment", name)
+	assert.Equal(t, 0, testutil.CollectAndCount(DriftedFields))
+}
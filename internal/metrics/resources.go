@@ -0,0 +1,82 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type resourceKey struct {
+	kind string
+	name types.NamespacedName
+}
+
+type readiness struct {
+	ready  string
+	reason string
+}
+
+type resourceTracker struct {
+	mu sync.Mutex // protects resources
+
+	resources map[resourceKey]readiness
+}
+
+var trackedResources = &resourceTracker{resources: map[resourceKey]readiness{}}
+
+// ObserveResource records the Ready condition status and reason of a custom resource
+// and updates the Resources gauge accordingly.
+func ObserveResource(kind string, name types.NamespacedName, ready, reason string) {
+	trackedResources.mu.Lock()
+	defer trackedResources.mu.Unlock()
+
+	key := resourceKey{kind: kind, name: name}
+	current := readiness{ready: ready, reason: reason}
+	if previous, ok := trackedResources.resources[key]; ok {
+		if previous == current {
+			return
+		}
+		Resources.WithLabelValues(kind, previous.ready, previous.reason).Dec()
+	}
+	trackedResources.resources[key] = current
+	Resources.WithLabelValues(kind, current.ready, current.reason).Inc()
+}
+
+// ForgetResource removes a deleted custom resource from the Resources and DriftedFields gauges.
+func ForgetResource(kind string, name types.NamespacedName) {
+	trackedResources.mu.Lock()
+	defer trackedResources.mu.Unlock()
+
+	DriftedFields.DeleteLabelValues(kind, name.Namespace, name.Name)
+	key := resourceKey{kind: kind, name: name}
+	previous, ok := trackedResources.resources[key]
+	if !ok {
+		return
+	}
+	delete(trackedResources.resources, key)
+	Resources.WithLabelValues(kind, previous.ready, previous.reason).Dec()
+}
+
+// ObserveDrift records the number of fields of a custom resource differing from Atlas.
+// Resources without drift are removed from the DriftedFields gauge.
+func ObserveDrift(kind string, name types.NamespacedName, fields int) {
+	if fields == 0 {
+		DriftedFields.DeleteLabelValues(kind, name.Namespace, name.Name)
+		return
+	}
+	DriftedFields.WithLabelValues(kind, name.Namespace, name.Name).Set(float64(fields))
+}
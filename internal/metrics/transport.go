@@ -0,0 +1,46 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Transport instruments every Atlas API request with request count and latency metrics.
+type Transport struct {
+	delegate http.RoundTripper
+}
+
+func NewTransport(delegate http.RoundTripper) *Transport {
+	return &Transport{delegate: delegate}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.delegate.RoundTrip(req)
+	endpoint := EndpointTemplate(req.URL.Path)
+
+	status := StatusError
+	if err == nil && resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	AtlasRequests.WithLabelValues(req.Method, endpoint, status).Inc()
+	AtlasRequestDuration.WithLabelValues(req.Method, endpoint).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/operator"
+)
+
+type config struct {
+	Namespace           string
+	OutputDir           string
+	Apply               bool
+	VerifyOnly          bool
+	Timeout             time.Duration
+	OperatorNamespace   string
+	GlobalAPISecretName string
+	AtlasDomain         string
+}
+
+// Run runs the migrate command. The legacy resources of a namespace are read from the cluster of the current
+// kubeconfig context, their Atlas state is exported with the credentials the operator uses, and the generated
+// resources are written as a kustomize directory tree. With --apply, the resources are handed off and verified.
+func Run(ctx context.Context, fs *flag.FlagSet, args []string, out io.Writer) error {
+	cfg, err := parseConfiguration(fs, args)
+	if err != nil {
+		return fmt.Errorf("error parsing configuration: %w", err)
+	}
+
+	migrationScheme := apiruntime.NewScheme()
+	utilruntime.Must(scheme.AddToScheme(migrationScheme))
+	utilruntime.Must(akov2.AddToScheme(migrationScheme))
+	utilruntime.Must(akov2generated.AddToScheme(migrationScheme))
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+	kubeClient, err := client.New(restConfig, client.Options{Scheme: migrationScheme})
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if cfg.VerifyOnly {
+		pairs, err := Load(ctx, kubeClient, cfg.Namespace)
+		if err != nil {
+			return err
+		}
+		if err := Verify(ctx, kubeClient, pairs); err != nil {
+			return fmt.Errorf("verification failed:\n%w", err)
+		}
+		fmt.Fprintf(out, "verified %d migrated resources\n", len(pairs))
+		return nil
+	}
+
+	translators, err := exporter.NewTranslators(migrationScheme)
+	if err != nil {
+		return err
+	}
+	globalSecretRef := client.ObjectKey{Namespace: cfg.OperatorNamespace, Name: cfg.GlobalAPISecretName}
+	provider := atlas.NewProductionProvider(cfg.AtlasDomain, false, false)
+	plan, err := NewPlan(ctx, kubeClient, provider, translators, globalSecretRef, cfg.Namespace)
+	if err != nil {
+		return err
+	}
+
+	projects := make([]exporter.Project, 0, len(plan.Migrations))
+	for _, migration := range plan.Migrations {
+		projects = append(projects, exporter.Project{Name: migration.Project.Name, Resources: migration.Generated()})
+	}
+	if err := exporter.Write(cfg.OutputDir, projects, cfg.Namespace); err != nil {
+		return err
+	}
+	printPlan(out, plan)
+
+	if !cfg.Apply {
+		fmt.Fprintf(out, "generated resources written to %s, run again with --apply to hand the resources off\n", cfg.OutputDir)
+		return nil
+	}
+	for _, migration := range plan.Migrations {
+		if err := HandOff(ctx, kubeClient, migration); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "handed off AtlasProject %s, waiting for the generated resources to be ready\n", migration.Project.Name)
+		if err := Wait(ctx, kubeClient, migration, cfg.Timeout); err != nil {
+			return err
+		}
+		if err := Verify(ctx, kubeClient, migration.Pairs); err != nil {
+			return fmt.Errorf("verification of AtlasProject %s failed:\n%w", migration.Project.Name, err)
+		}
+		fmt.Fprintf(out, "verified AtlasProject %s: %d resources\n", migration.Project.Name, len(migration.Pairs))
+		if migration.Blocked() {
+			fmt.Fprintf(out, "AtlasProject %s keeps reconciling until its blockers are solved, run again with --apply to hand it off\n", migration.Project.Name)
+		}
+	}
+	return nil
+}
+
+func printPlan(out io.Writer, plan *Plan) {
+	for _, migration := range plan.Migrations {
+		fmt.Fprintf(out, "AtlasProject %s:\n", migration.Project.Name)
+		for _, pair := range migration.Pairs {
+			fmt.Fprintf(out, "  %s -> %s\n", legacyReference(pair.Legacy), describe(pair.Generated))
+		}
+		for _, warning := range migration.Warnings {
+			fmt.Fprintf(out, "  warning: %s\n", warning)
+		}
+		for _, blocker := range migration.Blockers {
+			fmt.Fprintf(out, "  blocked: %s\n", blocker)
+		}
+	}
+	for _, warning := range plan.Warnings {
+		fmt.Fprintf(out, "warning: %s\n", warning)
+	}
+}
+
+func parseConfiguration(fs *flag.FlagSet, args []string) (*config, error) {
+	cfg := &config{}
+	fs.StringVar(&cfg.Namespace, "namespace", "", "the namespace of the legacy resources to migrate.")
+	fs.StringVar(&cfg.OutputDir, "output", "migration", "the directory the generated resources are written to.")
+	fs.BoolVar(&cfg.Apply, "apply", false, "hand the legacy resources off to the generated resources and verify them.")
+	fs.BoolVar(&cfg.VerifyOnly, "verify", false, "only verify the resources handed off by a previous run.")
+	fs.DurationVar(&cfg.Timeout, "timeout", 30*time.Minute, "how long to wait for the generated resources of a project to be ready.")
+	fs.StringVar(&cfg.OperatorNamespace, "operator-namespace", "mongodb-atlas-system", "the namespace of the operator global Secret.")
+	fs.StringVar(&cfg.GlobalAPISecretName, "global-api-secret-name", "mongodb-atlas-operator-api-key", "the name of the operator global Secret, used by the AtlasProjects without connection Secret.")
+	fs.StringVar(&cfg.AtlasDomain, "atlas-domain", operator.DefaultAtlasDomain, "the Atlas URL domain name (with slash in the end).")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if cfg.Namespace == "" {
+		return nil, errors.New("--namespace must be set")
+	}
+	if cfg.Apply && cfg.VerifyOnly {
+		return nil, errors.New("--apply and --verify are mutually exclusive")
+	}
+	return cfg, nil
+}
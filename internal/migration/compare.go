@@ -0,0 +1,241 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+// sameSpec compares the legacy spec with the spec of the generated resource, field by field.
+// The generated resource is ready, so Atlas matches its spec: the comparison shows whether
+// the legacy spec still describes the same Atlas state, whatever its stale status says.
+func sameSpec(legacy, generated any) error {
+	switch legacy := legacy.(type) {
+	case *akov2.AtlasProject:
+		if group, ok := generated.(*akov2generated.Group); ok {
+			return sameProject(legacy, group)
+		}
+	case *akov2.AtlasDeployment:
+		switch generated := generated.(type) {
+		case *akov2generated.Cluster:
+			return sameCluster(legacy, generated)
+		case *akov2generated.FlexCluster:
+			return sameFlexCluster(legacy, generated)
+		}
+	case *akov2.AtlasDatabaseUser:
+		if user, ok := generated.(*akov2generated.DatabaseUser); ok {
+			return sameDatabaseUser(legacy, user)
+		}
+	}
+	return nil
+}
+
+func sameProject(atlasProject *akov2.AtlasProject, group *akov2generated.Group) error {
+	if group.Spec.V20250312 == nil || group.Spec.V20250312.Entry == nil {
+		return fmt.Errorf("%s has no spec", describe(group))
+	}
+	entry := group.Spec.V20250312.Entry
+	var diffs []string
+	if atlasProject.Spec.Name != entry.Name {
+		diffs = append(diffs, difference("name", atlasProject.Spec.Name, entry.Name))
+	}
+	// The legacy operator leaves the tags of the project alone when they are omitted.
+	if atlasProject.Spec.Tags != nil {
+		legacyTags := make([]string, 0, len(atlasProject.Spec.Tags))
+		for _, tag := range atlasProject.Spec.Tags {
+			legacyTags = append(legacyTags, tag.Key+"="+tag.Value)
+		}
+		var tags []string
+		for _, tag := range pointer.GetOrDefault(entry.Tags, nil) {
+			tags = append(tags, tag.Key+"="+tag.Value)
+		}
+		diffs = append(diffs, sameSet("tags", legacyTags, tags)...)
+	}
+	return differences(diffs)
+}
+
+func sameCluster(deployment *akov2.AtlasDeployment, cluster *akov2generated.Cluster) error {
+	if deployment.Spec.DeploymentSpec == nil || cluster.Spec.V20250312 == nil || cluster.Spec.V20250312.Entry == nil {
+		return fmt.Errorf("%s or %s has no spec", legacyReference(deployment), describe(cluster))
+	}
+	legacyReplicationSpecs := deployment.Spec.DeploymentSpec.ReplicationSpecs
+	replicationSpecs := pointer.GetOrDefault(cluster.Spec.V20250312.Entry.ReplicationSpecs, nil)
+	if len(legacyReplicationSpecs) != len(replicationSpecs) {
+		return differences([]string{difference("number of replicationSpecs", len(legacyReplicationSpecs), len(replicationSpecs))})
+	}
+
+	var diffs []string
+	for i, legacyReplicationSpec := range legacyReplicationSpecs {
+		path := fmt.Sprintf("replicationSpecs[%d]", i)
+		regionConfigs := map[string]akov2generated.RegionConfigs{}
+		for _, regionConfig := range pointer.GetOrDefault(replicationSpecs[i].RegionConfigs, nil) {
+			regionConfigs[location(pointer.GetOrDefault(regionConfig.ProviderName, ""), pointer.GetOrDefault(regionConfig.BackingProviderName, ""), pointer.GetOrDefault(regionConfig.RegionName, ""))] = regionConfig
+		}
+		legacyLocations := make([]string, 0, len(legacyReplicationSpec.RegionConfigs))
+		for _, legacyRegionConfig := range legacyReplicationSpec.RegionConfigs {
+			legacyLocations = append(legacyLocations, location(legacyRegionConfig.ProviderName, legacyRegionConfig.BackingProviderName, legacyRegionConfig.RegionName))
+		}
+		locations := slices.Collect(maps.Keys(regionConfigs))
+		if regionDiffs := sameSet(path+" regions", legacyLocations, locations); len(regionDiffs) > 0 {
+			diffs = append(diffs, regionDiffs...)
+			continue
+		}
+		for _, legacyRegionConfig := range legacyReplicationSpec.RegionConfigs {
+			regionLocation := location(legacyRegionConfig.ProviderName, legacyRegionConfig.BackingProviderName, legacyRegionConfig.RegionName)
+			diffs = append(diffs, sameRegion(path+" "+regionLocation, legacyRegionConfig, regionConfigs[regionLocation])...)
+		}
+	}
+	return differences(diffs)
+}
+
+// sameRegion compares the hardware of a region. The instance sizes of the regions with compute auto-scaling
+// are left out, Atlas changes them without updating the legacy spec.
+func sameRegion(path string, legacy *akov2.AdvancedRegionConfig, generated akov2generated.RegionConfigs) []string {
+	autoScaled := isAutoScaled(legacy.AutoScaling)
+	analyticsAutoScaled := isAutoScaled(legacy.AnalyticsAutoScaling)
+
+	electable := nodes{}
+	if generated.ElectableSpecs != nil {
+		electable = nodes{count: generated.ElectableSpecs.NodeCount, instanceSize: pointer.GetOrDefault(generated.ElectableSpecs.InstanceSize, "")}
+	}
+	diffs := sameNodes(path+" electableSpecs", legacyNodes(legacy.ElectableSpecs), electable, autoScaled)
+	diffs = append(diffs, sameNodes(path+" readOnlySpecs", legacyNodes(legacy.ReadOnlySpecs), generatedNodes(generated.ReadOnlySpecs), autoScaled)...)
+	return append(diffs, sameNodes(path+" analyticsSpecs", legacyNodes(legacy.AnalyticsSpecs), generatedNodes(generated.AnalyticsSpecs), analyticsAutoScaled)...)
+}
+
+type nodes struct {
+	count        *int
+	instanceSize string
+}
+
+func legacyNodes(specs *akov2.Specs) nodes {
+	if specs == nil {
+		return nodes{}
+	}
+	return nodes{count: specs.NodeCount, instanceSize: specs.InstanceSize}
+}
+
+func generatedNodes(specs *akov2generated.AnalyticsSpecs) nodes {
+	if specs == nil {
+		return nodes{}
+	}
+	return nodes{count: specs.NodeCount, instanceSize: pointer.GetOrDefault(specs.InstanceSize, "")}
+}
+
+// sameNodes compares the node counts when both specs set them, shared tenant regions have none, and the
+// instance sizes of the nodes that exist.
+func sameNodes(path string, legacy, generated nodes, autoScaled bool) []string {
+	var diffs []string
+	if legacy.count != nil && generated.count != nil && *legacy.count != *generated.count {
+		diffs = append(diffs, difference(path+" nodeCount", *legacy.count, *generated.count))
+	}
+	hasNodes := pointer.GetOrDefault(legacy.count, 0) > 0 || pointer.GetOrDefault(generated.count, 0) > 0 ||
+		(legacy.count == nil && generated.count == nil && legacy.instanceSize != "")
+	if hasNodes && !autoScaled && legacy.instanceSize != generated.instanceSize {
+		diffs = append(diffs, difference(path+" instanceSize", legacy.instanceSize, generated.instanceSize))
+	}
+	return diffs
+}
+
+func isAutoScaled(autoScaling *akov2.AdvancedAutoScalingSpec) bool {
+	return autoScaling != nil && autoScaling.Compute != nil && pointer.GetOrDefault(autoScaling.Compute.Enabled, false)
+}
+
+// location identifies a region config, shared tenant regions by their backing provider.
+func location(providerName, backingProviderName, regionName string) string {
+	if providerName == "TENANT" {
+		return backingProviderName + "/" + regionName
+	}
+	return providerName + "/" + regionName
+}
+
+func sameFlexCluster(deployment *akov2.AtlasDeployment, flexCluster *akov2generated.FlexCluster) error {
+	if deployment.Spec.FlexSpec == nil || deployment.Spec.FlexSpec.ProviderSettings == nil || flexCluster.Spec.V20250312 == nil || flexCluster.Spec.V20250312.Entry == nil {
+		return fmt.Errorf("%s or %s has no spec", legacyReference(deployment), describe(flexCluster))
+	}
+	legacySettings := deployment.Spec.FlexSpec.ProviderSettings
+	settings := flexCluster.Spec.V20250312.Entry.ProviderSettings
+	var diffs []string
+	if legacySettings.BackingProviderName != settings.BackingProviderName {
+		diffs = append(diffs, difference("backingProviderName", legacySettings.BackingProviderName, settings.BackingProviderName))
+	}
+	if legacySettings.RegionName != settings.RegionName {
+		diffs = append(diffs, difference("regionName", legacySettings.RegionName, settings.RegionName))
+	}
+	return differences(diffs)
+}
+
+func sameDatabaseUser(user *akov2.AtlasDatabaseUser, generated *akov2generated.DatabaseUser) error {
+	if generated.Spec.V20250312 == nil || generated.Spec.V20250312.Entry == nil {
+		return fmt.Errorf("%s has no spec", describe(generated))
+	}
+	entry := generated.Spec.V20250312.Entry
+
+	legacyRoles := make([]string, 0, len(user.Spec.Roles))
+	for _, role := range user.Spec.Roles {
+		legacyRoles = append(legacyRoles, roleString(role.RoleName, role.DatabaseName, role.CollectionName))
+	}
+	roles := make([]string, 0, len(entry.Roles))
+	for _, role := range entry.Roles {
+		roles = append(roles, roleString(role.RoleName, role.DatabaseName, pointer.GetOrDefault(role.CollectionName, "")))
+	}
+
+	legacyScopes := make([]string, 0, len(user.Spec.Scopes))
+	for _, scope := range user.Spec.Scopes {
+		legacyScopes = append(legacyScopes, string(scope.Type)+"/"+scope.Name)
+	}
+	var scopes []string
+	for _, scope := range pointer.GetOrDefault(entry.Scopes, nil) {
+		scopes = append(scopes, scope.Type+"/"+scope.Name)
+	}
+
+	return differences(append(sameSet("roles", legacyRoles, roles), sameSet("scopes", legacyScopes, scopes)...))
+}
+
+func roleString(roleName, databaseName, collectionName string) string {
+	role := roleName + "@" + databaseName
+	if collectionName != "" {
+		role += "." + collectionName
+	}
+	return role
+}
+
+// sameSet compares two lists regardless of their order.
+func sameSet(field string, legacy, generated []string) []string {
+	slices.Sort(legacy)
+	slices.Sort(generated)
+	if slices.Equal(legacy, generated) {
+		return nil
+	}
+	return []string{difference(field, legacy, generated)}
+}
+
+func difference(field string, legacy, generated any) string {
+	return fmt.Sprintf("%s differ: %v and %v", field, legacy, generated)
+}
+
+func differences(diffs []string) error {
+	if len(diffs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the specs differ: %s", strings.Join(diffs, "; "))
+}
@@ -0,0 +1,319 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+)
+
+const (
+	readyCondition = "Ready"
+
+	pollInterval = 10 * time.Second
+)
+
+// HandOff stops the legacy resources from managing Atlas and creates the generated resources importing
+// the same Atlas resources. The legacy resources are set to be kept in Atlas and skipped before any generated
+// resource is created, so that the two never reconcile the same Atlas resource at the same time.
+// The AtlasProject of a blocked migration keeps reconciling, so that the resources and settings that are not
+// migrated stay managed, running the hand-off again once the blockers are solved hands it off.
+// Generated resources that already exist are left untouched, so that an interrupted hand-off can be resumed.
+func HandOff(ctx context.Context, kubeClient client.Client, migration *Migration) error {
+	handedOff := map[client.Object]bool{}
+	for _, pair := range migration.Pairs {
+		if handedOff[pair.Legacy] {
+			continue
+		}
+		if _, ok := pair.Legacy.(*akov2.AtlasProject); ok && migration.Blocked() {
+			continue
+		}
+		if err := stopReconciling(ctx, kubeClient, pair.Legacy); err != nil {
+			return err
+		}
+		handedOff[pair.Legacy] = true
+	}
+
+	for _, pair := range migration.Pairs {
+		if err := kubeClient.Create(ctx, pair.Generated); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create %s: %w", describe(pair.Generated), err)
+		}
+	}
+	return nil
+}
+
+func stopReconciling(ctx context.Context, kubeClient client.Client, legacy client.Object) error {
+	patch := client.MergeFrom(legacy.DeepCopyObject().(client.Object))
+	annotations := legacy.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[customresource.ResourcePolicyAnnotation] = customresource.ResourcePolicyKeep
+	annotations[customresource.ReconciliationPolicyAnnotation] = customresource.ReconciliationPolicySkip
+	legacy.SetAnnotations(annotations)
+	if err := kubeClient.Patch(ctx, legacy, patch); err != nil {
+		return fmt.Errorf("failed to stop reconciling %s: %w", legacyReference(legacy), err)
+	}
+	return nil
+}
+
+// Wait waits for all generated resources of the migration to be ready, which means they imported their Atlas resource.
+func Wait(ctx context.Context, reader client.Reader, migration *Migration, timeout time.Duration) error {
+	var pending []string
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		pending = pending[:0]
+		for _, pair := range migration.Pairs {
+			if err := reader.Get(ctx, client.ObjectKeyFromObject(pair.Generated), pair.Generated); err != nil {
+				return false, fmt.Errorf("failed to get %s: %w", describe(pair.Generated), err)
+			}
+			if !isGeneratedReady(pair.Generated) {
+				pending = append(pending, describe(pair.Generated))
+			}
+		}
+		return len(pending) == 0, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out waiting for %s to be ready", strings.Join(pending, ", "))
+	}
+	return err
+}
+
+// Load reads the pairs of legacy and generated resources of a namespace that were handed off,
+// from the MigratedFromAnnotation of the generated resources.
+func Load(ctx context.Context, reader client.Reader, namespace string) ([]Pair, error) {
+	lists := []client.ObjectList{
+		&akov2generated.GroupList{},
+		&akov2generated.ClusterList{},
+		&akov2generated.FlexClusterList{},
+		&akov2generated.DatabaseUserList{},
+		&akov2generated.IPAccessListEntryList{},
+	}
+	var pairs []Pair
+	for _, list := range lists {
+		if err := reader.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list %T: %w", list, err)
+		}
+		err := meta.EachListItem(list, func(obj runtime.Object) error {
+			generated := obj.(client.Object)
+			from, ok := generated.GetAnnotations()[MigratedFromAnnotation]
+			if !ok {
+				return nil
+			}
+			legacy, err := getLegacy(ctx, reader, namespace, from)
+			if err != nil {
+				return fmt.Errorf("%s: %w", describe(generated), err)
+			}
+			pairs = append(pairs, Pair{Legacy: legacy, Generated: generated})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pairs, nil
+}
+
+func getLegacy(ctx context.Context, reader client.Reader, namespace, reference string) (client.Object, error) {
+	kind, name, _ := strings.Cut(reference, "/")
+	var legacy client.Object
+	switch kind {
+	case "AtlasProject":
+		legacy = &akov2.AtlasProject{}
+	case "AtlasDeployment":
+		legacy = &akov2.AtlasDeployment{}
+	case "AtlasDatabaseUser":
+		legacy = &akov2.AtlasDatabaseUser{}
+	default:
+		return nil, fmt.Errorf("unsupported legacy resource %s", reference)
+	}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, legacy); err != nil {
+		return nil, fmt.Errorf("failed to get legacy resource %s: %w", reference, err)
+	}
+	return legacy, nil
+}
+
+// Verify checks that each legacy and generated resource describe the same Atlas state: the generated resource
+// is ready, so Atlas matches its spec, both refer to the same Atlas resource and their specs match field by field.
+// The conditions of the legacy resources are not used, they are no longer updated once the resources are skipped.
+func Verify(ctx context.Context, reader client.Reader, pairs []Pair) error {
+	var errs []error
+	for _, pair := range pairs {
+		if err := verify(ctx, reader, pair); err != nil {
+			errs = append(errs, fmt.Errorf("%s and %s: %w", legacyReference(pair.Legacy), describe(pair.Generated), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func verify(ctx context.Context, reader client.Reader, pair Pair) error {
+	if !isGeneratedReady(pair.Generated) {
+		return errors.New("generated resource is not ready")
+	}
+
+	legacyProjectID, err := legacyProjectID(ctx, reader, pair.Legacy)
+	if err != nil {
+		return err
+	}
+	generatedProjectID, err := generatedProjectID(ctx, reader, pair.Generated)
+	if err != nil {
+		return err
+	}
+	if legacyProjectID != generatedProjectID {
+		return fmt.Errorf("the Atlas projects differ: %s and %s", legacyProjectID, generatedProjectID)
+	}
+
+	externalID := pair.Generated.GetAnnotations()[exporter.ExternalIDAnnotation]
+	switch legacy := pair.Legacy.(type) {
+	case *akov2.AtlasProject:
+		if _, ok := pair.Generated.(*akov2generated.IPAccessListEntry); ok {
+			if !slices.ContainsFunc(legacy.Spec.ProjectIPAccessList, func(entry project.IPAccessList) bool { return ipAccessListValue(entry) == externalID }) {
+				return fmt.Errorf("IP access list entry %s is not in the projectIpAccessList", externalID)
+			}
+		}
+	case *akov2.AtlasDeployment:
+		if externalID != legacy.GetDeploymentName() {
+			return fmt.Errorf("the Atlas deployments differ: %s and %s", legacy.GetDeploymentName(), externalID)
+		}
+		if cluster, ok := pair.Generated.(*akov2generated.Cluster); ok {
+			if err := sameMongoDBVersion(legacy, cluster); err != nil {
+				return err
+			}
+		}
+	case *akov2.AtlasDatabaseUser:
+		if externalID != databaseUserID(legacy) {
+			return fmt.Errorf("the Atlas database users differ: %s and %s", databaseUserID(legacy), externalID)
+		}
+	}
+	return sameSpec(pair.Legacy, pair.Generated)
+}
+
+func legacyProjectID(ctx context.Context, reader client.Reader, legacy client.Object) (string, error) {
+	var ref *akov2.ProjectDualReference
+	switch resource := legacy.(type) {
+	case *akov2.AtlasProject:
+		return resource.Status.ID, nil
+	case *akov2.AtlasDeployment:
+		ref = resource.ProjectDualRef()
+	case *akov2.AtlasDatabaseUser:
+		ref = resource.ProjectDualRef()
+	}
+	if ref == nil || ref.ProjectRef == nil {
+		return "", fmt.Errorf("%s has no project reference", legacyReference(legacy))
+	}
+	atlasProject := &akov2.AtlasProject{}
+	if err := reader.Get(ctx, *ref.ProjectRef.GetObject(legacy.GetNamespace()), atlasProject); err != nil {
+		return "", fmt.Errorf("failed to get the AtlasProject of %s: %w", legacyReference(legacy), err)
+	}
+	return atlasProject.Status.ID, nil
+}
+
+func generatedProjectID(ctx context.Context, reader client.Reader, generated client.Object) (string, error) {
+	if group, ok := generated.(*akov2generated.Group); ok {
+		return groupID(group), nil
+	}
+	groupName := groupRefName(generated)
+	if groupName == "" {
+		return "", fmt.Errorf("%s has no groupRef", describe(generated))
+	}
+	group := &akov2generated.Group{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: generated.GetNamespace(), Name: groupName}, group); err != nil {
+		return "", fmt.Errorf("failed to get the Group of %s: %w", describe(generated), err)
+	}
+	return groupID(group), nil
+}
+
+func groupID(group *akov2generated.Group) string {
+	if group.Status.V20250312 == nil || group.Status.V20250312.Id == nil {
+		return ""
+	}
+	return *group.Status.V20250312.Id
+}
+
+func groupRefName(generated client.Object) string {
+	switch resource := generated.(type) {
+	case *akov2generated.Cluster:
+		if resource.Spec.V20250312 != nil && resource.Spec.V20250312.GroupRef != nil {
+			return resource.Spec.V20250312.GroupRef.Name
+		}
+	case *akov2generated.FlexCluster:
+		if resource.Spec.V20250312 != nil && resource.Spec.V20250312.GroupRef != nil {
+			return resource.Spec.V20250312.GroupRef.Name
+		}
+	case *akov2generated.DatabaseUser:
+		if resource.Spec.V20250312 != nil && resource.Spec.V20250312.GroupRef != nil {
+			return resource.Spec.V20250312.GroupRef.Name
+		}
+	case *akov2generated.IPAccessListEntry:
+		if resource.Spec.V20250312 != nil && resource.Spec.V20250312.GroupRef != nil {
+			return resource.Spec.V20250312.GroupRef.Name
+		}
+	}
+	return ""
+}
+
+// sameMongoDBVersion compares the major and minor version reported by the legacy deployment
+// to the full version reported by the generated cluster.
+func sameMongoDBVersion(deployment *akov2.AtlasDeployment, cluster *akov2generated.Cluster) error {
+	legacyVersion := deployment.Status.MongoDBVersion
+	if legacyVersion == "" || cluster.Status.V20250312 == nil || cluster.Status.V20250312.MongoDBVersion == nil {
+		return nil
+	}
+	version := *cluster.Status.V20250312.MongoDBVersion
+	if version != legacyVersion && !strings.HasPrefix(version, legacyVersion+".") {
+		return fmt.Errorf("the MongoDB versions differ: %s and %s", legacyVersion, version)
+	}
+	return nil
+}
+
+func isGeneratedReady(generated client.Object) bool {
+	resource, ok := generated.(interface{ GetConditions() []metav1.Condition })
+	return ok && meta.IsStatusConditionTrue(resource.GetConditions(), readyCondition)
+}
+
+// describe returns the kind and name of a generated resource. The kind is not taken from the
+// object as it is not set on objects read through a typed client.
+func describe(generated client.Object) string {
+	switch generated.(type) {
+	case *akov2generated.Group:
+		return "Group/" + generated.GetName()
+	case *akov2generated.Cluster:
+		return "Cluster/" + generated.GetName()
+	case *akov2generated.FlexCluster:
+		return "FlexCluster/" + generated.GetName()
+	case *akov2generated.DatabaseUser:
+		return "DatabaseUser/" + generated.GetName()
+	case *akov2generated.IPAccessListEntry:
+		return "IPAccessListEntry/" + generated.GetName()
+	default:
+		return fmt.Sprintf("%T/%s", generated, generated.GetName())
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration hands the Atlas resources managed by the AtlasProject, AtlasDeployment and
+// AtlasDatabaseUser custom resources over to the Group, Cluster, FlexCluster, DatabaseUser and
+// IPAccessListEntry custom resources of the generated API.
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crd2go/crd2go/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+)
+
+const (
+	// MigratedFromAnnotation is set on the generated resources, it holds the kind and name
+	// of the legacy resource that managed the same Atlas resource before the migration.
+	MigratedFromAnnotation = "mongodb.com/migrated-from"
+
+	defaultDatabaseName = "admin"
+)
+
+// Pair is a legacy resource and the generated resource replacing it.
+// The IP access list entries of an AtlasProject are each paired with the AtlasProject.
+type Pair struct {
+	Legacy    client.Object
+	Generated client.Object
+}
+
+// Migration is the set of generated resources replacing the legacy resources of an AtlasProject.
+type Migration struct {
+	Project *akov2.AtlasProject
+	Pairs   []Pair
+	// Warnings lists the legacy resources that are not migrated, and why.
+	Warnings []string
+	// Blockers lists why the AtlasProject keeps reconciling after the hand-off: the deployments and users
+	// of the project that are not migrated, and the project settings no generated kind manages.
+	// The IP access list entries of a blocked project are not handed off either.
+	Blockers []string
+}
+
+// Blocked returns whether the AtlasProject has to keep reconciling after the hand-off.
+func (m *Migration) Blocked() bool {
+	return len(m.Blockers) > 0
+}
+
+// Generated returns the generated resources of the migration, Group first.
+func (m *Migration) Generated() []client.Object {
+	resources := make([]client.Object, 0, len(m.Pairs))
+	for _, pair := range m.Pairs {
+		resources = append(resources, pair.Generated)
+	}
+	return resources
+}
+
+// NewMigration pairs the legacy resources of the project with the resources exported from its Atlas project.
+// Only the legacy resources that are ready are migrated, so that the exported Atlas state is the state they describe.
+// The legacy resources that can't be migrated block the hand-off of the AtlasProject, see Migration.Blockers.
+func NewMigration(atlasProject *akov2.AtlasProject, deployments []akov2.AtlasDeployment, users []akov2.AtlasDatabaseUser, exported *exporter.Project) (*Migration, error) {
+	m := &Migration{Project: atlasProject, Blockers: unmanagedSettings(atlasProject)}
+
+	exportedByID := map[string]client.Object{}
+	var group *akov2generated.Group
+	for _, obj := range exported.Resources {
+		if g, ok := obj.(*akov2generated.Group); ok {
+			group = g
+		}
+		exportedByID[key(obj.GetObjectKind().GroupVersionKind().Kind, obj.GetAnnotations()[exporter.ExternalIDAnnotation])] = obj
+	}
+	if group == nil {
+		return nil, fmt.Errorf("no Group exported for AtlasProject %s", atlasProject.Name)
+	}
+	groupRef := &k8s.LocalReference{Name: atlasProject.Name}
+	m.add(atlasProject, group, atlasProject.Name, groupRef)
+
+	for i := range deployments {
+		deployment := &deployments[i]
+		if !m.isReady(deployment) {
+			continue
+		}
+		kind := "Cluster"
+		switch {
+		case deployment.IsServerless():
+			m.Blockers = append(m.Blockers, fmt.Sprintf("AtlasDeployment %s: serverless deployments have no generated kind", deployment.Name))
+			continue
+		case deployment.IsFlex():
+			kind = "FlexCluster"
+		}
+		generated, ok := exportedByID[key(kind, deployment.GetDeploymentName())]
+		if !ok {
+			m.Blockers = append(m.Blockers, fmt.Sprintf("AtlasDeployment %s: %s %s not found in Atlas", deployment.Name, kind, deployment.GetDeploymentName()))
+			continue
+		}
+		m.add(deployment, generated, deployment.Name, groupRef)
+	}
+
+	for i := range users {
+		user := &users[i]
+		if !m.isReady(user) {
+			continue
+		}
+		generated, ok := exportedByID[key("DatabaseUser", databaseUserID(user))]
+		if !ok {
+			m.Blockers = append(m.Blockers, fmt.Sprintf("AtlasDatabaseUser %s: user %s not found in Atlas", user.Name, databaseUserID(user)))
+			continue
+		}
+		// Atlas never returns passwords, the generated user reads the password of the legacy user
+		if spec := generated.(*akov2generated.DatabaseUser).Spec.V20250312; user.Spec.PasswordSecret != nil && spec != nil && spec.Entry != nil {
+			spec.Entry.PasswordSecretRef = &akov2generated.PasswordSecretRef{Name: user.Spec.PasswordSecret.Name}
+		}
+		m.add(user, generated, user.Name, groupRef)
+	}
+
+	// The AtlasProject of a blocked migration keeps managing its IP access list
+	if m.Blocked() {
+		if len(atlasProject.Spec.ProjectIPAccessList) > 0 {
+			m.Warnings = append(m.Warnings, fmt.Sprintf("AtlasProject %s: the IP access list is handed off once the AtlasProject is no longer blocked", atlasProject.Name))
+		}
+		return m, nil
+	}
+	for _, entry := range atlasProject.Spec.ProjectIPAccessList {
+		value := ipAccessListValue(entry)
+		generated, ok := exportedByID[key("IPAccessListEntry", value)]
+		if !ok {
+			m.Warnings = append(m.Warnings, fmt.Sprintf("AtlasProject %s: IP access list entry %s not found in Atlas", atlasProject.Name, value))
+			continue
+		}
+		m.add(atlasProject, generated, fmt.Sprintf("%s-%s", atlasProject.Name, strings.TrimPrefix(generated.GetName(), group.GetName()+"-")), groupRef)
+	}
+
+	return m, nil
+}
+
+// add renames the generated resource after the legacy resource, points it to the migrated Group
+// and records where it is migrated from.
+func (m *Migration) add(legacy, generated client.Object, name string, groupRef *k8s.LocalReference) {
+	generated.SetName(name)
+	generated.SetNamespace(legacy.GetNamespace())
+	annotations := generated.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[MigratedFromAnnotation] = legacyReference(legacy)
+	generated.SetAnnotations(annotations)
+
+	switch resource := generated.(type) {
+	case *akov2generated.Cluster:
+		if resource.Spec.V20250312 != nil {
+			resource.Spec.V20250312.GroupRef = groupRef
+		}
+	case *akov2generated.FlexCluster:
+		if resource.Spec.V20250312 != nil {
+			resource.Spec.V20250312.GroupRef = groupRef
+		}
+	case *akov2generated.DatabaseUser:
+		if resource.Spec.V20250312 != nil {
+			resource.Spec.V20250312.GroupRef = groupRef
+		}
+	case *akov2generated.IPAccessListEntry:
+		if resource.Spec.V20250312 != nil {
+			resource.Spec.V20250312.GroupRef = groupRef
+		}
+	}
+
+	m.Pairs = append(m.Pairs, Pair{Legacy: legacy, Generated: generated})
+}
+
+func (m *Migration) isReady(resource api.AtlasCustomResource) bool {
+	if !api.HasReadyCondition(resource.GetStatus().GetConditions()) {
+		m.Blockers = append(m.Blockers, fmt.Sprintf("%s: not ready, Atlas may not match its spec", legacyReference(resource)))
+		return false
+	}
+	return true
+}
+
+// unmanagedSettings lists the settings of the AtlasProject that no generated kind manages.
+// Skipping the AtlasProject would leave them unmanaged, so they block its hand-off.
+func unmanagedSettings(atlasProject *akov2.AtlasProject) []string {
+	spec := atlasProject.Spec
+	settings := []struct {
+		name string
+		set  bool
+	}{
+		{"maintenanceWindow", spec.MaintenanceWindow != project.MaintenanceWindow{}},
+		{"privateEndpoints", len(spec.PrivateEndpoints) > 0},
+		{"regionalizedPrivateEndpoint", spec.RegionalizedPrivateEndpoint != nil},
+		{"cloudProviderAccessRoles", len(spec.CloudProviderAccessRoles) > 0},
+		{"cloudProviderIntegrations", len(spec.CloudProviderIntegrations) > 0},
+		{"alertConfigurations", len(spec.AlertConfigurations) > 0 || spec.AlertConfigurationSyncEnabled},
+		{"networkPeers", len(spec.NetworkPeers) > 0},
+		{"x509CertRef", spec.X509CertRef != nil},
+		{"integrations", len(spec.Integrations) > 0},
+		{"encryptionAtRest", spec.EncryptionAtRest != nil},
+		{"auditing", spec.Auditing != nil},
+		{"settings", spec.Settings != nil},
+		{"customRoles", len(spec.CustomRoles) > 0},
+		{"teams", len(spec.Teams) > 0},
+		{"backupCompliancePolicyRef", spec.BackupCompliancePolicyRef != nil},
+	}
+	var blockers []string
+	for _, setting := range settings {
+		if setting.set {
+			blockers = append(blockers, fmt.Sprintf("AtlasProject %s: %s has no generated kind", atlasProject.Name, setting.name))
+		}
+	}
+	return blockers
+}
+
+// legacyReference returns the value of the MigratedFromAnnotation for a legacy resource.
+func legacyReference(legacy client.Object) string {
+	switch legacy.(type) {
+	case *akov2.AtlasProject:
+		return "AtlasProject/" + legacy.GetName()
+	case *akov2.AtlasDeployment:
+		return "AtlasDeployment/" + legacy.GetName()
+	case *akov2.AtlasDatabaseUser:
+		return "AtlasDatabaseUser/" + legacy.GetName()
+	default:
+		return fmt.Sprintf("%T/%s", legacy, legacy.GetName())
+	}
+}
+
+func key(kind, externalID string) string {
+	return kind + "/" + externalID
+}
+
+// databaseUserID is the external ID of a database user, as set by the exporter.
+func databaseUserID(user *akov2.AtlasDatabaseUser) string {
+	databaseName := user.Spec.DatabaseName
+	if databaseName == "" {
+		databaseName = defaultDatabaseName
+	}
+	return databaseName + ":" + user.Spec.Username
+}
+
+// ipAccessListValue is the external ID of an IP access list entry, as set by the exporter.
+func ipAccessListValue(entry project.IPAccessList) string {
+	switch {
+	case entry.IPAddress != "":
+		return entry.IPAddress
+	case entry.CIDRBlock != "":
+		return entry.CIDRBlock
+	default:
+		return entry.AwsSecurityGroup
+	}
+}
@@ -0,0 +1,477 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crd2go/crd2go/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	akov2generated "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+)
+
+const projectID = "6523a8b5c4e2f8a1b3d9e7f0"
+
+func TestNewMigration(t *testing.T) {
+	atlasProject := legacyProject()
+	atlasProject.Spec.ProjectIPAccessList = []project.IPAccessList{{CIDRBlock: "10.0.0.0/24"}, {IPAddress: "192.168.0.1"}}
+	deployment := legacyDeployment("orders", "cluster0")
+	serverless := legacyDeployment("serverless", "instance0")
+	serverless.Spec.DeploymentSpec = nil
+	serverless.Spec.ServerlessSpec = &akov2.ServerlessSpec{Name: "instance0"}
+	notReady := legacyDeployment("pending", "cluster1")
+	notReady.Status.Conditions = []api.Condition{api.FalseCondition(api.ReadyType)}
+	user := legacyUser("app", "app-user")
+	user.Spec.PasswordSecret = &common.ResourceRef{Name: "app-password"}
+
+	m, err := NewMigration(atlasProject, []akov2.AtlasDeployment{*deployment, *serverless, *notReady}, []akov2.AtlasDatabaseUser{*user}, exported())
+	require.NoError(t, err)
+
+	generated := map[string]client.Object{}
+	for _, pair := range m.Pairs {
+		generated[describe(pair.Generated)] = pair.Generated
+		assert.Equal(t, legacyReference(pair.Legacy), pair.Generated.GetAnnotations()[MigratedFromAnnotation])
+		assert.Equal(t, "atlas", pair.Generated.GetNamespace())
+	}
+	assert.ElementsMatch(t, []string{
+		"Group/my-project",
+		"Cluster/orders",
+		"DatabaseUser/app",
+	}, keys(generated))
+
+	cluster := generated["Cluster/orders"].(*akov2generated.Cluster)
+	assert.Equal(t, "my-project", cluster.Spec.V20250312.GroupRef.Name)
+	assert.Equal(t, "cluster0", cluster.GetAnnotations()[exporter.ExternalIDAnnotation])
+	dbUser := generated["DatabaseUser/app"].(*akov2generated.DatabaseUser)
+	assert.Equal(t, &akov2generated.PasswordSecretRef{Name: "app-password"}, dbUser.Spec.V20250312.Entry.PasswordSecretRef)
+
+	assert.True(t, m.Blocked())
+	assert.ElementsMatch(t, []string{
+		"AtlasDeployment serverless: serverless deployments have no generated kind",
+		"AtlasDeployment/pending: not ready, Atlas may not match its spec",
+	}, m.Blockers)
+	assert.Equal(t, []string{
+		"AtlasProject my-project: the IP access list is handed off once the AtlasProject is no longer blocked",
+	}, m.Warnings)
+}
+
+func TestNewMigrationBlockers(t *testing.T) {
+	for _, tc := range []struct {
+		title        string
+		project      func(*akov2.AtlasProject)
+		wantPairs    []string
+		wantBlockers []string
+		wantWarnings []string
+	}{
+		{
+			title: "IP access list handed off",
+			project: func(atlasProject *akov2.AtlasProject) {
+				atlasProject.Spec.ProjectIPAccessList = []project.IPAccessList{{CIDRBlock: "10.0.0.0/24"}, {IPAddress: "192.168.0.1"}}
+			},
+			wantPairs:    []string{"Group/my-project", "Cluster/orders", "IPAccessListEntry/my-project-10.0.0.0-24"},
+			wantWarnings: []string{"AtlasProject my-project: IP access list entry 192.168.0.1 not found in Atlas"},
+		},
+		{
+			title: "teams and integrations",
+			project: func(atlasProject *akov2.AtlasProject) {
+				atlasProject.Spec.Teams = []akov2.Team{{TeamRef: common.ResourceRefNamespaced{Name: "admins"}}}
+				atlasProject.Spec.Integrations = []project.Integration{{Type: "DATADOG"}}
+			},
+			wantPairs: []string{"Group/my-project", "Cluster/orders"},
+			wantBlockers: []string{
+				"AtlasProject my-project: integrations has no generated kind",
+				"AtlasProject my-project: teams has no generated kind",
+			},
+		},
+		{
+			title: "maintenance window",
+			project: func(atlasProject *akov2.AtlasProject) {
+				atlasProject.Spec.MaintenanceWindow = project.MaintenanceWindow{DayOfWeek: 1, HourOfDay: 3}
+			},
+			wantPairs:    []string{"Group/my-project", "Cluster/orders"},
+			wantBlockers: []string{"AtlasProject my-project: maintenanceWindow has no generated kind"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			atlasProject := legacyProject()
+			tc.project(atlasProject)
+
+			m, err := NewMigration(atlasProject, []akov2.AtlasDeployment{*legacyDeployment("orders", "cluster0")}, nil, exported())
+			require.NoError(t, err)
+
+			pairs := make([]string, 0, len(m.Pairs))
+			for _, pair := range m.Pairs {
+				pairs = append(pairs, describe(pair.Generated))
+			}
+			assert.Equal(t, tc.wantPairs, pairs)
+			assert.Equal(t, tc.wantBlockers, m.Blockers)
+			assert.Equal(t, tc.wantWarnings, m.Warnings)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	atlasProject := legacyProject()
+	deployment := legacyDeployment("orders", "cluster0")
+	deployment.Status.MongoDBVersion = "8.0"
+	user := legacyUser("app", "app-user")
+	user.Spec.Roles = []akov2.RoleSpec{{RoleName: "readWrite", DatabaseName: "orders"}}
+	user.Spec.Scopes = []akov2.ScopeSpec{{Type: akov2.DeploymentScopeType, Name: "cluster0"}}
+	group := generatedGroup(projectID)
+	cluster := generatedCluster("orders", "cluster0", "8.0.4")
+	dbUser := generatedUser("app", "admin:app-user")
+
+	for _, tc := range []struct {
+		title   string
+		objects []client.Object
+		wantErr []string
+	}{
+		{
+			title:   "same Atlas state",
+			objects: []client.Object{atlasProject, deployment, user, group, cluster, dbUser},
+		},
+		{
+			title: "different Atlas project",
+			objects: []client.Object{
+				atlasProject, deployment, user, generatedGroup("6523a8b5c4e2f8a1b3d9e7f1"), cluster, dbUser,
+			},
+			wantErr: []string{
+				"AtlasProject/my-project and Group/my-project: the Atlas projects differ",
+				"AtlasDeployment/orders and Cluster/orders: the Atlas projects differ",
+				"AtlasDatabaseUser/app and DatabaseUser/app: the Atlas projects differ",
+			},
+		},
+		{
+			title: "different MongoDB version",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, generatedCluster("orders", "cluster0", "7.0.12"), dbUser,
+			},
+			wantErr: []string{"AtlasDeployment/orders and Cluster/orders: the MongoDB versions differ: 8.0 and 7.0.12"},
+		},
+		{
+			title: "different database user",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, cluster, generatedUser("app", "admin:other-user"),
+			},
+			wantErr: []string{"AtlasDatabaseUser/app and DatabaseUser/app: the Atlas database users differ: admin:app-user and admin:other-user"},
+		},
+		{
+			title: "legacy resources no longer updated",
+			objects: []client.Object{
+				atlasProject, func() client.Object {
+					stale := deployment.DeepCopy()
+					stale.Status.Conditions = []api.Condition{api.FalseCondition(api.ReadyType)}
+					return stale
+				}(), user, group, cluster, dbUser,
+			},
+		},
+		{
+			title: "different instance size",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, func() client.Object {
+					resized := cluster.DeepCopy()
+					(*resized.Spec.V20250312.Entry.ReplicationSpecs)[0] = replicationSpec("AWS", "US_EAST_1", "M30")
+					return resized
+				}(), dbUser,
+			},
+			wantErr: []string{"AtlasDeployment/orders and Cluster/orders: the specs differ: replicationSpecs[0] AWS/US_EAST_1 electableSpecs instanceSize differ: M10 and M30"},
+		},
+		{
+			title: "different region",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, func() client.Object {
+					moved := cluster.DeepCopy()
+					(*moved.Spec.V20250312.Entry.ReplicationSpecs)[0] = replicationSpec("AWS", "EU_WEST_1", "M10")
+					return moved
+				}(), dbUser,
+			},
+			wantErr: []string{"AtlasDeployment/orders and Cluster/orders: the specs differ: replicationSpecs[0] regions differ: [AWS/US_EAST_1] and [AWS/EU_WEST_1]"},
+		},
+		{
+			title: "auto-scaled instance size",
+			objects: []client.Object{
+				atlasProject, func() client.Object {
+					autoScaled := deployment.DeepCopy()
+					autoScaled.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs[0].AutoScaling = &akov2.AdvancedAutoScalingSpec{
+						Compute: &akov2.ComputeSpec{Enabled: ptr.To(true)},
+					}
+					return autoScaled
+				}(), user, group, func() client.Object {
+					resized := cluster.DeepCopy()
+					(*resized.Spec.V20250312.Entry.ReplicationSpecs)[0] = replicationSpec("AWS", "US_EAST_1", "M30")
+					return resized
+				}(), dbUser,
+			},
+		},
+		{
+			title: "different roles and scopes",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, cluster, func() client.Object {
+					changed := dbUser.DeepCopy()
+					changed.Spec.V20250312.Entry.Roles = []akov2generated.Roles{{RoleName: "read", DatabaseName: "orders"}}
+					changed.Spec.V20250312.Entry.Scopes = &[]akov2generated.Scopes{{Type: "CLUSTER", Name: "cluster1"}}
+					return changed
+				}(),
+			},
+			wantErr: []string{
+				"AtlasDatabaseUser/app and DatabaseUser/app: the specs differ: roles differ: [readWrite@orders] and [read@orders]",
+				"scopes differ: [CLUSTER/cluster0] and [CLUSTER/cluster1]",
+			},
+		},
+		{
+			title: "generated resource not ready",
+			objects: []client.Object{
+				atlasProject, deployment, user, group, cluster, func() client.Object {
+					notReady := generatedUser("app", "admin:app-user")
+					notReady.Status.Conditions = &[]metav1.Condition{{Type: readyCondition, Status: metav1.ConditionFalse}}
+					return notReady
+				}(),
+			},
+			wantErr: []string{"AtlasDatabaseUser/app and DatabaseUser/app: generated resource is not ready"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			kubeClient := newFakeClient(t, tc.objects...)
+
+			pairs, err := Load(context.Background(), kubeClient, "atlas")
+			require.NoError(t, err)
+			require.Len(t, pairs, 3)
+
+			err = Verify(context.Background(), kubeClient, pairs)
+			if len(tc.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, wantErr := range tc.wantErr {
+				assert.ErrorContains(t, err, wantErr)
+			}
+		})
+	}
+}
+
+func TestHandOff(t *testing.T) {
+	atlasProject := legacyProject()
+	deployment := legacyDeployment("orders", "cluster0")
+	existing := generatedCluster("orders", "cluster0", "8.0.4")
+	kubeClient := newFakeClient(t, atlasProject, deployment, existing)
+	m, err := NewMigration(atlasProject, []akov2.AtlasDeployment{*deployment}, nil, exported())
+	require.NoError(t, err)
+
+	require.NoError(t, HandOff(context.Background(), kubeClient, m))
+
+	for _, legacy := range []client.Object{atlasProject, deployment} {
+		require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKeyFromObject(legacy), legacy))
+		assert.Equal(t, "keep", legacy.GetAnnotations()["mongodb.com/atlas-resource-policy"])
+		assert.Equal(t, "skip", legacy.GetAnnotations()["mongodb.com/atlas-reconciliation-policy"])
+	}
+	group := &akov2generated.Group{}
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKey{Namespace: "atlas", Name: "my-project"}, group))
+	assert.Equal(t, projectID, group.GetAnnotations()[exporter.ExternalIDAnnotation])
+	assert.Equal(t, "AtlasProject/my-project", group.GetAnnotations()[MigratedFromAnnotation])
+	cluster := &akov2generated.Cluster{}
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKey{Namespace: "atlas", Name: "orders"}, cluster))
+	assert.Equal(t, existing.GetAnnotations(), cluster.GetAnnotations(), "existing generated resources are left untouched")
+}
+
+func TestHandOffBlocked(t *testing.T) {
+	atlasProject := legacyProject()
+	atlasProject.Spec.Teams = []akov2.Team{{TeamRef: common.ResourceRefNamespaced{Name: "admins"}}}
+	deployment := legacyDeployment("orders", "cluster0")
+	kubeClient := newFakeClient(t, atlasProject, deployment)
+	m, err := NewMigration(atlasProject, []akov2.AtlasDeployment{*deployment}, nil, exported())
+	require.NoError(t, err)
+
+	require.NoError(t, HandOff(context.Background(), kubeClient, m))
+
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKeyFromObject(atlasProject), atlasProject))
+	assert.NotContains(t, atlasProject.GetAnnotations(), "mongodb.com/atlas-reconciliation-policy", "a blocked AtlasProject keeps reconciling")
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKeyFromObject(deployment), deployment))
+	assert.Equal(t, "skip", deployment.GetAnnotations()["mongodb.com/atlas-reconciliation-policy"])
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKey{Namespace: "atlas", Name: "orders"}, &akov2generated.Cluster{}))
+}
+
+func keys(m map[string]client.Object) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
+
+func ready() api.Common {
+	return api.Common{Conditions: []api.Condition{api.TrueCondition(api.ReadyType)}}
+}
+
+func legacyProject() *akov2.AtlasProject {
+	return &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "atlas"},
+		Spec:       akov2.AtlasProjectSpec{Name: "My Project"},
+		Status:     status.AtlasProjectStatus{Common: ready(), ID: projectID},
+	}
+}
+
+func legacyDeployment(name, clusterName string) *akov2.AtlasDeployment {
+	return &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "atlas"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"}},
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				Name: clusterName,
+				ReplicationSpecs: []*akov2.AdvancedReplicationSpec{{
+					RegionConfigs: []*akov2.AdvancedRegionConfig{{
+						ProviderName:   "AWS",
+						RegionName:     "US_EAST_1",
+						ElectableSpecs: &akov2.Specs{InstanceSize: "M10", NodeCount: ptr.To(3)},
+					}},
+				}},
+			},
+		},
+		Status: status.AtlasDeploymentStatus{Common: ready()},
+	}
+}
+
+func legacyUser(name, username string) *akov2.AtlasDatabaseUser {
+	return &akov2.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "atlas"},
+		Spec: akov2.AtlasDatabaseUserSpec{
+			ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"}},
+			Username:             username,
+		},
+		Status: status.AtlasDatabaseUserStatus{Common: ready()},
+	}
+}
+
+// exported returns the resources the exporter produces for the Atlas project of legacyProject.
+func exported() *exporter.Project {
+	withExternalID := func(obj client.Object, kind, name, externalID string) client.Object {
+		obj.GetObjectKind().SetGroupVersionKind(akov2generated.GroupVersion.WithKind(kind))
+		obj.SetName(name)
+		obj.SetAnnotations(map[string]string{exporter.ExternalIDAnnotation: externalID, "mongodb.com/atlas-resource-policy": "keep"})
+		return obj
+	}
+	return &exporter.Project{
+		Name: "my-project-atlas",
+		Resources: []client.Object{
+			withExternalID(&akov2generated.Group{}, "Group", "my-project-atlas", projectID),
+			withExternalID(&akov2generated.Cluster{
+				Spec: akov2generated.ClusterSpec{V20250312: &akov2generated.ClusterSpecV20250312{}},
+			}, "Cluster", "my-project-atlas-cluster0", "cluster0"),
+			withExternalID(&akov2generated.Cluster{
+				Spec: akov2generated.ClusterSpec{V20250312: &akov2generated.ClusterSpecV20250312{}},
+			}, "Cluster", "my-project-atlas-unmanaged", "unmanaged"),
+			withExternalID(&akov2generated.DatabaseUser{
+				Spec: akov2generated.DatabaseUserSpec{V20250312: &akov2generated.DatabaseUserSpecV20250312{
+					Entry: &akov2generated.DatabaseUserSpecV20250312Entry{DatabaseName: "admin", Username: "app-user"},
+				}},
+			}, "DatabaseUser", "my-project-atlas-app-user", "admin:app-user"),
+			withExternalID(&akov2generated.IPAccessListEntry{
+				Spec: akov2generated.IPAccessListEntrySpec{V20250312: &akov2generated.IPAccessListEntrySpecV20250312{}},
+			}, "IPAccessListEntry", "my-project-atlas-10.0.0.0-24", "10.0.0.0/24"),
+		},
+	}
+}
+
+func generatedReady() *[]metav1.Condition {
+	return &[]metav1.Condition{{Type: readyCondition, Status: metav1.ConditionTrue}}
+}
+
+func generatedGroup(id string) *akov2generated.Group {
+	return &akov2generated.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-project",
+			Namespace:   "atlas",
+			Annotations: map[string]string{exporter.ExternalIDAnnotation: id, MigratedFromAnnotation: "AtlasProject/my-project"},
+		},
+		Spec: akov2generated.GroupSpec{
+			V20250312: &akov2generated.V20250312{Entry: &akov2generated.Entry{Name: "My Project"}},
+		},
+		Status: akov2generated.GroupStatus{Conditions: generatedReady(), V20250312: &akov2generated.GroupStatusV20250312{Id: ptr.To(id)}},
+	}
+}
+
+func generatedCluster(name, clusterName, version string) *akov2generated.Cluster {
+	return &akov2generated.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "atlas",
+			Annotations: map[string]string{exporter.ExternalIDAnnotation: clusterName, MigratedFromAnnotation: "AtlasDeployment/" + name},
+		},
+		Spec: akov2generated.ClusterSpec{
+			V20250312: &akov2generated.ClusterSpecV20250312{
+				GroupRef: &k8s.LocalReference{Name: "my-project"},
+				Entry: &akov2generated.V20250312Entry{
+					ReplicationSpecs: &[]akov2generated.ReplicationSpecs{replicationSpec("AWS", "US_EAST_1", "M10")},
+				},
+			},
+		},
+		Status: akov2generated.ClusterStatus{
+			Conditions: generatedReady(),
+			V20250312:  &akov2generated.ClusterStatusV20250312{MongoDBVersion: ptr.To(version)},
+		},
+	}
+}
+
+func generatedUser(name, externalID string) *akov2generated.DatabaseUser {
+	return &akov2generated.DatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "atlas",
+			Annotations: map[string]string{exporter.ExternalIDAnnotation: externalID, MigratedFromAnnotation: "AtlasDatabaseUser/" + name},
+		},
+		Spec: akov2generated.DatabaseUserSpec{
+			V20250312: &akov2generated.DatabaseUserSpecV20250312{
+				GroupRef: &k8s.LocalReference{Name: "my-project"},
+				Entry: &akov2generated.DatabaseUserSpecV20250312Entry{
+					Roles:  []akov2generated.Roles{{RoleName: "readWrite", DatabaseName: "orders"}},
+					Scopes: &[]akov2generated.Scopes{{Type: "CLUSTER", Name: "cluster0"}},
+				},
+			},
+		},
+		Status: akov2generated.DatabaseUserStatus{Conditions: generatedReady()},
+	}
+}
+
+func replicationSpec(providerName, regionName, instanceSize string) akov2generated.ReplicationSpecs {
+	return akov2generated.ReplicationSpecs{
+		RegionConfigs: &[]akov2generated.RegionConfigs{{
+			ProviderName:   ptr.To(providerName),
+			RegionName:     ptr.To(regionName),
+			ElectableSpecs: &akov2generated.ElectableSpecs{InstanceSize: ptr.To(instanceSize), NodeCount: ptr.To(3)},
+		}},
+	}
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(scheme))
+	require.NoError(t, akov2generated.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
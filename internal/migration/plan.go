@@ -0,0 +1,154 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/crd2go/crapi"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/exporter"
+)
+
+// Plan lists the migrations of the legacy resources of a namespace.
+type Plan struct {
+	Migrations []*Migration
+	// Warnings lists the legacy resources that can't be part of any migration.
+	Warnings []string
+}
+
+// NewPlan reads the legacy resources of the namespace and exports the Atlas projects of their AtlasProjects,
+// with the credentials the operator uses for these projects.
+func NewPlan(ctx context.Context, kubeClient client.Client, provider atlas.Provider, translators map[string]crapi.Translator, globalSecretRef client.ObjectKey, namespace string) (*Plan, error) {
+	projects := &akov2.AtlasProjectList{}
+	if err := kubeClient.List(ctx, projects, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasProjects: %w", err)
+	}
+	deployments := &akov2.AtlasDeploymentList{}
+	if err := kubeClient.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasDeployments: %w", err)
+	}
+	users := &akov2.AtlasDatabaseUserList{}
+	if err := kubeClient.List(ctx, users, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasDatabaseUsers: %w", err)
+	}
+
+	plan := &Plan{}
+	deploymentsByProject := map[client.ObjectKey][]akov2.AtlasDeployment{}
+	for _, deployment := range deployments.Items {
+		projectKey, ok := plan.projectKey("AtlasDeployment", deployment.Name, deployment.ProjectDualRef(), namespace)
+		if ok {
+			deploymentsByProject[projectKey] = append(deploymentsByProject[projectKey], deployment)
+		}
+	}
+	usersByProject := map[client.ObjectKey][]akov2.AtlasDatabaseUser{}
+	for _, user := range users.Items {
+		projectKey, ok := plan.projectKey("AtlasDatabaseUser", user.Name, user.ProjectDualRef(), namespace)
+		if ok {
+			usersByProject[projectKey] = append(usersByProject[projectKey], user)
+		}
+	}
+
+	for i := range projects.Items {
+		atlasProject := &projects.Items[i]
+		projectKey := client.ObjectKeyFromObject(atlasProject)
+		if atlasProject.Status.ID == "" {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("AtlasProject %s: not created in Atlas", atlasProject.Name))
+			continue
+		}
+		if !atlasProject.GetDeletionTimestamp().IsZero() {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("AtlasProject %s: being deleted", atlasProject.Name))
+			continue
+		}
+
+		exported, err := plan.export(ctx, kubeClient, provider, translators, globalSecretRef, atlasProject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export the Atlas project of AtlasProject %s: %w", atlasProject.Name, err)
+		}
+		migration, err := NewMigration(atlasProject, deploymentsByProject[projectKey], usersByProject[projectKey], exported)
+		if err != nil {
+			return nil, err
+		}
+		plan.Migrations = append(plan.Migrations, migration)
+		delete(deploymentsByProject, projectKey)
+		delete(usersByProject, projectKey)
+	}
+
+	for projectKey, deployments := range deploymentsByProject {
+		for _, deployment := range deployments {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("AtlasDeployment %s: AtlasProject %s is not migrated", deployment.Name, projectKey.Name))
+		}
+	}
+	for projectKey, users := range usersByProject {
+		for _, user := range users {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("AtlasDatabaseUser %s: AtlasProject %s is not migrated", user.Name, projectKey.Name))
+		}
+	}
+	slices.Sort(plan.Warnings)
+
+	return plan, nil
+}
+
+// projectKey resolves the AtlasProject of a legacy resource. The generated resources reference their
+// Group in the same namespace, resources of external projects or of other namespaces are not migrated.
+func (p *Plan) projectKey(kind, name string, ref *akov2.ProjectDualReference, namespace string) (client.ObjectKey, bool) {
+	if ref.ProjectRef == nil {
+		p.Warnings = append(p.Warnings, fmt.Sprintf("%s %s: resources referencing an external project are not migrated, use ako export", kind, name))
+		return client.ObjectKey{}, false
+	}
+	projectKey := ref.ProjectRef.GetObject(namespace)
+	if projectKey.Namespace != namespace {
+		p.Warnings = append(p.Warnings, fmt.Sprintf("%s %s: AtlasProject %s is in another namespace", kind, name, projectKey))
+		return client.ObjectKey{}, false
+	}
+	return *projectKey, true
+}
+
+func (p *Plan) export(ctx context.Context, kubeClient client.Client, provider atlas.Provider, translators map[string]crapi.Translator, globalSecretRef client.ObjectKey, atlasProject *akov2.AtlasProject) (*exporter.Project, error) {
+	connectionConfig, err := reconciler.GetConnectionConfig(ctx, kubeClient, atlasProject.ConnectionSecretObjectKey(), &globalSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	clientSet, err := provider.SdkClientSet(ctx, connectionConfig.Credentials, zap.NewNop().Sugar())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Atlas client: %w", err)
+	}
+
+	// the generated resources can only reference a Secret of their own namespace
+	opts := exporter.Options{ProjectIDs: []string{atlasProject.Status.ID}}
+	if secretKey := atlasProject.ConnectionSecretObjectKey(); secretKey != nil {
+		if secretKey.Namespace == atlasProject.Namespace {
+			opts.ConnectionSecret = secretKey.Name
+		} else {
+			p.Warnings = append(p.Warnings, fmt.Sprintf("AtlasProject %s: connection Secret %s is in another namespace, the generated resources use the global Secret", atlasProject.Name, secretKey))
+		}
+	}
+
+	projects, err := exporter.Export(ctx, clientSet.SdkClient20250312, translators, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) != 1 {
+		return nil, fmt.Errorf("expected a single exported project, got %d", len(projects))
+	}
+	return &projects[0], nil
+}
@@ -40,12 +40,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/admission"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/notification"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/secretservice"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
 )
 
 const (
@@ -53,6 +58,7 @@ const (
 	DefaultSyncPeriod            = 3 * time.Hour
 	DefaultIndependentSyncPeriod = 15 * time.Minute
 	DefaultLeaderElectionID      = "06d035fb.mongodb.com"
+	DefaultWebhookPort           = 9443
 )
 
 type ManagerProvider interface {
@@ -88,7 +94,21 @@ type Builder struct {
 	deletionProtection      bool
 	skipNameValidation      bool
 	dryRun                  bool
+	dryRunPlanOutputs       []dryrun.PlanOutput
+	atlasRateLimit          throttle.Config
 	maxConcurrentReconciles int
+	webhooksEnabled         bool
+	webhookPort             int
+	webhookCertDir          string
+	notificationAddress     string
+	notificationSecret      client.ObjectKey
+	notificationService     client.ObjectKey
+	notificationPodIP       string
+	approvalRequired        bool
+	subObjectProtection     bool
+	deletionGracePeriod     time.Duration
+	finalSnapshotRetention  int
+	credentialSources       credentialsource.Policy
 }
 
 func (b *Builder) WithMaxConcurrentReconciles(maxConcurrentReconciles int) *Builder {
@@ -180,6 +200,81 @@ func (b *Builder) WithDryRun(dryRun bool) *Builder {
 	return b
 }
 
+// WithAtlasRateLimit configures the per-organization Atlas API request budget
+// and the retry behavior for rate limited requests.
+func (b *Builder) WithAtlasRateLimit(config throttle.Config) *Builder {
+	b.atlasRateLimit = config
+	return b
+}
+
+// WithDryRunPlanOutputs configures where the dry-run manager writes the structured plan of all intercepted changes.
+func (b *Builder) WithDryRunPlanOutputs(outputs ...dryrun.PlanOutput) *Builder {
+	b.dryRunPlanOutputs = outputs
+	return b
+}
+
+// WithWebhooks enables the admission webhooks. They are never served in dry-run mode.
+func (b *Builder) WithWebhooks(enabled bool) *Builder {
+	b.webhooksEnabled = enabled
+	return b
+}
+
+func (b *Builder) WithWebhookPort(port int) *Builder {
+	b.webhookPort = port
+	return b
+}
+
+// WithWebhookCertDir sets the directory containing the tls.crt and tls.key files of the webhook server,
+// e.g. the mount path of the Secret issued by cert-manager or generated by the helm chart.
+func (b *Builder) WithWebhookCertDir(dir string) *Builder {
+	b.webhookCertDir = dir
+	return b
+}
+
+// WithAtlasNotifications enables the endpoint receiving the notifications of the Atlas webhook integration
+// on the given address. Notifications are verified with the shared secret held by the given Secret.
+func (b *Builder) WithAtlasNotifications(address string, secretRef client.ObjectKey) *Builder {
+	b.notificationAddress = address
+	b.notificationSecret = secretRef
+	return b
+}
+
+// WithAtlasNotificationsService routes the given Service, which has no selector, to the leader receiving the
+// Atlas notifications, with the IP of the Pod of the operator. Ignored when the service name is empty.
+func (b *Builder) WithAtlasNotificationsService(service client.ObjectKey, podIP string) *Builder {
+	b.notificationService = service
+	b.notificationPodIP = podIP
+	return b
+}
+
+// WithDestructiveOperationApproval holds the destructive operations, e.g. deleting a deployment from Atlas,
+// until they are approved with the token recorded in the status of the resource.
+func (b *Builder) WithDestructiveOperationApproval(required bool) *Builder {
+	b.approvalRequired = required
+	return b
+}
+
+// WithSubObjectDeletionProtection keeps the sub-objects of AtlasProjects not created by the operator,
+// e.g. IP access list entries added in the Atlas UI.
+func (b *Builder) WithSubObjectDeletionProtection(protected bool) *Builder {
+	b.subObjectProtection = protected
+	return b
+}
+
+// WithDeploymentDeletion holds the deletion of AtlasDeployments from Atlas during the grace period following the
+// deletion of their resource, and takes a final snapshot retained for the given days, if not zero, before deleting them.
+func (b *Builder) WithDeploymentDeletion(gracePeriod time.Duration, finalSnapshotRetentionDays int) *Builder {
+	b.deletionGracePeriod = gracePeriod
+	b.finalSnapshotRetention = finalSnapshotRetentionDays
+	return b
+}
+
+// WithCredentialSources sets the Vault servers and paths connection Secrets may read the Atlas credentials from.
+func (b *Builder) WithCredentialSources(policy credentialsource.Policy) *Builder {
+	b.credentialSources = policy
+	return b
+}
+
 // Build builds the cluster object and configures operator controllers
 func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 	mergeDefaults(b)
@@ -207,6 +302,8 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		}
 	}
 
+	reconciler.ConfigureCredentialSources(b.credentialSources)
+
 	controllerRegistry := controller.NewRegistry(
 		b.predicates,
 		b.deletionProtection,
@@ -216,7 +313,9 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		b.apiSecret,
 		b.maxConcurrentReconciles,
 		b.atlasDomain,
-	)
+	).WithDestructiveOperationApproval(b.approvalRequired).
+		WithSubObjectDeletionProtection(b.subObjectProtection).
+		WithDeploymentDeletion(b.deletionGracePeriod, b.finalSnapshotRetention)
 
 	var akoCluster cluster.Cluster
 	if b.dryRun {
@@ -237,7 +336,7 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		}
 
 		if b.atlasProvider == nil {
-			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, true, b.logger.Level() < 0)
+			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, true, b.logger.Level() < 0, atlas.WithRateLimit(b.atlasRateLimit))
 		}
 
 		// We cannot use cluster.Cluster's event recorder. This event recorder has no guarantees about the delivery of events to API server.
@@ -254,7 +353,7 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 			return nil, fmt.Errorf("failed to initialize event client: %w", err)
 		}
 
-		mgr, err := dryrun.NewManager(c, corev1Client, b.logger, b.namespaces)
+		mgr, err := dryrun.NewManager(c, corev1Client, b.logger, b.namespaces, dryrun.WithPlanOutputs(b.dryRunPlanOutputs...))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create dry-run manager: %w", err)
 		}
@@ -270,7 +369,8 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 				Scheme:  b.scheme,
 				Metrics: metricsserver.Options{BindAddress: b.metricAddress},
 				WebhookServer: webhook.NewServer(webhook.Options{
-					Port: 9443,
+					Port:    b.webhookPort,
+					CertDir: b.webhookCertDir,
 				}),
 				Cache:                  cacheOpts,
 				HealthProbeBindAddress: b.probeAddress,
@@ -292,12 +392,33 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		}
 
 		if b.atlasProvider == nil {
-			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, false, b.logger.Level() < 0)
+			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, false, b.logger.Level() < 0, atlas.WithRateLimit(b.atlasRateLimit))
+		}
+
+		if b.notificationAddress != "" {
+			receiver := notification.NewReceiver(b.notificationAddress, b.notificationSecret, mgr.GetClient(), mgr.GetAPIReader(), b.logger)
+			if b.notificationService.Name != "" {
+				receiver.WithService(b.notificationService, b.notificationPodIP)
+			}
+			if err = mgr.Add(receiver); err != nil {
+				return nil, err
+			}
+			controllerRegistry.WithNotifications(receiver)
 		}
 
 		if err := controllerRegistry.RegisterWithManager(mgr, b.skipNameValidation, b.atlasProvider); err != nil {
 			return nil, err
 		}
+
+		if b.webhooksEnabled {
+			if err := admission.SetupWithManager(mgr, b.atlasProvider); err != nil {
+				return nil, err
+			}
+
+			if err = mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+				return nil, err
+			}
+		}
 		akoCluster = mgr
 	}
 
@@ -359,4 +480,12 @@ func mergeDefaults(b *Builder) {
 	if b.featureFlags == nil {
 		b.featureFlags = featureflags.NewFeatureFlags(os.Environ)
 	}
+
+	if b.webhookPort == 0 {
+		b.webhookPort = DefaultWebhookPort
+	}
+
+	if b.atlasRateLimit == (throttle.Config{}) {
+		b.atlasRateLimit = throttle.DefaultConfig()
+	}
 }
@@ -42,10 +42,13 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	generatedv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/collection"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
 	generatedexpv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/generated/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/operator"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
@@ -56,7 +59,6 @@ const (
 	subobjectDeletionProtectionEnvVar  = "SUBOBJECT_DELETION_PROTECTION"
 	objectDeletionProtectionDefault    = true
 	subobjectDeletionProtectionDefault = false
-	subobjectDeletionProtectionMessage = "Note: sub-object deletion protection is IGNORED because it does not work deterministically."
 	independentSyncPeriod              = 15 // time in minutes
 	minimumIndependentSyncPeriod       = 5  // time in minutes
 )
@@ -98,16 +100,26 @@ func Run(ctx context.Context, fs *flag.FlagSet, args []string) error {
 		WithAtlasDomain(config.AtlasDomain).
 		WithAPISecret(config.GlobalAPISecret).
 		WithDeletionProtection(config.ObjectDeletionProtection).
-		WithIndependentSyncPeriod(time.Duration(config.IndependentSyncPeriod) * time.Minute).
+		WithDestructiveOperationApproval(config.DestructiveOperationApproval).
+		WithSubObjectDeletionProtection(config.SubObjectDeletionProtection).
+		WithDeploymentDeletion(config.DeploymentDeletionGrace, config.FinalSnapshotRetentionDays).
+		WithIndependentSyncPeriod(time.Duration(config.IndependentSyncPeriod)*time.Minute).
 		WithDryRun(config.DryRun).
+		WithDryRunPlanOutputs(config.DryRunPlanOutputs...).
 		WithMaxConcurrentReconciles(config.MaxConcurrentReconciles).
+		WithAtlasRateLimit(config.AtlasRateLimit).
+		WithWebhooks(config.EnableWebhooks).
+		WithWebhookPort(config.WebhookPort).
+		WithWebhookCertDir(config.WebhookCertDir).
+		WithAtlasNotifications(config.AtlasNotificationsAddr, config.AtlasNotificationsSecret).
+		WithAtlasNotificationsService(config.AtlasNotificationsService, config.AtlasNotificationsPodIP).
+		WithCredentialSources(config.CredentialSources).
 		Build(ctx)
 	if err != nil {
 		setupLog.Error(err, "unable to start operator")
 		return fmt.Errorf("unable to start operator: %w", err)
 	}
 
-	setupLog.Info(subobjectDeletionProtectionMessage)
 	setupLog.Info("starting manager")
 	if err = runnable.Start(ctx); err != nil {
 		setupLog.Errorf("error running manager: %v", err)
@@ -117,25 +129,41 @@ func Run(ctx context.Context, fs *flag.FlagSet, args []string) error {
 }
 
 type Config struct {
-	AtlasDomain                 string
-	EnableLeaderElection        bool
-	MetricsAddr                 string
-	WatchedNamespaces           map[string]bool
-	ProbeAddr                   string
-	GlobalAPISecret             client.ObjectKey
-	LogLevel                    string
-	LogEncoder                  string
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
-	IndependentSyncPeriod       int
-	FeatureFlags                *featureflags.FeatureFlags
-	DryRun                      bool
-	MaxConcurrentReconciles     int
+	AtlasDomain                  string
+	EnableLeaderElection         bool
+	MetricsAddr                  string
+	WatchedNamespaces            map[string]bool
+	ProbeAddr                    string
+	GlobalAPISecret              client.ObjectKey
+	LogLevel                     string
+	LogEncoder                   string
+	ObjectDeletionProtection     bool
+	SubObjectDeletionProtection  bool
+	DestructiveOperationApproval bool
+	DeploymentDeletionGrace      time.Duration
+	FinalSnapshotRetentionDays   int
+	IndependentSyncPeriod        int
+	FeatureFlags                 *featureflags.FeatureFlags
+	DryRun                       bool
+	DryRunPlanOutputs            []dryrun.PlanOutput
+	MaxConcurrentReconciles      int
+	AtlasRateLimit               throttle.Config
+	EnableWebhooks               bool
+	WebhookPort                  int
+	WebhookCertDir               string
+	AtlasNotificationsAddr       string
+	AtlasNotificationsSecret     client.ObjectKey
+	AtlasNotificationsService    client.ObjectKey
+	AtlasNotificationsPodIP      string
+	CredentialSources            credentialsource.Policy
 }
 
 // ParseConfiguration fills the 'OperatorConfig' from the flags passed to the program
 func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 	var globalAPISecretName string
+	var dryRunPlanOutputs string
+	var atlasNotificationsSecretName, atlasNotificationsServiceName string
+	var vaultAddresses, vaultAuthPaths, credentialPaths string
 	config := Config{}
 	fs.StringVar(&config.AtlasDomain, "atlas-domain", operator.DefaultAtlasDomain, "the Atlas URL domain name (with slash in the end).")
 	fs.StringVar(&config.MetricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -150,7 +178,17 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.BoolVar(&config.ObjectDeletionProtection, objectDeletionProtectionFlag, objectDeletionProtectionDefault, "Defines if the operator deletes Atlas resource "+
 		"when a Custom Resource is deleted")
 	fs.BoolVar(&config.SubObjectDeletionProtection, subobjectDeletionProtectionFlag, subobjectDeletionProtectionDefault, "Defines if the operator overwrites "+
-		"(and consequently delete) subresources that were not previously created by the operator. "+subobjectDeletionProtectionMessage)
+		"(and consequently delete) subresources that were not previously created by the operator.")
+	fs.BoolVar(&config.DestructiveOperationApproval, "destructive-operation-approval", false, "Hold the deletion of Atlas projects, deployments and database users, "+
+		"and the disk size decreases, shard removals and version downgrades of deployments until they are approved with the "+
+		"mongodb.com/atlas-approve annotation. The mongodb.com/atlas-approval-policy annotation overrides it per resource. "+
+		"The resources of the atlas.generated.mongodb.com API group are not held")
+	fs.DurationVar(&config.DeploymentDeletionGrace, "deployment-deletion-grace-period", 0, "Time an AtlasDeployment is kept in Atlas after its "+
+		"Custom Resource is deleted, e.g. 24h, during which setting the mongodb.com/atlas-resource-policy=keep annotation cancels the deletion. "+
+		"The mongodb.com/atlas-deletion-grace-period annotation overrides it per resource")
+	fs.IntVar(&config.FinalSnapshotRetentionDays, "deployment-final-snapshot-retention-days", 0, "Retention, in days, of the on-demand snapshot "+
+		"taken before deleting a dedicated AtlasDeployment from Atlas. 0 disables the final snapshot. "+
+		"The mongodb.com/atlas-final-snapshot-retention-days annotation overrides it per resource")
 	fs.IntVar(
 		&config.IndependentSyncPeriod,
 		"independent-sync-period",
@@ -158,7 +196,34 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 		fmt.Sprintf("The default time, in minutes,  between reconciliations for independent custom resources. (default %d, minimum %d)", independentSyncPeriod, minimumIndependentSyncPeriod),
 	)
 	fs.BoolVar(&config.DryRun, "dry-run", false, "If set, the operator will not perform any changes to the Atlas resources, run all reconcilers only Once and emit events for all planned changes")
+	fs.StringVar(&dryRunPlanOutputs, "dry-run-plan-output", "", "Comma separated list of destinations for the structured dry-run plan in addition to events. "+
+		"Available values: configmap (written to <JOB_NAME>-plan in JOB_NAMESPACE) | json | table (both written to stdout)")
 	config.MaxConcurrentReconciles, _ = env.GetInt("MDB_MAX_CONCURRENT_RECONCILES", 5) // errors yield default value
+	config.AtlasRateLimit = throttle.DefaultConfig()
+	fs.Float64Var(&config.AtlasRateLimit.RequestsPerSecond, "atlas-rate-limit", 0, "Maximum sustained number of Atlas API requests per second per Atlas organization, shared by all reconcilers. 0 disables the client side limit")
+	fs.IntVar(&config.AtlasRateLimit.Burst, "atlas-rate-limit-burst", 10, "Maximum number of Atlas API requests per Atlas organization that can be sent at once when --atlas-rate-limit is set")
+	fs.IntVar(&config.AtlasRateLimit.MaxRetries, "atlas-rate-limit-max-retries", throttle.DefaultMaxRetries, "Maximum number of retries of idempotent Atlas API requests rejected with HTTP 429 (Too Many Requests)")
+
+	fs.BoolVar(&config.EnableWebhooks, "enable-webhooks", false, "Serve the admission webhooks for AtlasProject, AtlasDeployment, AtlasDatabaseUser, AtlasPrivateEndpoint, AtlasIPAccessList, "+
+		"Cluster, FlexCluster and IPAccessListEntry")
+	fs.IntVar(&config.WebhookPort, "webhook-port", operator.DefaultWebhookPort, "The port the webhook server binds to when --enable-webhooks is set")
+	fs.StringVar(&config.WebhookCertDir, "webhook-cert-dir", "", "The directory containing the tls.crt and tls.key files of the webhook server. "+
+		"Defaults to <temp-dir>/k8s-webhook-server/serving-certs")
+
+	fs.StringVar(&config.AtlasNotificationsAddr, "atlas-notifications-bind-address", "", "The address the endpoint receiving the notifications "+
+		"of the Atlas webhook integration binds to. Notifications trigger the reconciliation of the AtlasProjects and AtlasDeployments they refer to. Disabled when empty")
+	fs.StringVar(&atlasNotificationsSecretName, "atlas-notifications-secret-name", "", "The name of the Secret, in the operator namespace, "+
+		"holding the shared secret the Atlas notifications are signed with in its \"secret\" key. Required when --atlas-notifications-bind-address is set")
+	fs.StringVar(&atlasNotificationsServiceName, "atlas-notifications-service-name", "", "The name of the Service, in the operator namespace and "+
+		"without selector, routed to the leader receiving the Atlas notifications. The leader publishes the IP of its Pod, read from the "+
+		"OPERATOR_POD_IP environment variable, as the endpoint of the Service")
+
+	fs.StringVar(&vaultAddresses, "credential-source-vault-addresses", "", "Comma separated list of the Vault addresses connection Secrets "+
+		"may read the Atlas credentials from with the vault credential source. The vault source is disabled when empty")
+	fs.StringVar(&vaultAuthPaths, "credential-source-vault-auth-paths", "", "Comma separated list of the mount paths of the Vault Kubernetes "+
+		"auth methods the vault credential source may log in with. Defaults to kubernetes")
+	fs.StringVar(&credentialPaths, "credential-source-paths", "", "Comma separated list of the directories the credentialPath and "+
+		"vaultTokenPath of connection Secrets must be in. The file source and Vault token files are disabled when empty")
 
 	appVersion := fs.Bool("v", false, "prints application version")
 	if err := fs.Parse(args); err != nil {
@@ -172,6 +237,35 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 
 	config.GlobalAPISecret = operatorGlobalKeySecretOrDefault(globalAPISecretName)
 
+	if config.AtlasNotificationsAddr != "" {
+		if atlasNotificationsSecretName == "" {
+			return Config{}, errors.New("--atlas-notifications-secret-name must be set when --atlas-notifications-bind-address is set")
+		}
+		config.AtlasNotificationsSecret = client.ObjectKey{Namespace: config.GlobalAPISecret.Namespace, Name: atlasNotificationsSecretName}
+		if atlasNotificationsServiceName != "" {
+			config.AtlasNotificationsPodIP = os.Getenv("OPERATOR_POD_IP")
+			if config.AtlasNotificationsPodIP == "" {
+				return Config{}, errors.New(`"OPERATOR_POD_IP" environment variable must be set when --atlas-notifications-service-name is set`)
+			}
+			config.AtlasNotificationsService = client.ObjectKey{Namespace: config.GlobalAPISecret.Namespace, Name: atlasNotificationsServiceName}
+		}
+	}
+
+	config.CredentialSources = credentialsource.Policy{
+		VaultAddresses: splitList(vaultAddresses),
+		VaultAuthPaths: splitList(vaultAuthPaths),
+		PathRoots:      splitList(credentialPaths),
+	}
+	if err := config.CredentialSources.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	planOutputs, err := dryrun.ParsePlanOutputs(dryRunPlanOutputs)
+	if err != nil {
+		return Config{}, err
+	}
+	config.DryRunPlanOutputs = planOutputs
+
 	// dev note: we pass the watched namespace as the env variable to use the Kubernetes Downward API. Unfortunately
 	// there is no way to use it for container arguments
 	watchedNamespace := strings.TrimSpace(os.Getenv("WATCH_NAMESPACE"))
@@ -189,6 +283,17 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 	return config, nil
 }
 
+// splitList returns the non empty values of a comma separated list.
+func splitList(list string) []string {
+	var values []string
+	for value := range strings.SplitSeq(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKey {
 	secretName := secretNameOverride
 	if secretName == "" {
@@ -28,7 +28,9 @@ import (
 	"go.uber.org/zap/zapcore"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentialsource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/throttle"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
@@ -266,11 +268,14 @@ func TestInitCustomZapLogger(t *testing.T) {
 }
 
 func TestParseConfiguration(t *testing.T) {
+	defaultRateLimit := throttle.DefaultConfig()
+	defaultRateLimit.Burst = 10
 	os.Setenv("OPERATOR_NAMESPACE", "atlas-operator")
 	os.Setenv("OPERATOR_POD_NAME", "podname-797f946f88-97f2q")
 	for _, tc := range []struct {
 		name    string
 		args    []string
+		podIP   string
 		want    Config
 		wantErr string
 	}{
@@ -295,6 +300,8 @@ func TestParseConfiguration(t *testing.T) {
 				FeatureFlags:                featureflags.NewFeatureFlags(os.Environ),
 				DryRun:                      false,
 				MaxConcurrentReconciles:     5,
+				AtlasRateLimit:              defaultRateLimit,
+				WebhookPort:                 9443,
 			},
 		},
 		{
@@ -323,10 +330,130 @@ func TestParseConfiguration(t *testing.T) {
 				FeatureFlags:                featureflags.NewFeatureFlags(os.Environ),
 				DryRun:                      false,
 				MaxConcurrentReconciles:     5,
+				AtlasRateLimit:              defaultRateLimit,
+				WebhookPort:                 9443,
 			},
 		},
+		{
+			name: "atlas notifications",
+			args: []string{
+				"--global-api-secret-name=mongodb-atlas-operator-api-key",
+				"--atlas-notifications-bind-address=:8082",
+				"--atlas-notifications-secret-name=atlas-notifications",
+			},
+			want: Config{
+				AtlasDomain: "https://cloud.mongodb.com/",
+				MetricsAddr: ":8080",
+				ProbeAddr:   ":8081",
+				GlobalAPISecret: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "mongodb-atlas-operator-api-key",
+				},
+				LogLevel:                 "info",
+				LogEncoder:               "json",
+				ObjectDeletionProtection: true,
+				IndependentSyncPeriod:    15,
+				FeatureFlags:             featureflags.NewFeatureFlags(os.Environ),
+				MaxConcurrentReconciles:  5,
+				AtlasRateLimit:           defaultRateLimit,
+				WebhookPort:              9443,
+				AtlasNotificationsAddr:   ":8082",
+				AtlasNotificationsSecret: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "atlas-notifications",
+				},
+			},
+		},
+		{
+			name: "credential sources",
+			args: []string{
+				"--global-api-secret-name=mongodb-atlas-operator-api-key",
+				"--credential-source-vault-addresses=https://vault-a.example.com:8200, https://vault-b.example.com:8200",
+				"--credential-source-vault-auth-paths=kubernetes-atlas",
+				"--credential-source-paths=/etc/atlas-credentials",
+			},
+			want: Config{
+				AtlasDomain: "https://cloud.mongodb.com/",
+				MetricsAddr: ":8080",
+				ProbeAddr:   ":8081",
+				GlobalAPISecret: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "mongodb-atlas-operator-api-key",
+				},
+				LogLevel:                 "info",
+				LogEncoder:               "json",
+				ObjectDeletionProtection: true,
+				IndependentSyncPeriod:    15,
+				FeatureFlags:             featureflags.NewFeatureFlags(os.Environ),
+				MaxConcurrentReconciles:  5,
+				AtlasRateLimit:           defaultRateLimit,
+				WebhookPort:              9443,
+				CredentialSources: credentialsource.Policy{
+					VaultAddresses: []string{"https://vault-a.example.com:8200", "https://vault-b.example.com:8200"},
+					VaultAuthPaths: []string{"kubernetes-atlas"},
+					PathRoots:      []string{"/etc/atlas-credentials"},
+				},
+			},
+		},
+		{
+			name:    "invalid credential source path",
+			args:    []string{"--credential-source-paths=etc/atlas-credentials"},
+			wantErr: `credential source path "etc/atlas-credentials" must be an absolute path`,
+		},
+		{
+			name: "atlas notifications routed to the leader",
+			args: []string{
+				"--global-api-secret-name=mongodb-atlas-operator-api-key",
+				"--atlas-notifications-bind-address=:8082",
+				"--atlas-notifications-secret-name=atlas-notifications",
+				"--atlas-notifications-service-name=atlas-operator-notifications",
+			},
+			podIP: "10.0.0.12",
+			want: Config{
+				AtlasDomain: "https://cloud.mongodb.com/",
+				MetricsAddr: ":8080",
+				ProbeAddr:   ":8081",
+				GlobalAPISecret: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "mongodb-atlas-operator-api-key",
+				},
+				LogLevel:                 "info",
+				LogEncoder:               "json",
+				ObjectDeletionProtection: true,
+				IndependentSyncPeriod:    15,
+				FeatureFlags:             featureflags.NewFeatureFlags(os.Environ),
+				MaxConcurrentReconciles:  5,
+				AtlasRateLimit:           defaultRateLimit,
+				WebhookPort:              9443,
+				AtlasNotificationsAddr:   ":8082",
+				AtlasNotificationsSecret: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "atlas-notifications",
+				},
+				AtlasNotificationsService: client.ObjectKey{
+					Namespace: "atlas-operator",
+					Name:      "atlas-operator-notifications",
+				},
+				AtlasNotificationsPodIP: "10.0.0.12",
+			},
+		},
+		{
+			name: "atlas notifications service without pod IP",
+			args: []string{
+				"--atlas-notifications-bind-address=:8082",
+				"--atlas-notifications-secret-name=atlas-notifications",
+				"--atlas-notifications-service-name=atlas-operator-notifications",
+			},
+			wantErr: `"OPERATOR_POD_IP" environment variable must be set`,
+		},
+		{
+			name:    "atlas notifications without secret",
+			args:    []string{"--atlas-notifications-bind-address=:8082"},
+			wantErr: "--atlas-notifications-secret-name must be set",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("OPERATOR_POD_IP", tc.podIP)
 			fs := flag.NewFlagSet("test", flag.ContinueOnError)
 			got, err := parseConfiguration(fs, tc.args)
 			if tc.wantErr == "" {
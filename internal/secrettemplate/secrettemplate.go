@@ -0,0 +1,176 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrettemplate renders additional connection Secret keys from Go templates.
+package secrettemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/servicebinding"
+)
+
+// Data is the connection data the templates are rendered with.
+type Data struct {
+	ProjectID      string
+	DeploymentName string
+
+	Username string
+	Password string
+	// AuthDatabase is the database the user authenticates against
+	AuthDatabase string
+
+	// Hosts are the host:port pairs of the standard connection string
+	Hosts []string
+	// Options are the options of the standard connection string
+	Options map[string]string
+
+	// URI and SrvURI are the standard connection strings, including the credentials
+	URI    string
+	SrvURI string
+	// PrivateURIs are the private endpoint connection strings, including the credentials
+	PrivateURIs []PrivateURI
+}
+
+type PrivateURI struct {
+	URI      string
+	SrvURI   string
+	ShardURI string
+}
+
+// reservedKeys are written by the operator itself and can't be rendered by a template.
+var reservedKeys = append([]string{"username", "password"}, servicebinding.Keys...)
+
+const reservedPrefix = "connectionString"
+
+var funcs = template.FuncMap{
+	"join":        strings.Join,
+	"pathEscape":  url.PathEscape,
+	"queryEscape": url.QueryEscape,
+	"userinfo":    userinfo,
+	"json":        toJSON,
+}
+
+// userinfo returns the user and password escaped for the userinfo part of a URI.
+func userinfo(username, password string) string {
+	return url.UserPassword(username, password).String()
+}
+
+// toJSON returns the value as a JSON document, strings are returned quoted and escaped
+// so that they are also valid YAML.
+func toJSON(v any) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Render renders the templates with the given data into Secret data.
+func Render(templates map[string]string, data *Data) (map[string][]byte, error) {
+	parsed, err := parse(templates)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]byte, len(parsed))
+	for _, key := range slices.Sorted(maps.Keys(parsed)) {
+		buf := bytes.Buffer{}
+		if err := parsed[key].Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render key %q: %w", key, err)
+		}
+		out[key] = buf.Bytes()
+	}
+	return out, nil
+}
+
+// Apply renders the templates into the Secret data. Keys already present in the Secret data are not overridden.
+func Apply(secretData map[string][]byte, templates map[string]string, data *Data) error {
+	rendered, err := Render(templates, data)
+	if err != nil {
+		return err
+	}
+	for key, value := range rendered {
+		if _, ok := secretData[key]; ok {
+			return fmt.Errorf("key %q is written by the operator and can't be rendered by a template", key)
+		}
+		secretData[key] = value
+	}
+	return nil
+}
+
+// ApplyConnection renders the templates of a connection Secret into its data.
+// The Hosts and Options of the given data are parsed from its standard connection string.
+func ApplyConnection(secretData map[string][]byte, templates map[string]string, data *Data) error {
+	if len(templates) == 0 {
+		return nil
+	}
+	hosts, options, err := ParseURI(data.URI)
+	if err != nil {
+		return err
+	}
+	data.Hosts = hosts
+	data.Options = options
+	if err := Apply(secretData, templates, data); err != nil {
+		return fmt.Errorf("failed to render connection secret templates: %w", err)
+	}
+	return nil
+}
+
+// ParseURI returns the hosts and the options of a MongoDB connection string.
+func ParseURI(uri string) ([]string, map[string]string, error) {
+	if uri == "" {
+		return nil, nil, nil
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse connection string: %w", err)
+	}
+	options := map[string]string{}
+	for key, values := range u.Query() {
+		options[key] = values[0]
+	}
+	return strings.Split(u.Host, ","), options, nil
+}
+
+func parse(templates map[string]string) (map[string]*template.Template, error) {
+	parsed := make(map[string]*template.Template, len(templates))
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(templates)) {
+		if msgs := validation.IsConfigMapKey(key); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid key %q: %s", key, strings.Join(msgs, ", ")))
+			continue
+		}
+		if slices.Contains(reservedKeys, key) || strings.HasPrefix(key, reservedPrefix) {
+			errs = append(errs, fmt.Errorf("key %q is written by the operator and can't be rendered by a template", key))
+			continue
+		}
+		t, err := template.New(key).Option("missingkey=error").Funcs(funcs).Parse(templates[key])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid template for key %q: %w", key, err))
+			continue
+		}
+		parsed[key] = t
+	}
+	return parsed, errors.Join(errs...)
+}
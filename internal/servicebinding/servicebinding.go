@@ -0,0 +1,82 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package servicebinding fills connection Secrets according to the Service Binding specification
+// (https://servicebinding.io/spec/core/1.0.0/), so that they can be projected into workloads.
+package servicebinding
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	TypeKey     = "type"
+	ProviderKey = "provider"
+	HostKey     = "host"
+	PortKey     = "port"
+	URIKey      = "uri"
+
+	Type     = "mongodb"
+	Provider = "atlas"
+
+	defaultPort = "27017"
+)
+
+// Keys are the well-known entries written by Fill.
+var Keys = []string{TypeKey, ProviderKey, HostKey, PortKey, URIKey}
+
+// Fill adds the well-known Service Binding entries to the Secret data.
+// The uri is the SRV connection string if there is one, the standard connection string otherwise.
+// The host and port are those of the first host of the standard connection string.
+func Fill(secretData map[string][]byte, connURL, srvConnURL string) error {
+	uri := srvConnURL
+	if uri == "" {
+		uri = connURL
+	}
+	if uri == "" {
+		return nil
+	}
+	host, port, err := firstHost(connURL, srvConnURL)
+	if err != nil {
+		return err
+	}
+
+	secretData[TypeKey] = []byte(Type)
+	secretData[ProviderKey] = []byte(Provider)
+	secretData[HostKey] = []byte(host)
+	secretData[PortKey] = []byte(port)
+	secretData[URIKey] = []byte(uri)
+	return nil
+}
+
+func firstHost(connURL, srvConnURL string) (string, string, error) {
+	uri := connURL
+	if uri == "" {
+		uri = srvConnURL
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse connection string: %w", err)
+	}
+	first, _, _ := strings.Cut(u.Host, ",")
+	host, port, err := net.SplitHostPort(first)
+	if err != nil {
+		// SRV connection strings and single hosts may omit the port
+		return first, defaultPort, nil
+	}
+	return host, port, nil
+}
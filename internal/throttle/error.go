@@ -0,0 +1,52 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RateLimitedError is returned when Atlas keeps answering with HTTP 429 (Too Many Requests)
+// after all retries were exhausted, or immediately for requests that are not safe to retry.
+type RateLimitedError struct {
+	Method     string
+	Path       string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	msg := fmt.Sprintf("Atlas API rate limit exceeded for %s %s", e.Method, e.Path)
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+	return msg
+}
+
+// IsRateLimited returns true if the given error tree contains a RateLimitedError.
+func IsRateLimited(err error) bool {
+	rlErr := &RateLimitedError{}
+	return errors.As(err, &rlErr)
+}
+
+// RetryAfter returns the delay Atlas asked to wait for if the given error tree contains a RateLimitedError.
+func RetryAfter(err error) (time.Duration, bool) {
+	rlErr := &RateLimitedError{}
+	if !errors.As(err, &rlErr) {
+		return 0, false
+	}
+	return rlErr.RetryAfter, true
+}
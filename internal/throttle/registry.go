@@ -0,0 +1,134 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultBaseDelay  = time.Second
+	DefaultMaxDelay   = time.Minute
+
+	// limiterIdleTimeout is how long the limiter of an organization no request was sent for is kept.
+	limiterIdleTimeout = time.Hour
+)
+
+// Config configures the Atlas API request budget and the retry behavior on HTTP 429 responses.
+type Config struct {
+	// RequestsPerSecond is the sustained rate of requests allowed per organization.
+	// Zero or a negative value disables the client side budget.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests per organization that can be sent at once.
+	Burst int
+	// MaxRetries is the maximum number of retries of idempotent requests rejected with HTTP 429.
+	MaxRetries int
+	// BaseDelay is the initial backoff used when Atlas does not send a Retry-After header.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two retries.
+	MaxDelay time.Duration
+}
+
+// DefaultConfig returns a configuration without client side budget
+// which only retries rate limited idempotent requests.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  DefaultBaseDelay,
+		MaxDelay:   DefaultMaxDelay,
+	}
+}
+
+// Registry holds one token bucket per Atlas organization so that all reconcilers
+// of the same organization share a single request budget.
+// The limiters of organizations no longer reconciled are dropped after limiterIdleTimeout.
+type Registry struct {
+	config Config
+	now    func() time.Time
+
+	mu       sync.Mutex // protects limiters
+	limiters map[string]*Limiter
+}
+
+// Limiter is the request budget of an organization, shared by the transports of its clients.
+type Limiter struct {
+	*rate.Limiter
+	now func() time.Time
+	// lastUsed is the time of the last request, in Unix nanoseconds
+	lastUsed atomic.Int64
+}
+
+// Wait blocks until the budget allows a request. The limiter is kept in the registry as long as
+// requests are sent with it, even when its clients were created long ago.
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.touch()
+	return l.Limiter.Wait(ctx)
+}
+
+func (l *Limiter) touch() {
+	l.lastUsed.Store(l.now().UnixNano())
+}
+
+func (l *Limiter) idle(now time.Time) bool {
+	return now.Sub(time.Unix(0, l.lastUsed.Load())) > limiterIdleTimeout
+}
+
+func NewRegistry(config Config) *Registry {
+	return &Registry{
+		config:   config,
+		now:      time.Now,
+		limiters: map[string]*Limiter{},
+	}
+}
+
+func (r *Registry) Config() Config {
+	return r.config
+}
+
+// Limiter returns the shared limiter for the given organization key,
+// or nil if no request budget is configured.
+func (r *Registry) Limiter(key string) *Limiter {
+	if r.config.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for k, limiter := range r.limiters {
+		if limiter.idle(now) {
+			delete(r.limiters, k)
+		}
+	}
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		burst := r.config.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = &Limiter{Limiter: rate.NewLimiter(rate.Limit(r.config.RequestsPerSecond), burst), now: r.now}
+		r.limiters[key] = limiter
+	}
+	limiter.touch()
+	return limiter
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Transport enforces a shared request budget and retries idempotent requests
+// rejected by Atlas with HTTP 429, honoring the Retry-After header.
+type Transport struct {
+	delegate http.RoundTripper
+	limiter  *Limiter
+	config   Config
+	log      *zap.SugaredLogger
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewTransport returns a rate limit aware transport using the limiter of the given organization key.
+func NewTransport(delegate http.RoundTripper, registry *Registry, key string, log *zap.SugaredLogger) *Transport {
+	return &Transport{
+		delegate: delegate,
+		limiter:  registry.Limiter(key),
+		config:   registry.Config(),
+		log:      log.Named("throttle"),
+		now:      time.Now,
+		sleep:    sleep,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(req.Context()); err != nil {
+				return nil, fmt.Errorf("failed waiting for Atlas API request budget: %w", err)
+			}
+		}
+
+		resp, err := t.delegate.RoundTrip(req)
+		if err != nil || resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+
+		retryAfter, hasRetryAfter := t.parseRetryAfter(resp.Header.Get("Retry-After"))
+		drainAndClose(resp)
+
+		rateLimited := &RateLimitedError{Method: req.Method, Path: req.URL.Path, RetryAfter: retryAfter}
+		if !isRetryable(req) || attempt >= t.config.MaxRetries {
+			return nil, rateLimited
+		}
+
+		delay := t.backoff(attempt, retryAfter, hasRetryAfter)
+		t.log.Debugw("Atlas API rate limit hit, retrying", "method", req.Method, "path", req.URL.Path, "attempt", attempt+1, "delay", delay)
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, fmt.Errorf("%w: %w", rateLimited, err)
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body for retry: %w", err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the delay before the next retry. Retry-After takes precedence over exponential backoff.
+// A random jitter of up to 50% is added so that concurrent reconcilers don't retry in lockstep.
+func (t *Transport) backoff(attempt int, retryAfter time.Duration, hasRetryAfter bool) time.Duration {
+	delay := retryAfter
+	if !hasRetryAfter {
+		delay = t.config.BaseDelay << attempt
+	}
+	if delay > 0 {
+		delay += rand.N(delay/2 + 1) //nolint:gosec // jitter does not need a cryptographically secure source
+	}
+	if t.config.MaxDelay > 0 && delay > t.config.MaxDelay {
+		delay = t.config.MaxDelay
+	}
+	return delay
+}
+
+// parseRetryAfter parses the Retry-After header which is either a number of seconds or an HTTP date.
+func (t *Transport) parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(t.now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// isRetryable returns true for idempotent requests whose body, if any, can be replayed.
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func drainAndClose(resp *http.Response) {
+	if resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func response(status int, headers map[string]string) *http.Response {
+	resp := &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestTransportRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		method       string
+		body         string
+		responses    []*http.Response
+		wantStatus   int
+		wantErr      string
+		wantAttempts int
+		wantDelays   []time.Duration
+	}{
+		{
+			name:         "success is passed through",
+			method:       http.MethodGet,
+			responses:    []*http.Response{response(http.StatusOK, nil)},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		{
+			name:   "GET is retried honoring Retry-After",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				response(http.StatusTooManyRequests, map[string]string{"Retry-After": "2"}),
+				response(http.StatusOK, nil),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+			wantDelays:   []time.Duration{2 * time.Second},
+		},
+		{
+			name:   "PUT with body is retried",
+			method: http.MethodPut,
+			body:   `{"name":"test"}`,
+			responses: []*http.Response{
+				response(http.StatusTooManyRequests, nil),
+				response(http.StatusTooManyRequests, nil),
+				response(http.StatusOK, nil),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+			wantDelays:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "POST is not retried",
+			method: http.MethodPost,
+			body:   `{"name":"test"}`,
+			responses: []*http.Response{
+				response(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}),
+			},
+			wantErr:      "Atlas API rate limit exceeded for POST /api/atlas/v2/groups, retry after 30s",
+			wantAttempts: 1,
+		},
+		{
+			name:   "retries are exhausted",
+			method: http.MethodDelete,
+			responses: []*http.Response{
+				response(http.StatusTooManyRequests, nil),
+				response(http.StatusTooManyRequests, nil),
+				response(http.StatusTooManyRequests, nil),
+			},
+			wantErr:      "Atlas API rate limit exceeded for DELETE /api/atlas/v2/groups",
+			wantAttempts: 3,
+			wantDelays:   []time.Duration{time.Second, 2 * time.Second},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			delegate := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if tc.body != "" {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.Equal(t, tc.body, string(body))
+				}
+				resp := tc.responses[attempts]
+				attempts++
+				return resp, nil
+			})
+
+			registry := NewRegistry(Config{MaxRetries: 2, BaseDelay: time.Second, MaxDelay: time.Minute})
+			transport := NewTransport(delegate, registry, "key", zaptest.NewLogger(t).Sugar())
+			var delays []time.Duration
+			transport.sleep = func(_ context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return nil
+			}
+
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, "https://cloud.mongodb.com/api/atlas/v2/groups", body)
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				assert.True(t, IsRateLimited(fmt.Errorf("wrapped: %w", err)))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			}
+			assert.Equal(t, tc.wantAttempts, attempts)
+			require.Len(t, delays, len(tc.wantDelays))
+			for i, want := range tc.wantDelays {
+				// up to 50% jitter is added on top of the expected delay
+				assert.GreaterOrEqual(t, delays[i], want)
+				assert.LessOrEqual(t, delays[i], want+want/2)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	transport := &Transport{now: func() time.Time { return now }}
+
+	for _, tc := range []struct {
+		value   string
+		want    time.Duration
+		wantSet bool
+	}{
+		{value: ""},
+		{value: "garbage"},
+		{value: "-1"},
+		{value: "10", want: 10 * time.Second, wantSet: true},
+		{value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantSet: true},
+		{value: now.Add(-time.Hour).Format(http.TimeFormat), want: 0, wantSet: true},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			got, ok := transport.parseRetryAfter(tc.value)
+			assert.Equal(t, tc.wantSet, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	transport := &Transport{config: Config{BaseDelay: time.Second, MaxDelay: 5 * time.Second}}
+	assert.Equal(t, 5*time.Second, transport.backoff(10, 0, false))
+	assert.Equal(t, 5*time.Second, transport.backoff(0, time.Hour, true))
+}
+
+func TestRegistrySharesLimiters(t *testing.T) {
+	assert.Nil(t, NewRegistry(DefaultConfig()).Limiter("key"))
+
+	registry := NewRegistry(Config{RequestsPerSecond: 5, Burst: 10})
+	limiter := registry.Limiter("key")
+	require.NotNil(t, limiter)
+	assert.Same(t, limiter, registry.Limiter("key"))
+	assert.NotSame(t, limiter, registry.Limiter("other"))
+	assert.Equal(t, 10, limiter.Burst())
+}
+
+func TestRegistryDropsIdleLimiters(t *testing.T) {
+	now := time.Now()
+	registry := NewRegistry(Config{RequestsPerSecond: 5, Burst: 10})
+	registry.now = func() time.Time { return now }
+	limiter := registry.Limiter("org:idle")
+	active := registry.Limiter("org:active")
+
+	now = now.Add(limiterIdleTimeout / 2)
+	assert.Same(t, active, registry.Limiter("org:active"))
+
+	now = now.Add(limiterIdleTimeout)
+	assert.Same(t, active, registry.Limiter("org:active"))
+	assert.Len(t, registry.limiters, 1)
+	assert.NotSame(t, limiter, registry.Limiter("org:idle"))
+}
+
+func TestRegistryKeepsLimitersInUse(t *testing.T) {
+	now := time.Now()
+	registry := NewRegistry(Config{RequestsPerSecond: 1000, Burst: 10})
+	registry.now = func() time.Time { return now }
+	delegate := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	// the transport holds the limiter of its organization for the lifetime of its client
+	transport := NewTransport(delegate, registry, "org:held", zaptest.NewLogger(t).Sugar())
+
+	for range 3 {
+		now = now.Add(limiterIdleTimeout / 2)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://cloud.mongodb.com/api/atlas/v2/groups", nil)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	now = now.Add(limiterIdleTimeout / 2)
+	assert.Same(t, transport.limiter, registry.Limiter("org:held"))
+}
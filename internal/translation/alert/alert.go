@@ -0,0 +1,59 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+const statusOpen = "OPEN"
+
+type AlertService interface {
+	ListOpen(ctx context.Context, projectID string) ([]Alert, error)
+}
+
+type alertService struct {
+	alertsAPI admin.AlertsAPI
+}
+
+func NewAlertServiceFromClientSet(clientSet *atlas.ClientSet) AlertService {
+	return NewAlertService(clientSet.SdkClient20250312.AlertsAPI)
+}
+
+func NewAlertService(alertsAPI admin.AlertsAPI) AlertService {
+	return &alertService{alertsAPI: alertsAPI}
+}
+
+// ListOpen returns the alerts of the project that are open, acknowledged alerts included.
+func (s *alertService) ListOpen(ctx context.Context, projectID string) ([]Alert, error) {
+	atlasAlerts, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.AlertViewForNdsGroup], *http.Response, error) {
+		return s.alertsAPI.ListAlerts(ctx, projectID).Status(statusOpen).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open alerts of project %s: %w", projectID, err)
+	}
+	alerts := make([]Alert, 0, len(atlasAlerts))
+	for i := range atlasAlerts {
+		alerts = append(alerts, fromAtlas(&atlasAlerts[i]))
+	}
+	return alerts, nil
+}
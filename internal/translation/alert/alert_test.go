@@ -0,0 +1,148 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+)
+
+const testProjectID = "project-id"
+
+func TestListOpen(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		title      string
+		setupMock  func(alertsAPI *mockadmin.AlertsAPI)
+		wantAlerts []Alert
+		wantErr    string
+	}{
+		{
+			title: "lists open alerts",
+			setupMock: func(alertsAPI *mockadmin.AlertsAPI) {
+				alertsAPI.EXPECT().ListAlerts(ctx, testProjectID).Return(admin.ListAlertsApiRequest{ApiService: alertsAPI})
+				alertsAPI.EXPECT().ListAlertsExecute(mock.Anything).Return(&admin.PaginatedAlert{
+					Results: &[]admin.AlertViewForNdsGroup{
+						{
+							Id:              new("alert-1"),
+							EventTypeName:   new("OUTSIDE_METRIC_THRESHOLD"),
+							ClusterName:     new("cluster0"),
+							HostnameAndPort: new("cluster0-shard-00-00.mongodb.net:27017"),
+							MetricName:      new("NORMALIZED_SYSTEM_CPU_USER"),
+							Created:         new(created),
+						},
+						{
+							Id:            new("alert-2"),
+							EventTypeName: new("USERS_WITHOUT_MULTI_FACTOR_AUTH"),
+							Created:       new(created),
+						},
+					},
+					TotalCount: new(2),
+				}, &http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			wantAlerts: []Alert{
+				{
+					ID:              "alert-1",
+					EventTypeName:   "OUTSIDE_METRIC_THRESHOLD",
+					ClusterName:     "cluster0",
+					HostnameAndPort: "cluster0-shard-00-00.mongodb.net:27017",
+					MetricName:      "NORMALIZED_SYSTEM_CPU_USER",
+					Created:         created,
+				},
+				{
+					ID:            "alert-2",
+					EventTypeName: "USERS_WITHOUT_MULTI_FACTOR_AUTH",
+					Created:       created,
+				},
+			},
+		},
+		{
+			title: "no open alerts",
+			setupMock: func(alertsAPI *mockadmin.AlertsAPI) {
+				alertsAPI.EXPECT().ListAlerts(ctx, testProjectID).Return(admin.ListAlertsApiRequest{ApiService: alertsAPI})
+				alertsAPI.EXPECT().ListAlertsExecute(mock.Anything).Return(&admin.PaginatedAlert{
+					Results:    &[]admin.AlertViewForNdsGroup{},
+					TotalCount: new(0),
+				}, &http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			wantAlerts: []Alert{},
+		},
+		{
+			title: "list fails",
+			setupMock: func(alertsAPI *mockadmin.AlertsAPI) {
+				alertsAPI.EXPECT().ListAlerts(ctx, testProjectID).Return(admin.ListAlertsApiRequest{ApiService: alertsAPI})
+				alertsAPI.EXPECT().ListAlertsExecute(mock.Anything).Return(
+					nil, &http.Response{StatusCode: http.StatusUnauthorized}, errors.New("unauthorized"))
+			},
+			wantErr: "failed to list open alerts of project project-id: unauthorized",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			alertsAPI := mockadmin.NewAlertsAPI(t)
+			tc.setupMock(alertsAPI)
+			alerts, err := NewAlertService(alertsAPI).ListOpen(ctx, testProjectID)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAlerts, alerts)
+		})
+	}
+}
+
+func TestAlertString(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		alert Alert
+		want  string
+	}{
+		{
+			title: "project alert",
+			alert: Alert{ID: "alert-1", EventTypeName: "USERS_WITHOUT_MULTI_FACTOR_AUTH"},
+			want:  "USERS_WITHOUT_MULTI_FACTOR_AUTH (alert alert-1)",
+		},
+		{
+			title: "host metric alert",
+			alert: Alert{
+				ID:              "alert-2",
+				EventTypeName:   "OUTSIDE_METRIC_THRESHOLD",
+				HostnameAndPort: "host-0:27017",
+				ReplicaSetName:  "atlas-abc-shard-0",
+				MetricName:      "NORMALIZED_SYSTEM_CPU_USER",
+			},
+			want: "OUTSIDE_METRIC_THRESHOLD NORMALIZED_SYSTEM_CPU_USER on host-0:27017 (alert alert-2)",
+		},
+		{
+			title: "replica set alert",
+			alert: Alert{ID: "alert-3", EventTypeName: "NO_PRIMARY", ReplicaSetName: "atlas-abc-shard-0"},
+			want:  "NO_PRIMARY on atlas-abc-shard-0 (alert alert-3)",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.alert.String())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+// Alert is an alert raised by an Atlas alert configuration.
+type Alert struct {
+	ID              string
+	EventTypeName   string
+	ClusterName     string
+	HostnameAndPort string
+	ReplicaSetName  string
+	MetricName      string
+	Created         time.Time
+}
+
+// String describes the alert, e.g. "OUTSIDE_METRIC_THRESHOLD NORMALIZED_SYSTEM_CPU_USER on host-0:27017".
+func (a *Alert) String() string {
+	parts := []string{a.EventTypeName}
+	if a.MetricName != "" {
+		parts = append(parts, a.MetricName)
+	}
+	switch {
+	case a.HostnameAndPort != "":
+		parts = append(parts, "on", a.HostnameAndPort)
+	case a.ReplicaSetName != "":
+		parts = append(parts, "on", a.ReplicaSetName)
+	}
+	return fmt.Sprintf("%s (alert %s)", strings.Join(parts, " "), a.ID)
+}
+
+func fromAtlas(alert *admin.AlertViewForNdsGroup) Alert {
+	return Alert{
+		ID:              alert.GetId(),
+		EventTypeName:   alert.GetEventTypeName(),
+		ClusterName:     alert.GetClusterName(),
+		HostnameAndPort: alert.GetHostnameAndPort(),
+		ReplicaSetName:  alert.GetReplicaSetName(),
+		MetricName:      alert.GetMetricName(),
+		Created:         alert.GetCreated(),
+	}
+}
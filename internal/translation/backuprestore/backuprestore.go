@@ -0,0 +1,104 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+var (
+	// ErrNotFound means the restore job is missing
+	ErrNotFound = errors.New("not found")
+)
+
+type RestoreJobService interface {
+	Create(ctx context.Context, projectID, clusterName string, cfg *RestoreJobConfig) (*RestoreJob, error)
+	Get(ctx context.Context, projectID, clusterName, jobID string) (*RestoreJob, error)
+	Find(ctx context.Context, projectID, clusterName string, cfg *RestoreJobConfig) (*RestoreJob, error)
+	Cancel(ctx context.Context, projectID, clusterName, jobID string) error
+}
+
+type restoreJobService struct {
+	backupsAPI admin.CloudBackupsAPI
+}
+
+func NewRestoreJobServiceFromClientSet(clientSet *atlas.ClientSet) RestoreJobService {
+	return NewRestoreJobService(clientSet.SdkClient20250312.CloudBackupsAPI)
+}
+
+func NewRestoreJobService(backupsAPI admin.CloudBackupsAPI) RestoreJobService {
+	return &restoreJobService{backupsAPI: backupsAPI}
+}
+
+func (s *restoreJobService) Create(ctx context.Context, projectID, clusterName string, cfg *RestoreJobConfig) (*RestoreJob, error) {
+	job, _, err := s.backupsAPI.CreateBackupRestoreJob(ctx, projectID, clusterName, toAtlas(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create restore job for cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	return fromAtlas(job), nil
+}
+
+func (s *restoreJobService) Get(ctx context.Context, projectID, clusterName, jobID string) (*RestoreJob, error) {
+	job, resp, err := s.backupsAPI.GetBackupRestoreJob(ctx, projectID, clusterName, jobID).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restore job %s: %w", jobID, err)
+	}
+	return fromAtlas(job), nil
+}
+
+// Find returns the restore job of the cluster matching the given config.
+// Jobs that were cancelled, failed or expired are ignored as they did not restore any data,
+// so are jobs that completed before the restore was requested.
+func (s *restoreJobService) Find(ctx context.Context, projectID, clusterName string, cfg *RestoreJobConfig) (*RestoreJob, error) {
+	atlasJobs, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.DiskBackupSnapshotRestoreJob], *http.Response, error) {
+		return s.backupsAPI.ListBackupRestoreJobs(ctx, projectID, clusterName).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list restore jobs for cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	for _, atlasJob := range atlasJobs {
+		job := fromAtlas(&atlasJob)
+		if !cfg.Adoptable(job) {
+			continue
+		}
+		if cfg.DeliveryType() == atlasJob.GetDeliveryType() && cfg.Matches(job) {
+			return job, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *restoreJobService) Cancel(ctx context.Context, projectID, clusterName, jobID string) error {
+	resp, err := s.backupsAPI.CancelBackupRestoreJob(ctx, projectID, clusterName, jobID).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to cancel restore job %s: %w", jobID, err)
+	}
+	return nil
+}
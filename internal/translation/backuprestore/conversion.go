@@ -0,0 +1,170 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprestore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	// DeliveryTypeAutomated restores a snapshot to a target deployment
+	DeliveryTypeAutomated = "automated"
+
+	// DeliveryTypePointInTime restores a deployment to a point in time or oplog position
+	DeliveryTypePointInTime = "pointInTime"
+)
+
+// RestoreJobConfig is the restore requested to Atlas
+type RestoreJobConfig struct {
+	SnapshotID            string
+	PointInTimeUTCSeconds *int
+	OplogTs               *int
+	OplogInc              *int
+	TargetProjectID       string
+	TargetClusterName     string
+	// RequestedAt is when the restore was requested, i.e. the creation of the custom resource.
+	// It is not sent to Atlas, it tells the restore jobs of this request apart from older ones.
+	RequestedAt time.Time
+}
+
+// RestoreJob is a restore job as observed in Atlas
+type RestoreJob struct {
+	RestoreJobConfig
+	ID         string
+	Phase      status.AtlasBackupRestoreJobPhase
+	FinishedAt *time.Time
+}
+
+func NewRestoreJobConfig(restoreJob *akov2.AtlasBackupRestoreJob, targetProjectID, targetClusterName string) *RestoreJobConfig {
+	spec := &restoreJob.Spec
+	return &RestoreJobConfig{
+		SnapshotID:            spec.SnapshotID,
+		PointInTimeUTCSeconds: spec.PointInTimeUTCSeconds,
+		OplogTs:               spec.OplogTs,
+		OplogInc:              spec.OplogInc,
+		TargetProjectID:       targetProjectID,
+		TargetClusterName:     targetClusterName,
+		RequestedAt:           restoreJob.CreationTimestamp.Time,
+	}
+}
+
+// DeliveryType returns the Atlas delivery type of the restore
+func (cfg *RestoreJobConfig) DeliveryType() string {
+	if cfg.SnapshotID != "" {
+		return DeliveryTypeAutomated
+	}
+	return DeliveryTypePointInTime
+}
+
+// Matches is true when the given restore job restores the same backup to the same target.
+// Atlas may pick the snapshot of a point in time restore, so the snapshot is only compared
+// when it was requested explicitly.
+func (cfg *RestoreJobConfig) Matches(job *RestoreJob) bool {
+	if cfg.SnapshotID != "" && cfg.SnapshotID != job.SnapshotID {
+		return false
+	}
+	return cfg.TargetProjectID == job.TargetProjectID &&
+		cfg.TargetClusterName == job.TargetClusterName &&
+		pointer.GetOrDefault(cfg.PointInTimeUTCSeconds, 0) == pointer.GetOrDefault(job.PointInTimeUTCSeconds, 0) &&
+		pointer.GetOrDefault(cfg.OplogTs, 0) == pointer.GetOrDefault(job.OplogTs, 0) &&
+		pointer.GetOrDefault(cfg.OplogInc, 0) == pointer.GetOrDefault(job.OplogInc, 0)
+}
+
+// Adoptable is true when the given restore job may have been started for this request: it is still in progress,
+// or it finished after the restore was requested. Restore jobs that completed earlier ran for an older request
+// of the same restore, e.g. of a deleted resource, and are never adopted.
+func (cfg *RestoreJobConfig) Adoptable(job *RestoreJob) bool {
+	switch job.Phase {
+	case status.BackupRestoreJobPhaseInProgress:
+		return true
+	case status.BackupRestoreJobPhaseCompleted:
+		return job.FinishedAt != nil && !job.FinishedAt.Before(cfg.RequestedAt)
+	default:
+		return false
+	}
+}
+
+// SpecHash returns a stable hash of the restore spec, used to never run the same restore twice
+func SpecHash(spec *akov2.AtlasBackupRestoreJobSpec) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal restore job spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func ApplyRestoreJobStatus(restoreStatus *status.AtlasBackupRestoreJobStatus, job *RestoreJob) {
+	restoreStatus.ID = job.ID
+	restoreStatus.Phase = job.Phase
+	restoreStatus.SnapshotID = job.SnapshotID
+	restoreStatus.FinishedAt = ""
+	if job.FinishedAt != nil {
+		restoreStatus.FinishedAt = job.FinishedAt.UTC().Format(time.RFC3339)
+	}
+}
+
+func toAtlas(cfg *RestoreJobConfig) *admin.DiskBackupSnapshotRestoreJob {
+	return &admin.DiskBackupSnapshotRestoreJob{
+		DeliveryType:          cfg.DeliveryType(),
+		SnapshotId:            pointer.SetOrNil(cfg.SnapshotID, ""),
+		PointInTimeUTCSeconds: cfg.PointInTimeUTCSeconds,
+		OplogTs:               cfg.OplogTs,
+		OplogInc:              cfg.OplogInc,
+		TargetGroupId:         pointer.SetOrNil(cfg.TargetProjectID, ""),
+		TargetClusterName:     pointer.SetOrNil(cfg.TargetClusterName, ""),
+	}
+}
+
+func fromAtlas(job *admin.DiskBackupSnapshotRestoreJob) *RestoreJob {
+	return &RestoreJob{
+		RestoreJobConfig: RestoreJobConfig{
+			SnapshotID:            job.GetSnapshotId(),
+			PointInTimeUTCSeconds: job.PointInTimeUTCSeconds,
+			OplogTs:               job.OplogTs,
+			OplogInc:              job.OplogInc,
+			TargetProjectID:       job.GetTargetGroupId(),
+			TargetClusterName:     job.GetTargetClusterName(),
+		},
+		ID:         job.GetId(),
+		Phase:      phaseFromAtlas(job),
+		FinishedAt: job.FinishedAt,
+	}
+}
+
+func phaseFromAtlas(job *admin.DiskBackupSnapshotRestoreJob) status.AtlasBackupRestoreJobPhase {
+	switch {
+	case job.GetCancelled():
+		return status.BackupRestoreJobPhaseCancelled
+	case job.GetFailed():
+		return status.BackupRestoreJobPhaseFailed
+	case job.GetExpired():
+		return status.BackupRestoreJobPhaseExpired
+	case job.FinishedAt != nil:
+		return status.BackupRestoreJobPhaseCompleted
+	default:
+		return status.BackupRestoreJobPhaseInProgress
+	}
+}
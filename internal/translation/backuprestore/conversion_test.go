@@ -0,0 +1,150 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+const (
+	testSnapshotID = "5f4007f327a3bd7b6f4103c5"
+
+	testProjectID = "5f4007f327a3bd7b6f4103c6"
+
+	testClusterName = "target-cluster"
+)
+
+func TestConvertRestoreJob(t *testing.T) {
+	finishedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tc := range []struct {
+		title    string
+		cfg      RestoreJobConfig
+		atlasJob admin.DiskBackupSnapshotRestoreJob
+		want     status.AtlasBackupRestoreJobPhase
+	}{
+		{
+			title:    "running snapshot restore",
+			cfg:      RestoreJobConfig{SnapshotID: testSnapshotID, TargetProjectID: testProjectID, TargetClusterName: testClusterName},
+			atlasJob: admin.DiskBackupSnapshotRestoreJob{},
+			want:     status.BackupRestoreJobPhaseInProgress,
+		},
+		{
+			title:    "completed point in time restore",
+			cfg:      RestoreJobConfig{PointInTimeUTCSeconds: new(1735787045), TargetProjectID: testProjectID, TargetClusterName: testClusterName},
+			atlasJob: admin.DiskBackupSnapshotRestoreJob{FinishedAt: &finishedAt},
+			want:     status.BackupRestoreJobPhaseCompleted,
+		},
+		{
+			title:    "failed oplog restore",
+			cfg:      RestoreJobConfig{OplogTs: new(1735787045), OplogInc: new(3), TargetProjectID: testProjectID, TargetClusterName: testClusterName},
+			atlasJob: admin.DiskBackupSnapshotRestoreJob{Failed: new(true), FinishedAt: &finishedAt},
+			want:     status.BackupRestoreJobPhaseFailed,
+		},
+		{
+			title:    "cancelled restore",
+			cfg:      RestoreJobConfig{SnapshotID: testSnapshotID, TargetProjectID: testProjectID, TargetClusterName: testClusterName},
+			atlasJob: admin.DiskBackupSnapshotRestoreJob{Cancelled: new(true)},
+			want:     status.BackupRestoreJobPhaseCancelled,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			atlasJob := toAtlas(&tc.cfg)
+			atlasJob.Id = new("restore-job-id")
+			atlasJob.Cancelled = tc.atlasJob.Cancelled
+			atlasJob.Failed = tc.atlasJob.Failed
+			atlasJob.FinishedAt = tc.atlasJob.FinishedAt
+
+			job := fromAtlas(atlasJob)
+			assert.Equal(t, tc.cfg, job.RestoreJobConfig)
+			assert.Equal(t, tc.want, job.Phase)
+			assert.True(t, tc.cfg.Matches(job))
+		})
+	}
+}
+
+func TestDeliveryType(t *testing.T) {
+	assert.Equal(t, DeliveryTypeAutomated, (&RestoreJobConfig{SnapshotID: testSnapshotID}).DeliveryType())
+	assert.Equal(t, DeliveryTypePointInTime, (&RestoreJobConfig{PointInTimeUTCSeconds: new(1735787045)}).DeliveryType())
+	assert.Equal(t, DeliveryTypePointInTime, (&RestoreJobConfig{OplogTs: new(1735787045), OplogInc: new(1)}).DeliveryType())
+}
+
+func TestMatches(t *testing.T) {
+	cfg := RestoreJobConfig{PointInTimeUTCSeconds: new(1735787045), TargetProjectID: testProjectID, TargetClusterName: testClusterName}
+
+	// Atlas reports the snapshot picked for a point in time restore
+	assert.True(t, cfg.Matches(&RestoreJob{RestoreJobConfig: RestoreJobConfig{
+		SnapshotID: testSnapshotID, PointInTimeUTCSeconds: new(1735787045), TargetProjectID: testProjectID, TargetClusterName: testClusterName,
+	}}))
+	assert.False(t, cfg.Matches(&RestoreJob{RestoreJobConfig: RestoreJobConfig{
+		PointInTimeUTCSeconds: new(1735787046), TargetProjectID: testProjectID, TargetClusterName: testClusterName,
+	}}))
+	assert.False(t, cfg.Matches(&RestoreJob{RestoreJobConfig: RestoreJobConfig{
+		PointInTimeUTCSeconds: new(1735787045), TargetProjectID: testProjectID, TargetClusterName: "other-cluster",
+	}}))
+}
+
+func TestAdoptable(t *testing.T) {
+	requestedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := RestoreJobConfig{SnapshotID: testSnapshotID, RequestedAt: requestedAt}
+
+	assert.True(t, cfg.Adoptable(&RestoreJob{Phase: status.BackupRestoreJobPhaseInProgress}))
+	assert.True(t, cfg.Adoptable(&RestoreJob{Phase: status.BackupRestoreJobPhaseCompleted, FinishedAt: new(requestedAt.Add(time.Minute))}))
+	assert.False(t, cfg.Adoptable(&RestoreJob{Phase: status.BackupRestoreJobPhaseCompleted, FinishedAt: new(requestedAt.Add(-time.Minute))}),
+		"restore jobs that completed before the request must not be adopted")
+	assert.False(t, cfg.Adoptable(&RestoreJob{Phase: status.BackupRestoreJobPhaseFailed}))
+}
+
+func TestSpecHash(t *testing.T) {
+	spec := akov2.AtlasBackupRestoreJobSpec{
+		DeploymentRef:       common.ResourceRefNamespaced{Name: "source"},
+		SnapshotID:          testSnapshotID,
+		TargetDeploymentRef: common.ResourceRefNamespaced{Name: "target"},
+	}
+	hash, err := SpecHash(&spec)
+	require.NoError(t, err)
+
+	sameHash, err := SpecHash(spec.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	spec.TargetDeploymentRef.Name = "other-target"
+	otherHash, err := SpecHash(&spec)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestApplyRestoreJobStatus(t *testing.T) {
+	finishedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	restoreStatus := status.AtlasBackupRestoreJobStatus{}
+	ApplyRestoreJobStatus(&restoreStatus, &RestoreJob{
+		RestoreJobConfig: RestoreJobConfig{SnapshotID: testSnapshotID},
+		ID:               "restore-job-id",
+		Phase:            status.BackupRestoreJobPhaseCompleted,
+		FinishedAt:       &finishedAt,
+	})
+	assert.Equal(t, "restore-job-id", restoreStatus.ID)
+	assert.Equal(t, status.BackupRestoreJobPhaseCompleted, restoreStatus.Phase)
+	assert.Equal(t, testSnapshotID, restoreStatus.SnapshotID)
+	assert.Equal(t, "2025-01-02T03:04:05Z", restoreStatus.FinishedAt)
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+var (
+	// ErrNotFound means the snapshot is missing, it expired or was deleted
+	ErrNotFound = errors.New("not found")
+)
+
+type SnapshotService interface {
+	Create(ctx context.Context, projectID, clusterName string, cfg *SnapshotConfig) (*Snapshot, error)
+	Find(ctx context.Context, projectID, clusterName string, cfg *SnapshotConfig) (*Snapshot, error)
+	Get(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error)
+	UpdateRetention(ctx context.Context, projectID, clusterName, snapshotID string, retentionInDays int) error
+	Delete(ctx context.Context, projectID, clusterName, snapshotID string) error
+}
+
+type snapshotService struct {
+	backupsAPI admin.CloudBackupsAPI
+}
+
+func NewSnapshotServiceFromClientSet(clientSet *atlas.ClientSet) SnapshotService {
+	return NewSnapshotService(clientSet.SdkClient20250312.CloudBackupsAPI)
+}
+
+func NewSnapshotService(backupsAPI admin.CloudBackupsAPI) SnapshotService {
+	return &snapshotService{backupsAPI: backupsAPI}
+}
+
+func (s *snapshotService) Create(ctx context.Context, projectID, clusterName string, cfg *SnapshotConfig) (*Snapshot, error) {
+	snapshot, _, err := s.backupsAPI.TakeSnapshot(ctx, projectID, clusterName, toAtlas(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot of cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	return fromAtlasSnapshot(snapshot), nil
+}
+
+// Find returns the on-demand snapshot of the cluster matching the given config, so that a snapshot taken by
+// a reconciliation that failed to record it is adopted instead of taking another one.
+// Sharded cluster snapshots are only listed when the cluster has no replica set snapshot.
+func (s *snapshotService) Find(ctx context.Context, projectID, clusterName string, cfg *SnapshotConfig) (*Snapshot, error) {
+	replicaSetSnapshots, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.DiskBackupReplicaSet], *http.Response, error) {
+		return s.backupsAPI.ListReplicaSetBackups(ctx, projectID, clusterName).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots of cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	snapshots := make([]*Snapshot, 0, len(replicaSetSnapshots))
+	for i := range replicaSetSnapshots {
+		if replicaSetSnapshots[i].GetSnapshotType() == atlasSnapshotTypeOnDemand {
+			snapshots = append(snapshots, fromAtlasReplicaSet(&replicaSetSnapshots[i]))
+		}
+	}
+
+	if len(replicaSetSnapshots) == 0 {
+		shardedSnapshots, _, err := s.backupsAPI.ListShardedClusterBackups(ctx, projectID, clusterName).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sharded cluster snapshots of cluster %s at project %s: %w", clusterName, projectID, err)
+		}
+		for _, snapshot := range shardedSnapshots.GetResults() {
+			if snapshot.GetSnapshotType() == atlasSnapshotTypeOnDemand {
+				snapshots = append(snapshots, fromAtlasShardedCluster(&snapshot))
+			}
+		}
+	}
+
+	for _, snapshot := range snapshots {
+		if cfg.Matches(snapshot) {
+			return snapshot, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Get returns the snapshot of a replica set or, failing that, of a sharded cluster,
+// as Atlas serves each from a different endpoint.
+func (s *snapshotService) Get(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error) {
+	replicaSetSnapshot, resp, err := s.backupsAPI.GetReplicaSetBackup(ctx, projectID, clusterName, snapshotID).Execute()
+	if err == nil {
+		return fromAtlasReplicaSet(replicaSetSnapshot), nil
+	}
+	if httputil.StatusCode(resp) != http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get snapshot %s: %w", snapshotID, err)
+	}
+	shardedSnapshot, resp, err := s.backupsAPI.GetShardedClusterBackup(ctx, projectID, clusterName, snapshotID).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sharded cluster snapshot %s: %w", snapshotID, err)
+	}
+	return fromAtlasShardedCluster(shardedSnapshot), nil
+}
+
+func (s *snapshotService) UpdateRetention(ctx context.Context, projectID, clusterName, snapshotID string, retentionInDays int) error {
+	_, resp, err := s.backupsAPI.UpdateSnapshotRetention(ctx, projectID, clusterName, snapshotID, toAtlasRetention(retentionInDays)).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update retention of snapshot %s: %w", snapshotID, err)
+	}
+	return nil
+}
+
+// Delete removes the snapshot of a replica set or, failing that, of a sharded cluster.
+func (s *snapshotService) Delete(ctx context.Context, projectID, clusterName, snapshotID string) error {
+	resp, err := s.backupsAPI.DeleteReplicaSetBackup(ctx, projectID, clusterName, snapshotID).Execute()
+	if err == nil {
+		return nil
+	}
+	if httputil.StatusCode(resp) != http.StatusNotFound {
+		return fmt.Errorf("failed to delete snapshot %s: %w", snapshotID, err)
+	}
+	resp, err = s.backupsAPI.DeleteShardedClusterBackup(ctx, projectID, clusterName, snapshotID).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete sharded cluster snapshot %s: %w", snapshotID, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupsnapshot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+const (
+	testProjectID = "5f4007f327a3bd7b6f4103c6"
+
+	testClusterName = "test-cluster"
+
+	testSnapshotID = "5f4007f327a3bd7b6f4103c5"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+	notFoundErr := errors.New("not found")
+	for _, tc := range []struct {
+		title        string
+		setupMock    func(backupsAPI *mockadmin.CloudBackupsAPI)
+		wantSnapshot *Snapshot
+		wantErr      error
+	}{
+		{
+			title: "replica set snapshot",
+			setupMock: func(backupsAPI *mockadmin.CloudBackupsAPI) {
+				backupsAPI.EXPECT().GetReplicaSetBackup(ctx, testProjectID, testClusterName, testSnapshotID).Return(
+					admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetReplicaSetBackupExecute(admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI}).Return(
+					&admin.DiskBackupReplicaSet{
+						Id:               new(testSnapshotID),
+						Status:           new("completed"),
+						StorageSizeBytes: new(int64(1024)),
+					}, &http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			wantSnapshot: &Snapshot{
+				ID:               testSnapshotID,
+				Phase:            status.BackupSnapshotPhaseCompleted,
+				StorageSizeBytes: 1024,
+			},
+		},
+		{
+			title: "sharded cluster snapshot",
+			setupMock: func(backupsAPI *mockadmin.CloudBackupsAPI) {
+				backupsAPI.EXPECT().GetReplicaSetBackup(ctx, testProjectID, testClusterName, testSnapshotID).Return(
+					admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetReplicaSetBackupExecute(admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI}).Return(
+					nil, &http.Response{StatusCode: http.StatusNotFound}, notFoundErr)
+				backupsAPI.EXPECT().GetShardedClusterBackup(ctx, testProjectID, testClusterName, testSnapshotID).Return(
+					admin.GetShardedClusterBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetShardedClusterBackupExecute(admin.GetShardedClusterBackupApiRequest{ApiService: backupsAPI}).Return(
+					&admin.DiskBackupShardedClusterSnapshot{
+						Id:     new(testSnapshotID),
+						Status: new("inProgress"),
+					}, &http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			wantSnapshot: &Snapshot{
+				ID:    testSnapshotID,
+				Phase: status.BackupSnapshotPhaseInProgress,
+			},
+		},
+		{
+			title: "missing snapshot",
+			setupMock: func(backupsAPI *mockadmin.CloudBackupsAPI) {
+				backupsAPI.EXPECT().GetReplicaSetBackup(ctx, testProjectID, testClusterName, testSnapshotID).Return(
+					admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetReplicaSetBackupExecute(admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI}).Return(
+					nil, &http.Response{StatusCode: http.StatusNotFound}, notFoundErr)
+				backupsAPI.EXPECT().GetShardedClusterBackup(ctx, testProjectID, testClusterName, testSnapshotID).Return(
+					admin.GetShardedClusterBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetShardedClusterBackupExecute(admin.GetShardedClusterBackupApiRequest{ApiService: backupsAPI}).Return(
+					nil, &http.Response{StatusCode: http.StatusNotFound}, notFoundErr)
+			},
+			wantErr: ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsAPI(t)
+			tc.setupMock(backupsAPI)
+
+			snapshot, err := NewSnapshotService(backupsAPI).Get(ctx, testProjectID, testClusterName, testSnapshotID)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantSnapshot, snapshot)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupsnapshot
+
+import (
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	// RetentionUnitDays is the only retention unit used for on-demand snapshots
+	RetentionUnitDays = "days"
+
+	atlasStatusQueued    = "queued"
+	atlasStatusCompleted = "completed"
+	atlasStatusFailed    = "failed"
+
+	atlasSnapshotTypeOnDemand = "onDemand"
+)
+
+// SnapshotConfig is the on-demand snapshot requested to Atlas
+type SnapshotConfig struct {
+	Description     string
+	RetentionInDays int
+	// RequestedAt is when the snapshot was requested, it is not sent to Atlas but used to find
+	// a snapshot taken for this request, see Matches.
+	RequestedAt time.Time
+}
+
+// Matches is true when the given snapshot may have been taken for this request: it has the same description,
+// it was taken after the request and it did not fail. Snapshots taken earlier belong to an older request,
+// e.g. of a deleted resource, and are never adopted.
+func (cfg *SnapshotConfig) Matches(snapshot *Snapshot) bool {
+	return snapshot.Description == cfg.Description &&
+		snapshot.Phase != status.BackupSnapshotPhaseFailed &&
+		snapshot.CreatedAt != nil && !snapshot.CreatedAt.Before(cfg.RequestedAt)
+}
+
+// Snapshot is an on-demand snapshot as observed in Atlas
+type Snapshot struct {
+	ID               string
+	Description      string
+	Phase            status.AtlasBackupSnapshotPhase
+	StorageSizeBytes int64
+	CreatedAt        *time.Time
+	ExpiresAt        *time.Time
+}
+
+func NewSnapshotConfig(snapshot *akov2.AtlasBackupSnapshot) *SnapshotConfig {
+	return &SnapshotConfig{
+		Description:     snapshot.Spec.Description,
+		RetentionInDays: snapshot.Spec.RetentionInDays,
+		RequestedAt:     snapshot.CreationTimestamp.Time,
+	}
+}
+
+// ApplySnapshotStatus sets the status fields observed in Atlas.
+// The completion time is not reported by Atlas, so it is recorded the first time
+// the snapshot is seen completed and kept afterwards.
+func ApplySnapshotStatus(snapshotStatus *status.AtlasBackupSnapshotStatus, snapshot *Snapshot, now time.Time) {
+	snapshotStatus.ID = snapshot.ID
+	snapshotStatus.Phase = snapshot.Phase
+	snapshotStatus.StorageSizeBytes = snapshot.StorageSizeBytes
+	snapshotStatus.CreatedAt = formatTime(snapshot.CreatedAt)
+	snapshotStatus.ExpiresAt = formatTime(snapshot.ExpiresAt)
+	if snapshot.Phase == status.BackupSnapshotPhaseCompleted && snapshotStatus.CompletedAt == "" {
+		snapshotStatus.CompletedAt = formatTime(&now)
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func toAtlas(cfg *SnapshotConfig) *admin.DiskBackupOnDemandSnapshotRequest {
+	return &admin.DiskBackupOnDemandSnapshotRequest{
+		Description:     pointer.SetOrNil(cfg.Description, ""),
+		RetentionInDays: pointer.SetOrNil(cfg.RetentionInDays, 0),
+	}
+}
+
+func toAtlasRetention(retentionInDays int) *admin.BackupSnapshotRetention {
+	return &admin.BackupSnapshotRetention{
+		RetentionUnit:  RetentionUnitDays,
+		RetentionValue: retentionInDays,
+	}
+}
+
+func fromAtlasSnapshot(snapshot *admin.DiskBackupSnapshot) *Snapshot {
+	return &Snapshot{
+		ID:          snapshot.GetId(),
+		Description: snapshot.GetDescription(),
+		Phase:       phaseFromAtlas(snapshot.GetStatus()),
+		CreatedAt:   snapshot.CreatedAt,
+		ExpiresAt:   snapshot.ExpiresAt,
+	}
+}
+
+func fromAtlasReplicaSet(snapshot *admin.DiskBackupReplicaSet) *Snapshot {
+	return &Snapshot{
+		ID:               snapshot.GetId(),
+		Description:      snapshot.GetDescription(),
+		Phase:            phaseFromAtlas(snapshot.GetStatus()),
+		StorageSizeBytes: snapshot.GetStorageSizeBytes(),
+		CreatedAt:        snapshot.CreatedAt,
+		ExpiresAt:        snapshot.ExpiresAt,
+	}
+}
+
+func fromAtlasShardedCluster(snapshot *admin.DiskBackupShardedClusterSnapshot) *Snapshot {
+	return &Snapshot{
+		ID:               snapshot.GetId(),
+		Description:      snapshot.GetDescription(),
+		Phase:            phaseFromAtlas(snapshot.GetStatus()),
+		StorageSizeBytes: snapshot.GetStorageSizeBytes(),
+		CreatedAt:        snapshot.CreatedAt,
+		ExpiresAt:        snapshot.ExpiresAt,
+	}
+}
+
+func phaseFromAtlas(atlasStatus string) status.AtlasBackupSnapshotPhase {
+	switch atlasStatus {
+	case atlasStatusCompleted:
+		return status.BackupSnapshotPhaseCompleted
+	case atlasStatusFailed:
+		return status.BackupSnapshotPhaseFailed
+	case atlasStatusQueued:
+		return status.BackupSnapshotPhaseQueued
+	default:
+		return status.BackupSnapshotPhaseInProgress
+	}
+}
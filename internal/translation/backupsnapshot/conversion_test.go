@@ -0,0 +1,93 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupsnapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func TestPhaseFromAtlas(t *testing.T) {
+	for atlasStatus, want := range map[string]status.AtlasBackupSnapshotPhase{
+		"queued":     status.BackupSnapshotPhaseQueued,
+		"inProgress": status.BackupSnapshotPhaseInProgress,
+		"completed":  status.BackupSnapshotPhaseCompleted,
+		"failed":     status.BackupSnapshotPhaseFailed,
+		"":           status.BackupSnapshotPhaseInProgress,
+	} {
+		assert.Equal(t, want, phaseFromAtlas(atlasStatus), "Atlas status %q", atlasStatus)
+	}
+}
+
+func TestApplySnapshotStatus(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+	now := createdAt.Add(time.Hour)
+	snapshot := &Snapshot{
+		ID:               testSnapshotID,
+		StorageSizeBytes: 2048,
+		CreatedAt:        &createdAt,
+		ExpiresAt:        &expiresAt,
+	}
+
+	t.Run("in progress snapshots have no completion time", func(t *testing.T) {
+		snapshotStatus := status.AtlasBackupSnapshotStatus{}
+		snapshot.Phase = status.BackupSnapshotPhaseInProgress
+		ApplySnapshotStatus(&snapshotStatus, snapshot, now)
+		assert.Equal(t, status.AtlasBackupSnapshotStatus{
+			ID:               testSnapshotID,
+			Phase:            status.BackupSnapshotPhaseInProgress,
+			StorageSizeBytes: 2048,
+			CreatedAt:        "2025-01-02T03:04:05Z",
+			ExpiresAt:        "2025-01-09T03:04:05Z",
+		}, snapshotStatus)
+	})
+
+	t.Run("completion time is recorded once", func(t *testing.T) {
+		snapshotStatus := status.AtlasBackupSnapshotStatus{}
+		snapshot.Phase = status.BackupSnapshotPhaseCompleted
+		ApplySnapshotStatus(&snapshotStatus, snapshot, now)
+		assert.Equal(t, "2025-01-02T04:04:05Z", snapshotStatus.CompletedAt)
+
+		ApplySnapshotStatus(&snapshotStatus, snapshot, now.Add(time.Hour))
+		assert.Equal(t, "2025-01-02T04:04:05Z", snapshotStatus.CompletedAt)
+	})
+}
+
+func TestToAtlas(t *testing.T) {
+	request := toAtlas(&SnapshotConfig{Description: "before migration", RetentionInDays: 7})
+	assert.Equal(t, "before migration", request.GetDescription())
+	assert.Equal(t, 7, request.GetRetentionInDays())
+
+	retention := toAtlasRetention(7)
+	assert.Equal(t, RetentionUnitDays, retention.RetentionUnit)
+	assert.Equal(t, 7, retention.RetentionValue)
+}
+
+func TestMatches(t *testing.T) {
+	requestedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := &SnapshotConfig{Description: "before migration", RetentionInDays: 7, RequestedAt: requestedAt}
+
+	assert.True(t, cfg.Matches(&Snapshot{Description: "before migration", Phase: status.BackupSnapshotPhaseQueued, CreatedAt: new(requestedAt)}))
+	assert.True(t, cfg.Matches(&Snapshot{Description: "before migration", Phase: status.BackupSnapshotPhaseCompleted, CreatedAt: new(requestedAt.Add(time.Minute))}))
+	assert.False(t, cfg.Matches(&Snapshot{Description: "nightly", Phase: status.BackupSnapshotPhaseCompleted, CreatedAt: new(requestedAt.Add(time.Minute))}))
+	assert.False(t, cfg.Matches(&Snapshot{Description: "before migration", Phase: status.BackupSnapshotPhaseCompleted, CreatedAt: new(requestedAt.Add(-time.Minute))}),
+		"snapshots taken before the request belong to an older request")
+	assert.False(t, cfg.Matches(&Snapshot{Description: "before migration", Phase: status.BackupSnapshotPhaseFailed, CreatedAt: new(requestedAt.Add(time.Minute))}))
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+// DestructiveChanges describes the changes from current to desired that lose data or capacity and cannot be undone:
+// decreasing the disk size, removing shards or downgrading the MongoDB major version.
+func DestructiveChanges(desired, current *Cluster) []string {
+	changes := []string{}
+	if desired.DiskSizeGB != nil && current.DiskSizeGB != nil && *desired.DiskSizeGB < *current.DiskSizeGB {
+		changes = append(changes, fmt.Sprintf("reduce the disk size from %d to %d GB", *current.DiskSizeGB, *desired.DiskSizeGB))
+	}
+	if desiredShards, currentShards := shards(desired, current); desiredShards < currentShards {
+		changes = append(changes, fmt.Sprintf("reduce the number of shards from %d to %d", currentShards, desiredShards))
+	}
+	if desired.MongoDBMajorVersion != "" && versionLess(desired.MongoDBMajorVersion, current.MongoDBMajorVersion) {
+		changes = append(changes, fmt.Sprintf("downgrade the MongoDB major version from %s to %s", current.MongoDBMajorVersion, desired.MongoDBMajorVersion))
+	}
+	return changes
+}
+
+// shards returns the desired and current number of shards, following shardsChanged.
+func shards(desired, current *Cluster) (int, int) {
+	if desired.ClusterType != string(akov2.TypeSharded) || current.ClusterType != string(akov2.TypeSharded) {
+		return len(desired.ReplicationSpecs), len(current.ReplicationSpecs)
+	}
+	desiredShards := 0
+	for _, replicationSpec := range desired.ReplicationSpecs {
+		if replicationSpec != nil {
+			desiredShards = max(desiredShards, replicationSpec.NumShards)
+		}
+	}
+	return desiredShards, len(current.ReplicationSpecs)
+}
+
+// versionLess compares MongoDB major versions such as "7.0" and "8.0", unparsable versions are never less.
+func versionLess(version, than string) bool {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	t, ok := parseVersion(than)
+	if !ok {
+		return false
+	}
+	if v[0] != t[0] {
+		return v[0] < t[0]
+	}
+	return v[1] < t[1]
+}
+
+func parseVersion(version string) ([2]int, bool) {
+	major, minor, _ := strings.Cut(version, ".")
+	majorNumber, err := strconv.Atoi(major)
+	if err != nil {
+		return [2]int{}, false
+	}
+	minorNumber := 0
+	if minor != "" {
+		if minorNumber, err = strconv.Atoi(minor); err != nil {
+			return [2]int{}, false
+		}
+	}
+	return [2]int{majorNumber, minorNumber}, true
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestDestructiveChanges(t *testing.T) {
+	tests := map[string]struct {
+		desired  func(*Cluster)
+		current  func(*Cluster)
+		expected []string
+	}{
+		"no changes": {
+			desired:  func(*Cluster) {},
+			current:  func(*Cluster) {},
+			expected: []string{},
+		},
+		"disk size increased": {
+			desired:  func(c *Cluster) { c.DiskSizeGB = new(40) },
+			current:  func(c *Cluster) { c.DiskSizeGB = new(20) },
+			expected: []string{},
+		},
+		"disk size decreased": {
+			desired:  func(c *Cluster) { c.DiskSizeGB = new(20) },
+			current:  func(c *Cluster) { c.DiskSizeGB = new(40) },
+			expected: []string{"reduce the disk size from 40 to 20 GB"},
+		},
+		"shards added": {
+			desired: func(c *Cluster) { c.ReplicationSpecs[0].NumShards = 3 },
+			current: func(c *Cluster) {
+				c.ReplicationSpecs = append(c.ReplicationSpecs, c.ReplicationSpecs[0].DeepCopy())
+			},
+			expected: []string{},
+		},
+		"shards removed": {
+			desired: func(c *Cluster) { c.ReplicationSpecs[0].NumShards = 1 },
+			current: func(c *Cluster) {
+				c.ReplicationSpecs = append(c.ReplicationSpecs, c.ReplicationSpecs[0].DeepCopy(), c.ReplicationSpecs[0].DeepCopy())
+			},
+			expected: []string{"reduce the number of shards from 3 to 1"},
+		},
+		"geo-sharded zone removed": {
+			desired: func(c *Cluster) { c.ClusterType = string(akov2.TypeGeoSharded) },
+			current: func(c *Cluster) {
+				c.ClusterType = string(akov2.TypeGeoSharded)
+				c.ReplicationSpecs = append(c.ReplicationSpecs, c.ReplicationSpecs[0].DeepCopy())
+			},
+			expected: []string{"reduce the number of shards from 2 to 1"},
+		},
+		"version upgraded": {
+			desired:  func(c *Cluster) { c.MongoDBMajorVersion = "8.0" },
+			current:  func(*Cluster) {},
+			expected: []string{},
+		},
+		"version downgraded": {
+			desired:  func(c *Cluster) { c.MongoDBMajorVersion = "6.0" },
+			current:  func(*Cluster) {},
+			expected: []string{"downgrade the MongoDB major version from 7.0 to 6.0"},
+		},
+		"version not managed": {
+			desired:  func(c *Cluster) { c.MongoDBMajorVersion = "" },
+			current:  func(*Cluster) {},
+			expected: []string{},
+		},
+		"all": {
+			desired: func(c *Cluster) {
+				c.DiskSizeGB = new(10)
+				c.MongoDBMajorVersion = "6.0"
+			},
+			current: func(c *Cluster) {
+				c.DiskSizeGB = new(20)
+				c.ReplicationSpecs = append(c.ReplicationSpecs, c.ReplicationSpecs[0].DeepCopy())
+			},
+			expected: []string{
+				"reduce the disk size from 20 to 10 GB",
+				"reduce the number of shards from 2 to 1",
+				"downgrade the MongoDB major version from 7.0 to 6.0",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			desired := testDisruptiveCluster(string(akov2.TypeSharded), 1, "US_EAST_1")
+			tt.desired(desired)
+			current := testDisruptiveCluster(string(akov2.TypeSharded), 1, "US_EAST_1")
+			tt.current(current)
+			assert.Equal(t, tt.expected, DestructiveChanges(desired, current))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"slices"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+// Disruptive changes of a deployment, they restart, resync or move the nodes of the deployment.
+const (
+	ChangeClusterType         = "cluster type"
+	ChangeMongoDBMajorVersion = "MongoDB major version"
+	ChangeShards              = "number of shards"
+	ChangeRegions             = "regions"
+	ChangeInstanceSize        = "instance size"
+)
+
+// DisruptiveChanges returns the disruptive changes from current to desired. Other changes, e.g. to the backup
+// settings, the tags, the node counts or the auto-scaling range, are applied without disruption.
+func DisruptiveChanges(desired, current *Cluster) []string {
+	changes := []string{}
+	if desired.ClusterType != current.ClusterType {
+		changes = append(changes, ChangeClusterType)
+	}
+	if desired.MongoDBMajorVersion != "" && desired.MongoDBMajorVersion != current.MongoDBMajorVersion {
+		changes = append(changes, ChangeMongoDBMajorVersion)
+	}
+	if shardsChanged(desired, current) {
+		return append(changes, ChangeShards)
+	}
+
+	regionsChanged, instanceSizeChanged := false, false
+	for ix, desiredReplicationSpec := range desired.ReplicationSpecs {
+		if ix >= len(current.ReplicationSpecs) {
+			break
+		}
+		currentReplicationSpec := current.ReplicationSpecs[ix]
+		if desiredReplicationSpec == nil || currentReplicationSpec == nil {
+			continue
+		}
+		if regionsMoved(desiredReplicationSpec, currentReplicationSpec) {
+			regionsChanged = true
+			continue
+		}
+		if !desired.computeAutoscalingEnabled && instanceSizesChanged(desiredReplicationSpec, currentReplicationSpec) {
+			instanceSizeChanged = true
+		}
+	}
+	if regionsChanged {
+		changes = append(changes, ChangeRegions)
+	}
+	if instanceSizeChanged {
+		changes = append(changes, ChangeInstanceSize)
+	}
+	return changes
+}
+
+// WithoutDisruptiveChanges returns a copy of desired in which the disruptive changes are reverted to current,
+// except for the allowed ones. ComputeChanges then only returns the changes that can be applied without disruption.
+func WithoutDisruptiveChanges(desired, current *Cluster, allowed ...string) *Cluster {
+	held := *desired
+	held.AdvancedDeploymentSpec = desired.AdvancedDeploymentSpec.DeepCopy()
+
+	if !slices.Contains(allowed, ChangeClusterType) {
+		held.ClusterType = current.ClusterType
+	}
+	if held.MongoDBMajorVersion != "" && !slices.Contains(allowed, ChangeMongoDBMajorVersion) {
+		held.MongoDBMajorVersion = current.MongoDBMajorVersion
+	}
+	if shardsChanged(&held, current) {
+		if slices.Contains(allowed, ChangeShards) {
+			return &held
+		}
+		if held.ClusterType != string(akov2.TypeSharded) {
+			held.ReplicationSpecs = copyReplicationSpecs(current.ReplicationSpecs)
+			return &held
+		}
+		for _, replicationSpec := range held.ReplicationSpecs {
+			if replicationSpec != nil {
+				replicationSpec.NumShards = len(current.ReplicationSpecs)
+			}
+		}
+		// replication specs added to the spec are shards as well
+		if len(held.ReplicationSpecs) > len(current.ReplicationSpecs) {
+			held.ReplicationSpecs = held.ReplicationSpecs[:len(current.ReplicationSpecs)]
+		}
+	}
+
+	for ix, heldReplicationSpec := range held.ReplicationSpecs {
+		if ix >= len(current.ReplicationSpecs) {
+			break
+		}
+		currentReplicationSpec := current.ReplicationSpecs[ix]
+		if heldReplicationSpec == nil || currentReplicationSpec == nil {
+			continue
+		}
+		if regionsMoved(heldReplicationSpec, currentReplicationSpec) {
+			if !slices.Contains(allowed, ChangeRegions) {
+				heldReplicationSpec.RegionConfigs = copyReplicationSpecs([]*akov2.AdvancedReplicationSpec{currentReplicationSpec})[0].RegionConfigs
+			}
+			continue
+		}
+		if held.computeAutoscalingEnabled || slices.Contains(allowed, ChangeInstanceSize) {
+			continue
+		}
+		for regIx, regionConfig := range heldReplicationSpec.RegionConfigs {
+			currentRegionConfig := currentReplicationSpec.RegionConfigs[regIx]
+			if regionConfig == nil || currentRegionConfig == nil {
+				continue
+			}
+			keepInstanceSize(regionConfig.ElectableSpecs, currentRegionConfig.ElectableSpecs)
+			keepInstanceSize(regionConfig.ReadOnlySpecs, currentRegionConfig.ReadOnlySpecs)
+			keepInstanceSize(regionConfig.AnalyticsSpecs, currentRegionConfig.AnalyticsSpecs)
+		}
+	}
+
+	return &held
+}
+
+// shardsChanged follows specAreEqual: the replication specs of sharded deployments hold the number of shards,
+// Atlas returns a replication spec per shard.
+func shardsChanged(desired, current *Cluster) bool {
+	if desired.ClusterType != string(akov2.TypeSharded) {
+		return len(desired.ReplicationSpecs) != len(current.ReplicationSpecs)
+	}
+	for _, replicationSpec := range desired.ReplicationSpecs {
+		if replicationSpec != nil && replicationSpec.NumShards != len(current.ReplicationSpecs) {
+			return true
+		}
+	}
+	return len(desired.ReplicationSpecs) > len(current.ReplicationSpecs)
+}
+
+// regionsMoved returns whether regions are added to, removed from or replaced in the replication spec.
+func regionsMoved(desired, current *akov2.AdvancedReplicationSpec) bool {
+	if len(desired.RegionConfigs) != len(current.RegionConfigs) {
+		return true
+	}
+	for regIx, regionConfig := range desired.RegionConfigs {
+		currentRegionConfig := current.RegionConfigs[regIx]
+		if regionConfig == nil || currentRegionConfig == nil {
+			continue
+		}
+		if regionConfig.ProviderName != currentRegionConfig.ProviderName || regionConfig.RegionName != currentRegionConfig.RegionName {
+			return true
+		}
+	}
+	return false
+}
+
+func instanceSizesChanged(desired, current *akov2.AdvancedReplicationSpec) bool {
+	for regIx, regionConfig := range desired.RegionConfigs {
+		currentRegionConfig := current.RegionConfigs[regIx]
+		if regionConfig == nil || currentRegionConfig == nil {
+			continue
+		}
+		if instanceSizeChanged(regionConfig.ElectableSpecs, currentRegionConfig.ElectableSpecs) ||
+			instanceSizeChanged(regionConfig.ReadOnlySpecs, currentRegionConfig.ReadOnlySpecs) ||
+			instanceSizeChanged(regionConfig.AnalyticsSpecs, currentRegionConfig.AnalyticsSpecs) {
+			return true
+		}
+	}
+	return false
+}
+
+func instanceSizeChanged(desired, current *akov2.Specs) bool {
+	return desired != nil && current != nil && desired.InstanceSize != current.InstanceSize
+}
+
+func keepInstanceSize(desired, current *akov2.Specs) {
+	if desired != nil && current != nil {
+		desired.InstanceSize = current.InstanceSize
+	}
+}
+
+func copyReplicationSpecs(replicationSpecs []*akov2.AdvancedReplicationSpec) []*akov2.AdvancedReplicationSpec {
+	copied := make([]*akov2.AdvancedReplicationSpec, 0, len(replicationSpecs))
+	for _, replicationSpec := range replicationSpecs {
+		copied = append(copied, replicationSpec.DeepCopy())
+	}
+	return copied
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func testDisruptiveCluster(clusterType string, numShards int, regions ...string) *Cluster {
+	replicationSpec := &akov2.AdvancedReplicationSpec{
+		NumShards: numShards,
+		ZoneName:  "Zone 1",
+	}
+	for i, region := range regions {
+		replicationSpec.RegionConfigs = append(replicationSpec.RegionConfigs, &akov2.AdvancedRegionConfig{
+			ProviderName: "AWS",
+			RegionName:   region,
+			Priority:     new(7 - i),
+			ElectableSpecs: &akov2.Specs{
+				InstanceSize: "M10",
+				NodeCount:    new(3),
+			},
+		})
+	}
+
+	return &Cluster{
+		ProjectID: "project-id",
+		AdvancedDeploymentSpec: &akov2.AdvancedDeploymentSpec{
+			Name:                "cluster0",
+			ClusterType:         clusterType,
+			MongoDBMajorVersion: "7.0",
+			ReplicationSpecs:    []*akov2.AdvancedReplicationSpec{replicationSpec},
+		},
+	}
+}
+
+func TestDisruptiveChanges(t *testing.T) {
+	tests := map[string]struct {
+		desired  func(*Cluster)
+		current  *Cluster
+		expected []string
+	}{
+		"no changes": {
+			desired:  func(*Cluster) {},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{},
+		},
+		"node count and backup are not disruptive": {
+			desired: func(c *Cluster) {
+				c.BackupEnabled = new(true)
+				c.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount = new(5)
+			},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{},
+		},
+		"unset major version is not disruptive": {
+			desired:  func(c *Cluster) { c.MongoDBMajorVersion = "" },
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{},
+		},
+		"major version and instance size": {
+			desired: func(c *Cluster) {
+				c.MongoDBMajorVersion = "8.0"
+				c.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+			},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{ChangeMongoDBMajorVersion, ChangeInstanceSize},
+		},
+		"instance size under compute auto-scaling": {
+			desired: func(c *Cluster) {
+				c.computeAutoscalingEnabled = true
+				c.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+			},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{},
+		},
+		"region moved": {
+			desired: func(c *Cluster) {
+				c.ReplicationSpecs[0].RegionConfigs[0].RegionName = "US_WEST_2"
+				c.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+			},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{ChangeRegions},
+		},
+		"region added": {
+			desired:  func(*Cluster) {},
+			current:  testDisruptiveCluster("REPLICASET", 1),
+			expected: []string{ChangeRegions},
+		},
+		"shards added": {
+			desired: func(c *Cluster) {
+				c.ClusterType = "SHARDED"
+				c.ReplicationSpecs[0].NumShards = 3
+			},
+			current:  testDisruptiveCluster("SHARDED", 1, "US_EAST_1"),
+			expected: []string{ChangeShards},
+		},
+		"replica set to sharded": {
+			desired: func(c *Cluster) {
+				c.ClusterType = "SHARDED"
+			},
+			current:  testDisruptiveCluster("REPLICASET", 1, "US_EAST_1"),
+			expected: []string{ChangeClusterType},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			desired := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+			tt.desired(desired)
+			assert.Equal(t, tt.expected, DisruptiveChanges(desired, tt.current))
+		})
+	}
+}
+
+func TestWithoutDisruptiveChanges(t *testing.T) {
+	t.Run("reverts disruptive changes and keeps the others", func(t *testing.T) {
+		desired := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+		desired.MongoDBMajorVersion = "8.0"
+		desired.BackupEnabled = new(true)
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount = new(5)
+		current := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+
+		held := WithoutDisruptiveChanges(desired, current)
+
+		assert.Empty(t, DisruptiveChanges(held, current))
+		assert.Equal(t, "7.0", held.MongoDBMajorVersion)
+		assert.Equal(t, new(true), held.BackupEnabled)
+		assert.Equal(t, "M10", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Equal(t, new(5), held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount)
+		assert.Equal(t, "8.0", desired.MongoDBMajorVersion, "desired must not be modified")
+		assert.Equal(t, "M20", desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+	})
+
+	t.Run("keeps allowed changes", func(t *testing.T) {
+		desired := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+		desired.MongoDBMajorVersion = "8.0"
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+		current := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+
+		held := WithoutDisruptiveChanges(desired, current, ChangeInstanceSize)
+
+		assert.Equal(t, []string{ChangeInstanceSize}, DisruptiveChanges(held, current))
+		assert.Equal(t, "7.0", held.MongoDBMajorVersion)
+	})
+
+	t.Run("reverts moved regions", func(t *testing.T) {
+		desired := testDisruptiveCluster("REPLICASET", 1, "US_WEST_2", "US_EAST_2")
+		current := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+
+		held := WithoutDisruptiveChanges(desired, current)
+
+		assert.Empty(t, DisruptiveChanges(held, current))
+		assert.Equal(t, current.ReplicationSpecs[0].RegionConfigs, held.ReplicationSpecs[0].RegionConfigs)
+		assert.NotSame(t, current.ReplicationSpecs[0].RegionConfigs[0], held.ReplicationSpecs[0].RegionConfigs[0])
+	})
+
+	t.Run("reverts the number of shards", func(t *testing.T) {
+		desired := testDisruptiveCluster("SHARDED", 3, "US_EAST_1")
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount = new(5)
+		current := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+		current.ReplicationSpecs = append(current.ReplicationSpecs, current.ReplicationSpecs[0].DeepCopy())
+
+		held := WithoutDisruptiveChanges(desired, current)
+
+		assert.Empty(t, DisruptiveChanges(held, current))
+		assert.Equal(t, 2, held.ReplicationSpecs[0].NumShards)
+		assert.Equal(t, new(5), held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount)
+	})
+
+	t.Run("reverts replication specs added to a sharded deployment", func(t *testing.T) {
+		desired := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+		desired.ReplicationSpecs = append(desired.ReplicationSpecs, desired.ReplicationSpecs[0].DeepCopy())
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+		current := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+
+		assert.Equal(t, []string{ChangeShards}, DisruptiveChanges(desired, current))
+		held := WithoutDisruptiveChanges(desired, current)
+
+		assert.Empty(t, DisruptiveChanges(held, current))
+		assert.Len(t, held.ReplicationSpecs, 1)
+		assert.Equal(t, 1, held.ReplicationSpecs[0].NumShards)
+		assert.Equal(t, "M10", held.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize)
+		assert.Len(t, desired.ReplicationSpecs, 2, "desired must not be modified")
+	})
+
+	t.Run("keeps replication specs added when the shards are allowed", func(t *testing.T) {
+		desired := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+		desired.ReplicationSpecs = append(desired.ReplicationSpecs, desired.ReplicationSpecs[0].DeepCopy())
+		desired.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M20"
+		current := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+
+		held := WithoutDisruptiveChanges(desired, current, ChangeShards)
+
+		assert.Len(t, held.ReplicationSpecs, 2)
+	})
+
+	t.Run("reverts the cluster type", func(t *testing.T) {
+		desired := testDisruptiveCluster("SHARDED", 1, "US_EAST_1")
+		current := testDisruptiveCluster("REPLICASET", 1, "US_EAST_1")
+
+		held := WithoutDisruptiveChanges(desired, current)
+
+		assert.Empty(t, DisruptiveChanges(held, current))
+		assert.Equal(t, "REPLICASET", held.ClusterType)
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlinearchive
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	StatePending   = "PENDING"
+	StateActive    = "ACTIVE"
+	StateArchiving = "ARCHIVING"
+	StateIdle      = "IDLE"
+	StatePausing   = "PAUSING"
+	StatePaused    = "PAUSED"
+	StateOrphaned  = "ORPHANED"
+	StateDeleted   = "DELETED"
+
+	CriteriaTypeDate   = "DATE"
+	CriteriaTypeCustom = "CUSTOM"
+
+	defaultCollectionType = "STANDARD"
+	defaultDateFormat     = "ISODATE"
+	defaultScheduleType   = "DEFAULT"
+)
+
+// OnlineArchiveConfig is the Online Archive requested to Atlas, with the Atlas defaults applied
+type OnlineArchiveConfig struct {
+	akov2.AtlasOnlineArchiveConfig
+}
+
+// OnlineArchive is an Online Archive as observed in Atlas
+type OnlineArchive struct {
+	OnlineArchiveConfig
+	ID    string
+	State string
+}
+
+func NewOnlineArchiveConfig(config *akov2.AtlasOnlineArchiveConfig) *OnlineArchiveConfig {
+	cfg := &OnlineArchiveConfig{AtlasOnlineArchiveConfig: *config.DeepCopy()}
+	cfg.normalize()
+	return cfg
+}
+
+// normalize applies the defaults Atlas reports back, so that configs can be compared
+func (cfg *OnlineArchiveConfig) normalize() {
+	if cfg.CollectionType == "" {
+		cfg.CollectionType = defaultCollectionType
+	}
+	if cfg.Criteria.Type == CriteriaTypeDate && cfg.Criteria.DateFormat == "" {
+		cfg.Criteria.DateFormat = defaultDateFormat
+	}
+	if cfg.Schedule == nil {
+		cfg.Schedule = &akov2.OnlineArchiveSchedule{Type: defaultScheduleType}
+	}
+	if len(cfg.PartitionFields) == 0 {
+		cfg.PartitionFields = nil
+	}
+	slices.SortFunc(cfg.PartitionFields, func(a, b akov2.OnlineArchivePartitionField) int {
+		return a.Order - b.Order
+	})
+}
+
+// IsActive is true when the archive state matches the desired paused state
+func (oa *OnlineArchive) IsActive(paused bool) bool {
+	if paused {
+		return oa.State == StatePaused
+	}
+	switch oa.State {
+	case StateActive, StateArchiving, StateIdle:
+		return true
+	}
+	return false
+}
+
+// NeedsUpdate is true when the updatable settings of the archive differ from the desired ones.
+// The namespace, partition fields and criteria type cannot be changed in Atlas.
+func NeedsUpdate(desired *OnlineArchiveConfig, current *OnlineArchive) bool {
+	return desired.Paused != current.Paused ||
+		!equalCriteria(&desired.Criteria, &current.Criteria) ||
+		!reflect.DeepEqual(desired.Schedule, current.Schedule) ||
+		!reflect.DeepEqual(desired.DataExpirationRule, current.DataExpirationRule)
+}
+
+// equalCriteria compares criteria with queries as JSON, as Atlas may format them differently
+func equalCriteria(a, b *akov2.OnlineArchiveCriteria) bool {
+	aCopy, bCopy := *a, *b
+	aCopy.Query, bCopy.Query = "", ""
+	if !reflect.DeepEqual(aCopy, bCopy) {
+		return false
+	}
+	if a.Query == b.Query {
+		return true
+	}
+	var aQuery, bQuery any
+	if json.Unmarshal([]byte(a.Query), &aQuery) != nil || json.Unmarshal([]byte(b.Query), &bQuery) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aQuery, bQuery)
+}
+
+func ApplyOnlineArchiveStatus(archiveStatus *status.AtlasOnlineArchiveStatus, archive *OnlineArchive) {
+	archiveStatus.ID = archive.ID
+	archiveStatus.State = archive.State
+}
+
+func toAtlasCreate(cfg *OnlineArchiveConfig) *admin.BackupOnlineArchiveCreate {
+	return &admin.BackupOnlineArchiveCreate{
+		DbName:             cfg.DBName,
+		CollName:           cfg.CollName,
+		CollectionType:     pointer.SetOrNil(cfg.CollectionType, ""),
+		Criteria:           *toAtlasCriteria(&cfg.Criteria),
+		PartitionFields:    toAtlasPartitionFields(cfg.PartitionFields),
+		Schedule:           toAtlasSchedule(cfg.Schedule),
+		DataExpirationRule: toAtlasDataExpirationRule(cfg.DataExpirationRule),
+		Paused:             new(cfg.Paused),
+	}
+}
+
+// toAtlasUpdate sets the settings that can be changed after creation.
+// A missing data expiration rule is sent empty, which removes it in Atlas.
+func toAtlasUpdate(cfg *OnlineArchiveConfig) *admin.BackupOnlineArchive {
+	dataExpirationRule := toAtlasDataExpirationRule(cfg.DataExpirationRule)
+	if dataExpirationRule == nil {
+		dataExpirationRule = &admin.DataExpirationRule{}
+	}
+	return &admin.BackupOnlineArchive{
+		Criteria:           toAtlasCriteria(&cfg.Criteria),
+		Schedule:           toAtlasSchedule(cfg.Schedule),
+		DataExpirationRule: dataExpirationRule,
+		Paused:             new(cfg.Paused),
+	}
+}
+
+func toAtlasCriteria(criteria *akov2.OnlineArchiveCriteria) *admin.Criteria {
+	return &admin.Criteria{
+		Type:            pointer.SetOrNil(criteria.Type, ""),
+		DateField:       pointer.SetOrNil(criteria.DateField, ""),
+		DateFormat:      pointer.SetOrNil(criteria.DateFormat, ""),
+		ExpireAfterDays: criteria.ExpireAfterDays,
+		Query:           pointer.SetOrNil(criteria.Query, ""),
+	}
+}
+
+func toAtlasPartitionFields(fields []akov2.OnlineArchivePartitionField) *[]admin.PartitionField {
+	if len(fields) == 0 {
+		return nil
+	}
+	atlasFields := make([]admin.PartitionField, 0, len(fields))
+	for _, field := range fields {
+		atlasFields = append(atlasFields, admin.PartitionField{
+			FieldName: field.FieldName,
+			Order:     field.Order,
+		})
+	}
+	return &atlasFields
+}
+
+func toAtlasSchedule(schedule *akov2.OnlineArchiveSchedule) *admin.OnlineArchiveSchedule {
+	if schedule == nil {
+		return nil
+	}
+	return &admin.OnlineArchiveSchedule{
+		Type:        schedule.Type,
+		StartHour:   schedule.StartHour,
+		StartMinute: schedule.StartMinute,
+		EndHour:     schedule.EndHour,
+		EndMinute:   schedule.EndMinute,
+		DayOfWeek:   schedule.DayOfWeek,
+		DayOfMonth:  schedule.DayOfMonth,
+	}
+}
+
+func toAtlasDataExpirationRule(rule *akov2.OnlineArchiveDataExpirationRule) *admin.DataExpirationRule {
+	if rule == nil {
+		return nil
+	}
+	return &admin.DataExpirationRule{ExpireAfterDays: new(rule.ExpireAfterDays)}
+}
+
+func fromAtlas(archive *admin.BackupOnlineArchive) *OnlineArchive {
+	cfg := OnlineArchiveConfig{
+		AtlasOnlineArchiveConfig: akov2.AtlasOnlineArchiveConfig{
+			DBName:             archive.GetDbName(),
+			CollName:           archive.GetCollName(),
+			CollectionType:     archive.GetCollectionType(),
+			Criteria:           fromAtlasCriteria(archive.Criteria),
+			PartitionFields:    fromAtlasPartitionFields(archive.PartitionFields),
+			Schedule:           fromAtlasSchedule(archive.Schedule),
+			DataExpirationRule: fromAtlasDataExpirationRule(archive.DataExpirationRule),
+			Paused:             archive.GetPaused(),
+		},
+	}
+	cfg.normalize()
+	return &OnlineArchive{
+		OnlineArchiveConfig: cfg,
+		ID:                  archive.GetId(),
+		State:               archive.GetState(),
+	}
+}
+
+func fromAtlasCriteria(criteria *admin.Criteria) akov2.OnlineArchiveCriteria {
+	if criteria == nil {
+		return akov2.OnlineArchiveCriteria{}
+	}
+	return akov2.OnlineArchiveCriteria{
+		Type:            criteria.GetType(),
+		DateField:       criteria.GetDateField(),
+		DateFormat:      criteria.GetDateFormat(),
+		ExpireAfterDays: criteria.ExpireAfterDays,
+		Query:           criteria.GetQuery(),
+	}
+}
+
+func fromAtlasPartitionFields(fields *[]admin.PartitionField) []akov2.OnlineArchivePartitionField {
+	if fields == nil || len(*fields) == 0 {
+		return nil
+	}
+	partitionFields := make([]akov2.OnlineArchivePartitionField, 0, len(*fields))
+	for _, field := range *fields {
+		partitionFields = append(partitionFields, akov2.OnlineArchivePartitionField{
+			FieldName: field.FieldName,
+			Order:     field.Order,
+		})
+	}
+	return partitionFields
+}
+
+func fromAtlasSchedule(schedule *admin.OnlineArchiveSchedule) *akov2.OnlineArchiveSchedule {
+	if schedule == nil {
+		return nil
+	}
+	return &akov2.OnlineArchiveSchedule{
+		Type:        schedule.Type,
+		StartHour:   schedule.StartHour,
+		StartMinute: schedule.StartMinute,
+		EndHour:     schedule.EndHour,
+		EndMinute:   schedule.EndMinute,
+		DayOfWeek:   schedule.DayOfWeek,
+		DayOfMonth:  schedule.DayOfMonth,
+	}
+}
+
+func fromAtlasDataExpirationRule(rule *admin.DataExpirationRule) *akov2.OnlineArchiveDataExpirationRule {
+	if rule == nil || rule.ExpireAfterDays == nil {
+		return nil
+	}
+	return &akov2.OnlineArchiveDataExpirationRule{ExpireAfterDays: rule.GetExpireAfterDays()}
+}
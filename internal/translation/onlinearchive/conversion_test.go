@@ -0,0 +1,172 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlinearchive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestRoundtrip(t *testing.T) {
+	for _, tc := range []struct {
+		title  string
+		config akov2.AtlasOnlineArchiveConfig
+	}{
+		{
+			title: "date criteria with defaults",
+			config: akov2.AtlasOnlineArchiveConfig{
+				DBName:   "sample",
+				CollName: "orders",
+				Criteria: akov2.OnlineArchiveCriteria{
+					Type:            CriteriaTypeDate,
+					DateField:       "createdAt",
+					ExpireAfterDays: new(90),
+				},
+			},
+		},
+		{
+			title: "custom criteria with all settings",
+			config: akov2.AtlasOnlineArchiveConfig{
+				DBName:         "sample",
+				CollName:       "metrics",
+				CollectionType: "TIMESERIES",
+				Criteria: akov2.OnlineArchiveCriteria{
+					Type:  CriteriaTypeCustom,
+					Query: `{"status":"closed"}`,
+				},
+				PartitionFields: []akov2.OnlineArchivePartitionField{
+					{FieldName: "region", Order: 1},
+					{FieldName: "customerId", Order: 0},
+				},
+				Schedule: &akov2.OnlineArchiveSchedule{
+					Type:        "WEEKLY",
+					DayOfWeek:   new(6),
+					StartHour:   new(1),
+					StartMinute: new(0),
+					EndHour:     new(5),
+					EndMinute:   new(30),
+				},
+				DataExpirationRule: &akov2.OnlineArchiveDataExpirationRule{ExpireAfterDays: 365},
+				Paused:             true,
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			cfg := NewOnlineArchiveConfig(&tc.config)
+			atlasArchive := toAtlasCreate(cfg)
+			archive := fromAtlas(toAtlasArchive(atlasArchive))
+			assert.Equal(t, cfg, &archive.OnlineArchiveConfig)
+			assert.False(t, NeedsUpdate(cfg, archive))
+		})
+	}
+}
+
+func TestNewOnlineArchiveConfigDefaults(t *testing.T) {
+	cfg := NewOnlineArchiveConfig(&akov2.AtlasOnlineArchiveConfig{
+		DBName:   "sample",
+		CollName: "orders",
+		Criteria: akov2.OnlineArchiveCriteria{Type: CriteriaTypeDate, DateField: "createdAt", ExpireAfterDays: new(30)},
+	})
+	assert.Equal(t, "STANDARD", cfg.CollectionType)
+	assert.Equal(t, "ISODATE", cfg.Criteria.DateFormat)
+	assert.Equal(t, &akov2.OnlineArchiveSchedule{Type: "DEFAULT"}, cfg.Schedule)
+}
+
+func TestNeedsUpdate(t *testing.T) {
+	base := func() *OnlineArchiveConfig {
+		return NewOnlineArchiveConfig(&akov2.AtlasOnlineArchiveConfig{
+			DBName:   "sample",
+			CollName: "orders",
+			Criteria: akov2.OnlineArchiveCriteria{Type: CriteriaTypeCustom, Query: `{"status": "closed", "total": {"$lt": 10}}`},
+		})
+	}
+	for _, tc := range []struct {
+		title   string
+		change  func(current *OnlineArchive)
+		updated bool
+	}{
+		{
+			title:  "query formatted differently",
+			change: func(current *OnlineArchive) { current.Criteria.Query = `{"total":{"$lt":10},"status":"closed"}` },
+		},
+		{
+			title:   "query changed",
+			change:  func(current *OnlineArchive) { current.Criteria.Query = `{"status":"open"}` },
+			updated: true,
+		},
+		{
+			title:   "paused",
+			change:  func(current *OnlineArchive) { current.Paused = true },
+			updated: true,
+		},
+		{
+			title: "schedule changed",
+			change: func(current *OnlineArchive) {
+				current.Schedule = &akov2.OnlineArchiveSchedule{Type: "DAILY", StartHour: new(1), StartMinute: new(0), EndHour: new(2), EndMinute: new(0)}
+			},
+			updated: true,
+		},
+		{
+			title: "data expiration rule removed",
+			change: func(current *OnlineArchive) {
+				current.DataExpirationRule = &akov2.OnlineArchiveDataExpirationRule{ExpireAfterDays: 30}
+			},
+			updated: true,
+		},
+		{
+			title: "partition fields are ignored",
+			change: func(current *OnlineArchive) {
+				current.PartitionFields = []akov2.OnlineArchivePartitionField{{FieldName: "status", Order: 0}}
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			current := &OnlineArchive{OnlineArchiveConfig: *base()}
+			tc.change(current)
+			assert.Equal(t, tc.updated, NeedsUpdate(base(), current))
+		})
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	for _, state := range []string{StateActive, StateArchiving, StateIdle} {
+		assert.True(t, (&OnlineArchive{State: state}).IsActive(false), state)
+		assert.False(t, (&OnlineArchive{State: state}).IsActive(true), state)
+	}
+	for _, state := range []string{StatePending, StatePausing, StateOrphaned} {
+		assert.False(t, (&OnlineArchive{State: state}).IsActive(false), state)
+	}
+	assert.True(t, (&OnlineArchive{State: StatePaused}).IsActive(true))
+}
+
+// toAtlasArchive emulates Atlas returning the archive it was requested to create
+func toAtlasArchive(create *admin.BackupOnlineArchiveCreate) *admin.BackupOnlineArchive {
+	return &admin.BackupOnlineArchive{
+		Id:                 new("archive-id"),
+		DbName:             new(create.DbName),
+		CollName:           new(create.CollName),
+		CollectionType:     create.CollectionType,
+		Criteria:           &create.Criteria,
+		PartitionFields:    create.PartitionFields,
+		Schedule:           create.Schedule,
+		DataExpirationRule: create.DataExpirationRule,
+		Paused:             create.Paused,
+		State:              new(StatePending),
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onlinearchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+var (
+	// ErrNotFound means the Online Archive is missing
+	ErrNotFound = errors.New("not found")
+)
+
+type OnlineArchiveService interface {
+	Create(ctx context.Context, projectID, clusterName string, cfg *OnlineArchiveConfig) (*OnlineArchive, error)
+	Get(ctx context.Context, projectID, clusterName, archiveID string) (*OnlineArchive, error)
+	Find(ctx context.Context, projectID, clusterName string, cfg *OnlineArchiveConfig) (*OnlineArchive, error)
+	Update(ctx context.Context, projectID, clusterName, archiveID string, cfg *OnlineArchiveConfig) (*OnlineArchive, error)
+	Delete(ctx context.Context, projectID, clusterName, archiveID string) error
+}
+
+type onlineArchiveService struct {
+	onlineArchiveAPI admin.OnlineArchiveAPI
+}
+
+func NewOnlineArchiveServiceFromClientSet(clientSet *atlas.ClientSet) OnlineArchiveService {
+	return NewOnlineArchiveService(clientSet.SdkClient20250312.OnlineArchiveAPI)
+}
+
+func NewOnlineArchiveService(onlineArchiveAPI admin.OnlineArchiveAPI) OnlineArchiveService {
+	return &onlineArchiveService{onlineArchiveAPI: onlineArchiveAPI}
+}
+
+func (s *onlineArchiveService) Create(ctx context.Context, projectID, clusterName string, cfg *OnlineArchiveConfig) (*OnlineArchive, error) {
+	archive, _, err := s.onlineArchiveAPI.CreateOnlineArchive(ctx, projectID, clusterName, toAtlasCreate(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create online archive for cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	return fromAtlas(archive), nil
+}
+
+func (s *onlineArchiveService) Get(ctx context.Context, projectID, clusterName, archiveID string) (*OnlineArchive, error) {
+	archive, resp, err := s.onlineArchiveAPI.GetOnlineArchive(ctx, projectID, archiveID, clusterName).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get online archive %s: %w", archiveID, err)
+	}
+	onlineArchive := fromAtlas(archive)
+	if onlineArchive.State == StateDeleted {
+		return nil, ErrNotFound
+	}
+	return onlineArchive, nil
+}
+
+// Find returns the Online Archive of the same collection, ignoring deleted ones.
+func (s *onlineArchiveService) Find(ctx context.Context, projectID, clusterName string, cfg *OnlineArchiveConfig) (*OnlineArchive, error) {
+	archives, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.BackupOnlineArchive], *http.Response, error) {
+		return s.onlineArchiveAPI.ListOnlineArchives(ctx, projectID, clusterName).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list online archives for cluster %s at project %s: %w", clusterName, projectID, err)
+	}
+	for _, archive := range archives {
+		onlineArchive := fromAtlas(&archive)
+		if onlineArchive.State == StateDeleted {
+			continue
+		}
+		if onlineArchive.DBName == cfg.DBName &&
+			onlineArchive.CollName == cfg.CollName &&
+			onlineArchive.CollectionType == cfg.CollectionType {
+			return onlineArchive, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *onlineArchiveService) Update(ctx context.Context, projectID, clusterName, archiveID string, cfg *OnlineArchiveConfig) (*OnlineArchive, error) {
+	archive, _, err := s.onlineArchiveAPI.UpdateOnlineArchive(ctx, projectID, archiveID, clusterName, toAtlasUpdate(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update online archive %s: %w", archiveID, err)
+	}
+	return fromAtlas(archive), nil
+}
+
+func (s *onlineArchiveService) Delete(ctx context.Context, projectID, clusterName, archiveID string) error {
+	resp, err := s.onlineArchiveAPI.DeleteOnlineArchive(ctx, projectID, archiveID, clusterName).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete online archive %s: %w", archiveID, err)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 
 type FakeAtlasSearch struct {
 	GetIndexFunc    func(ctx context.Context, projectID, clusterName, indexName, indexID string) (*searchindex.SearchIndex, error)
+	FindIndexFunc   func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
 	CreateIndexFunc func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
 	DeleteIndexFunc func(ctx context.Context, projectID, clusterName, indexID string) error
 	UpdateIndexFunc func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
@@ -31,6 +32,10 @@ func (fas *FakeAtlasSearch) GetIndex(ctx context.Context, projectID, clusterName
 	return fas.GetIndexFunc(ctx, projectID, clusterName, indexName, indexID)
 }
 
+func (fas *FakeAtlasSearch) FindIndex(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+	return fas.FindIndexFunc(ctx, projectID, clusterName, index)
+}
+
 func (fas *FakeAtlasSearch) CreateIndex(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
 	return fas.CreateIndexFunc(ctx, projectID, clusterName, index)
 }
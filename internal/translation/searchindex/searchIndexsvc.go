@@ -23,6 +23,7 @@ import (
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 )
 
 var (
@@ -32,6 +33,7 @@ var (
 
 type AtlasSearchIdxService interface {
 	GetIndex(ctx context.Context, projectID, clusterName, indexName, indexID string) (*SearchIndex, error)
+	FindIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error)
 	CreateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error)
 	DeleteIndex(ctx context.Context, projectID, clusterName, indexID string) error
 	UpdateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error)
@@ -66,6 +68,28 @@ func (si *SearchIndexes) GetIndex(ctx context.Context, projectID, clusterName, i
 	return stateInAtlas, nil
 }
 
+// FindIndex looks up an index by its database, collection and name, for indexes whose ID is not known,
+// e.g. indexes created outside of the operator or by an AtlasDeployment.
+func (si *SearchIndexes) FindIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error) {
+	resp, httpResp, err := si.searchAPI.ListClusterSearchIndexes(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes, status code %d: %w", httputil.StatusCode(httpResp), err)
+	}
+	for _, atlasIndex := range resp {
+		if pointer.GetOrDefault(atlasIndex.Database, "") != index.DBName ||
+			pointer.GetOrDefault(atlasIndex.CollectionName, "") != index.CollectionName ||
+			pointer.GetOrDefault(atlasIndex.Name, "") != index.Name {
+			continue
+		}
+		stateInAtlas, err := fromAtlas(atlasIndex)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert index %s: %w", index.Name, err)
+		}
+		return stateInAtlas, nil
+	}
+	return nil, ErrNotFound
+}
+
 func (si *SearchIndexes) CreateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error) {
 	atlasIndex, err := index.toAtlasCreateView()
 	if err != nil {
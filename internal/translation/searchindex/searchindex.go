@@ -38,6 +38,16 @@ type SearchIndex struct {
 	akov2.AtlasSearchIndexConfigSpec
 	ID     *string
 	Status *string
+	// Queryable and Hosts are reported by Atlas only, they are not compared
+	Queryable *bool
+	Hosts     []HostStatus
+}
+
+// HostStatus is the state of the index on a node of the deployment
+type HostStatus struct {
+	Hostname  string
+	Status    string
+	Queryable bool
 }
 
 func (s *SearchIndex) GetID() string {
@@ -116,15 +126,34 @@ func fromAtlas(index admin.SearchIndexResponse) (*SearchIndex, error) {
 			SearchAnalyzer: index.LatestDefinition.SearchAnalyzer,
 			StoredSource:   storedSource,
 		},
-		ID:     index.IndexID,
-		Status: index.Status,
+		ID:        index.IndexID,
+		Status:    index.Status,
+		Queryable: index.Queryable,
+		Hosts:     hostsFromAtlas(index.StatusDetail),
 	}, errors.Join(errs...)
 }
 
+func hostsFromAtlas(details *[]admin.SearchHostStatusDetail) []HostStatus {
+	if details == nil {
+		return nil
+	}
+	hosts := make([]HostStatus, 0, len(*details))
+	for _, detail := range *details {
+		hosts = append(hosts, HostStatus{
+			Hostname:  detail.GetHostname(),
+			Status:    detail.GetStatus(),
+			Queryable: detail.GetQueryable(),
+		})
+	}
+	return hosts
+}
+
 // cleanup normalizes the search index for comparison
 func (s *SearchIndex) cleanup(cleaners ...indexCleaner) {
 	s.ID = nil
 	s.Status = nil
+	s.Queryable = nil
+	s.Hosts = nil
 
 	for _, cleaner := range cleaners {
 		cleaner(s)
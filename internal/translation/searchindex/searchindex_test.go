@@ -201,6 +201,14 @@ func Test_NewSearchIndexFromAtlas(t *testing.T) {
 					Name:           new("name"),
 					Status:         new("ACTIVE"),
 					Type:           new("search"),
+					Queryable:      new(true),
+					StatusDetail: &([]admin.SearchHostStatusDetail{
+						{
+							Hostname:  new("host-0"),
+							Queryable: new(true),
+							Status:    new("READY"),
+						},
+					}),
 					LatestDefinition: &admin.BaseSearchIndexResponseLatestDefinition{
 						Analyzer: new("lucene.standard"),
 						Analyzers: &([]admin.AtlasSearchAnalyzer{
@@ -246,8 +254,12 @@ func Test_NewSearchIndexFromAtlas(t *testing.T) {
 				},
 			},
 			want: &SearchIndex{
-				ID:     new("indexID"),
-				Status: new("ACTIVE"),
+				ID:        new("indexID"),
+				Status:    new("ACTIVE"),
+				Queryable: new(true),
+				Hosts: []HostStatus{
+					{Hostname: "host-0", Status: "READY", Queryable: true},
+				},
 				SearchIndex: akov2.SearchIndex{
 					Name:           "name",
 					DBName:         "db",
@@ -0,0 +1,214 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamprocessor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+const (
+	StateCreated = "CREATED"
+	StateStarted = "STARTED"
+	StateStopped = "STOPPED"
+	StateFailed  = "FAILED"
+)
+
+// StreamProcessorConfig is the stream processor requested to Atlas
+type StreamProcessorConfig struct {
+	Name     string
+	Pipeline []any
+	DLQ      *akov2.StreamProcessorDLQ
+}
+
+// StreamProcessor is a stream processor as observed in Atlas
+type StreamProcessor struct {
+	StreamProcessorConfig
+	ID    string
+	State string
+	Stats *status.StreamProcessorStats
+}
+
+func NewStreamProcessorConfig(spec *akov2.AtlasStreamProcessorSpec) (*StreamProcessorConfig, error) {
+	pipeline, err := parsePipeline(spec.Pipeline.Raw)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &StreamProcessorConfig{
+		Name:     spec.Name,
+		Pipeline: pipeline,
+	}
+	if spec.Options != nil && spec.Options.DLQ != nil {
+		cfg.DLQ = spec.Options.DLQ.DeepCopy()
+	}
+	return cfg, nil
+}
+
+// parsePipeline accepts the stages as a list, or as a string holding the JSON array of stages
+func parsePipeline(raw []byte) ([]any, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("invalid pipeline: %w", err)
+	}
+	if str, ok := value.(string); ok {
+		if err := json.Unmarshal([]byte(str), &value); err != nil {
+			return nil, fmt.Errorf("invalid pipeline JSON: %w", err)
+		}
+	}
+	stages, ok := value.([]any)
+	if !ok {
+		return nil, errors.New("invalid pipeline: expected a list of stages")
+	}
+	return stages, nil
+}
+
+// NeedsUpdate is true when the pipeline or options of the processor differ from the desired ones
+func NeedsUpdate(desired *StreamProcessorConfig, current *StreamProcessor) bool {
+	return !equalPipelines(desired.Pipeline, current.Pipeline) ||
+		!reflect.DeepEqual(desired.DLQ, current.DLQ)
+}
+
+// equalPipelines compares pipelines through their JSON form, as numbers may be decoded differently
+func equalPipelines(a, b []any) bool {
+	aValue, aErr := normalizeJSON(a)
+	bValue, bErr := normalizeJSON(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func normalizeJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+func ApplyStreamProcessorStatus(processorStatus *status.AtlasStreamProcessorStatus, processor *StreamProcessor) {
+	processorStatus.ID = processor.ID
+	processorStatus.State = processor.State
+	if processor.Stats != nil {
+		processorStatus.Stats = processor.Stats
+	}
+}
+
+func toAtlas(cfg *StreamProcessorConfig) *admin.StreamsProcessor {
+	processor := &admin.StreamsProcessor{
+		Name:     new(cfg.Name),
+		Pipeline: &cfg.Pipeline,
+	}
+	if cfg.DLQ != nil {
+		processor.Options = &admin.StreamsOptions{Dlq: toAtlasDLQ(cfg.DLQ)}
+	}
+	return processor
+}
+
+func toAtlasUpdate(cfg *StreamProcessorConfig) *admin.StreamsModifyStreamProcessor {
+	processor := &admin.StreamsModifyStreamProcessor{
+		Name:     new(cfg.Name),
+		Pipeline: &cfg.Pipeline,
+	}
+	if cfg.DLQ != nil {
+		processor.Options = &admin.StreamsModifyStreamProcessorOptions{Dlq: toAtlasDLQ(cfg.DLQ)}
+	}
+	return processor
+}
+
+func toAtlasDLQ(dlq *akov2.StreamProcessorDLQ) *admin.StreamsDLQ {
+	return &admin.StreamsDLQ{
+		ConnectionName: new(dlq.ConnectionName),
+		Db:             new(dlq.DB),
+		Coll:           new(dlq.Coll),
+	}
+}
+
+func fromAtlasCreated(processor *admin.StreamsProcessor) *StreamProcessor {
+	return &StreamProcessor{
+		StreamProcessorConfig: StreamProcessorConfig{
+			Name:     processor.GetName(),
+			Pipeline: processor.GetPipeline(),
+			DLQ:      fromAtlasOptions(processor.Options),
+		},
+		ID:    processor.GetId(),
+		State: StateCreated,
+	}
+}
+
+func fromAtlas(processor *admin.StreamsProcessorWithStats) *StreamProcessor {
+	return &StreamProcessor{
+		StreamProcessorConfig: StreamProcessorConfig{
+			Name:     processor.GetName(),
+			Pipeline: processor.GetPipeline(),
+			DLQ:      fromAtlasOptions(processor.Options),
+		},
+		ID:    processor.GetId(),
+		State: processor.GetState(),
+		Stats: fromAtlasStats(processor.GetStats()),
+	}
+}
+
+func fromAtlasOptions(options *admin.StreamsOptions) *akov2.StreamProcessorDLQ {
+	if options == nil || options.Dlq == nil {
+		return nil
+	}
+	dlq := options.Dlq
+	return &akov2.StreamProcessorDLQ{
+		ConnectionName: dlq.GetConnectionName(),
+		DB:             dlq.GetDb(),
+		Coll:           dlq.GetColl(),
+	}
+}
+
+// atlasStats holds the statistics reported in status, Atlas reports them as a free form document
+type atlasStats struct {
+	InputMessageCount  float64 `json:"inputMessageCount"`
+	InputMessageSize   float64 `json:"inputMessageSize"`
+	OutputMessageCount float64 `json:"outputMessageCount"`
+	OutputMessageSize  float64 `json:"outputMessageSize"`
+	DLQMessageCount    float64 `json:"dlqMessageCount"`
+	DLQMessageSize     float64 `json:"dlqMessageSize"`
+}
+
+func fromAtlasStats(stats any) *status.StreamProcessorStats {
+	data, err := json.Marshal(stats)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+	parsed := atlasStats{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil
+	}
+	return &status.StreamProcessorStats{
+		InputMessageCount:  int64(parsed.InputMessageCount),
+		InputMessageSize:   int64(parsed.InputMessageSize),
+		OutputMessageCount: int64(parsed.OutputMessageCount),
+		OutputMessageSize:  int64(parsed.OutputMessageSize),
+		DLQMessageCount:    int64(parsed.DLQMessageCount),
+		DLQMessageSize:     int64(parsed.DLQMessageSize),
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func TestNewStreamProcessorConfig(t *testing.T) {
+	wantPipeline := []any{
+		map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+		map[string]any{"$emit": map[string]any{"connectionName": "cluster", "db": "solar", "coll": "readings"}},
+	}
+	for _, tc := range []struct {
+		title        string
+		pipeline     string
+		wantPipeline []any
+		wantErr      string
+	}{
+		{
+			title:        "pipeline as a list of stages",
+			pipeline:     `[{"$source":{"connectionName":"sample_stream_solar"}},{"$emit":{"connectionName":"cluster","db":"solar","coll":"readings"}}]`,
+			wantPipeline: wantPipeline,
+		},
+		{
+			title:        "pipeline as a JSON string",
+			pipeline:     `"[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"cluster\",\"db\":\"solar\",\"coll\":\"readings\"}}]"`,
+			wantPipeline: wantPipeline,
+		},
+		{
+			title:    "pipeline is not a list",
+			pipeline: `{"$source":{"connectionName":"sample_stream_solar"}}`,
+			wantErr:  "invalid pipeline: expected a list of stages",
+		},
+		{
+			title:    "pipeline string is not JSON",
+			pipeline: `"$source: sample_stream_solar"`,
+			wantErr:  "invalid pipeline JSON",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			spec := &akov2.AtlasStreamProcessorSpec{
+				Name:     "solar",
+				Pipeline: apiextensions.JSON{Raw: []byte(tc.pipeline)},
+			}
+			cfg, err := NewStreamProcessorConfig(spec)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "solar", cfg.Name)
+			assert.Equal(t, tc.wantPipeline, cfg.Pipeline)
+		})
+	}
+}
+
+func TestRoundtrip(t *testing.T) {
+	cfg := &StreamProcessorConfig{
+		Name: "solar",
+		Pipeline: []any{
+			map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+		},
+		DLQ: &akov2.StreamProcessorDLQ{ConnectionName: "cluster", DB: "solar", Coll: "dlq"},
+	}
+	created := fromAtlasCreated(toAtlas(cfg))
+	assert.Equal(t, cfg, &created.StreamProcessorConfig)
+	assert.Equal(t, StateCreated, created.State)
+}
+
+func TestNeedsUpdate(t *testing.T) {
+	desired := &StreamProcessorConfig{
+		Name: "solar",
+		Pipeline: []any{
+			map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+			map[string]any{"$limit": 10},
+		},
+	}
+	for _, tc := range []struct {
+		title   string
+		current StreamProcessorConfig
+		want    bool
+	}{
+		{
+			title: "same pipeline with numbers decoded differently",
+			current: StreamProcessorConfig{
+				Name: "solar",
+				Pipeline: []any{
+					map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+					map[string]any{"$limit": float64(10)},
+				},
+			},
+		},
+		{
+			title: "different pipeline",
+			current: StreamProcessorConfig{
+				Name: "solar",
+				Pipeline: []any{
+					map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+				},
+			},
+			want: true,
+		},
+		{
+			title: "different dead letter queue",
+			current: StreamProcessorConfig{
+				Name:     "solar",
+				Pipeline: desired.Pipeline,
+				DLQ:      &akov2.StreamProcessorDLQ{ConnectionName: "cluster", DB: "solar", Coll: "dlq"},
+			},
+			want: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.want, NeedsUpdate(desired, &StreamProcessor{StreamProcessorConfig: tc.current}))
+		})
+	}
+}
+
+func TestFromAtlasStats(t *testing.T) {
+	stats := map[string]any{
+		"inputMessageCount":  float64(120),
+		"inputMessageSize":   float64(4096),
+		"outputMessageCount": float64(100),
+		"dlqMessageCount":    float64(2),
+		"stateSize":          float64(512),
+	}
+	assert.Equal(t, &status.StreamProcessorStats{
+		InputMessageCount:  120,
+		InputMessageSize:   4096,
+		OutputMessageCount: 100,
+		DLQMessageCount:    2,
+	}, fromAtlasStats(stats))
+	assert.Nil(t, fromAtlasStats(nil))
+}
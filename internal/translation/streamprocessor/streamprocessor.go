@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+)
+
+var (
+	// ErrNotFound means the stream processor is missing
+	ErrNotFound = errors.New("not found")
+)
+
+type StreamProcessorService interface {
+	Create(ctx context.Context, projectID, instanceName string, cfg *StreamProcessorConfig) (*StreamProcessor, error)
+	Get(ctx context.Context, projectID, instanceName, processorName string) (*StreamProcessor, error)
+	Update(ctx context.Context, projectID, instanceName string, cfg *StreamProcessorConfig) (*StreamProcessor, error)
+	Start(ctx context.Context, projectID, instanceName, processorName string) error
+	Stop(ctx context.Context, projectID, instanceName, processorName string) error
+	Delete(ctx context.Context, projectID, instanceName, processorName string) error
+}
+
+type streamProcessorService struct {
+	streamsAPI admin.StreamsAPI
+}
+
+func NewStreamProcessorServiceFromClientSet(clientSet *atlas.ClientSet) StreamProcessorService {
+	return NewStreamProcessorService(clientSet.SdkClient20250312.StreamsAPI)
+}
+
+func NewStreamProcessorService(streamsAPI admin.StreamsAPI) StreamProcessorService {
+	return &streamProcessorService{streamsAPI: streamsAPI}
+}
+
+func (s *streamProcessorService) Create(ctx context.Context, projectID, instanceName string, cfg *StreamProcessorConfig) (*StreamProcessor, error) {
+	processor, _, err := s.streamsAPI.CreateStreamProcessor(ctx, projectID, instanceName, toAtlas(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream processor %s in instance %s: %w", cfg.Name, instanceName, err)
+	}
+	return fromAtlasCreated(processor), nil
+}
+
+func (s *streamProcessorService) Get(ctx context.Context, projectID, instanceName, processorName string) (*StreamProcessor, error) {
+	processor, resp, err := s.streamsAPI.GetStreamProcessor(ctx, projectID, instanceName, processorName).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream processor %s in instance %s: %w", processorName, instanceName, err)
+	}
+	return fromAtlas(processor), nil
+}
+
+// Update modifies the pipeline and options of a processor, which must not be running.
+func (s *streamProcessorService) Update(ctx context.Context, projectID, instanceName string, cfg *StreamProcessorConfig) (*StreamProcessor, error) {
+	processor, _, err := s.streamsAPI.UpdateStreamProcessor(ctx, projectID, instanceName, cfg.Name, toAtlasUpdate(cfg)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update stream processor %s in instance %s: %w", cfg.Name, instanceName, err)
+	}
+	return fromAtlas(processor), nil
+}
+
+func (s *streamProcessorService) Start(ctx context.Context, projectID, instanceName, processorName string) error {
+	_, err := s.streamsAPI.StartStreamProcessor(ctx, projectID, instanceName, processorName).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to start stream processor %s in instance %s: %w", processorName, instanceName, err)
+	}
+	return nil
+}
+
+func (s *streamProcessorService) Stop(ctx context.Context, projectID, instanceName, processorName string) error {
+	_, err := s.streamsAPI.StopStreamProcessor(ctx, projectID, instanceName, processorName).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to stop stream processor %s in instance %s: %w", processorName, instanceName, err)
+	}
+	return nil
+}
+
+func (s *streamProcessorService) Delete(ctx context.Context, projectID, instanceName, processorName string) error {
+	resp, err := s.streamsAPI.DeleteStreamProcessor(ctx, projectID, instanceName, processorName).Execute()
+	if httputil.StatusCode(resp) == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete stream processor %s in instance %s: %w", processorName, instanceName, err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamprocessor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+const (
+	testProjectID = "5f4007f327a3bd7b6f4103c6"
+
+	testInstanceName = "test-instance"
+
+	testProcessorID = "5f4007f327a3bd7b6f4103c5"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		title         string
+		setupMock     func(streamsAPI *mockadmin.StreamsAPI)
+		wantProcessor *StreamProcessor
+		wantErr       error
+	}{
+		{
+			title: "processor with stats",
+			setupMock: func(streamsAPI *mockadmin.StreamsAPI) {
+				streamsAPI.EXPECT().GetStreamProcessor(ctx, testProjectID, testInstanceName, "solar").Return(
+					admin.GetStreamProcessorApiRequest{ApiService: streamsAPI})
+				streamsAPI.EXPECT().GetStreamProcessorExecute(admin.GetStreamProcessorApiRequest{ApiService: streamsAPI}).Return(
+					&admin.StreamsProcessorWithStats{
+						Id:       testProcessorID,
+						Name:     "solar",
+						Pipeline: []any{map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}}},
+						Options: &admin.StreamsOptions{
+							Dlq: &admin.StreamsDLQ{ConnectionName: new("cluster"), Db: new("solar"), Coll: new("dlq")},
+						},
+						State: StateStarted,
+						Stats: map[string]any{"inputMessageCount": float64(10), "outputMessageCount": float64(8)},
+					}, &http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			wantProcessor: &StreamProcessor{
+				StreamProcessorConfig: StreamProcessorConfig{
+					Name:     "solar",
+					Pipeline: []any{map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}}},
+					DLQ:      &akov2.StreamProcessorDLQ{ConnectionName: "cluster", DB: "solar", Coll: "dlq"},
+				},
+				ID:    testProcessorID,
+				State: StateStarted,
+				Stats: &status.StreamProcessorStats{InputMessageCount: 10, OutputMessageCount: 8},
+			},
+		},
+		{
+			title: "processor not found",
+			setupMock: func(streamsAPI *mockadmin.StreamsAPI) {
+				streamsAPI.EXPECT().GetStreamProcessor(ctx, testProjectID, testInstanceName, "solar").Return(
+					admin.GetStreamProcessorApiRequest{ApiService: streamsAPI})
+				streamsAPI.EXPECT().GetStreamProcessorExecute(admin.GetStreamProcessorApiRequest{ApiService: streamsAPI}).Return(
+					nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found"))
+			},
+			wantErr: ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			streamsAPI := mockadmin.NewStreamsAPI(t)
+			tc.setupMock(streamsAPI)
+			processor, err := NewStreamProcessorService(streamsAPI).Get(ctx, testProjectID, testInstanceName, "solar")
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantProcessor, processor)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		title     string
+		setupMock func(streamsAPI *mockadmin.StreamsAPI)
+		wantErr   error
+	}{
+		{
+			title: "deletes the processor",
+			setupMock: func(streamsAPI *mockadmin.StreamsAPI) {
+				streamsAPI.EXPECT().DeleteStreamProcessor(ctx, testProjectID, testInstanceName, "solar").Return(
+					admin.DeleteStreamProcessorApiRequest{ApiService: streamsAPI})
+				streamsAPI.EXPECT().DeleteStreamProcessorExecute(admin.DeleteStreamProcessorApiRequest{ApiService: streamsAPI}).Return(
+					&http.Response{StatusCode: http.StatusNoContent}, nil)
+			},
+		},
+		{
+			title: "processor already gone",
+			setupMock: func(streamsAPI *mockadmin.StreamsAPI) {
+				streamsAPI.EXPECT().DeleteStreamProcessor(ctx, testProjectID, testInstanceName, "solar").Return(
+					admin.DeleteStreamProcessorApiRequest{ApiService: streamsAPI})
+				streamsAPI.EXPECT().DeleteStreamProcessorExecute(admin.DeleteStreamProcessorApiRequest{ApiService: streamsAPI}).Return(
+					&http.Response{StatusCode: http.StatusNotFound}, errors.New("not found"))
+			},
+			wantErr: ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			streamsAPI := mockadmin.NewStreamsAPI(t)
+			tc.setupMock(streamsAPI)
+			err := NewStreamProcessorService(streamsAPI).Delete(ctx, testProjectID, testInstanceName, "solar")
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
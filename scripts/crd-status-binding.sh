@@ -0,0 +1,36 @@
+#!/bin/bash
+# Copyright 2025 MongoDB Inc
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+set -eou pipefail
+
+# openapi2crd generates the status of the CRDs from the Atlas API only. The
+# generated Cluster and DatabaseUser kinds are Provisioned Services of the
+# Service Binding specification (servicebinding.io), so their status gets a
+# binding field referencing the connection Secret.
+#
+# Usage: crd-status-binding.sh file...
+
+YQ="${YQ:-yq}"
+
+for file in "$@"; do
+	"${YQ}" e -i '(select(.kind == "CustomResourceDefinition" and (.spec.names.kind == "Cluster" or .spec.names.kind == "DatabaseUser"))
+		| .spec.versions[].schema.openAPIV3Schema.properties.status.properties) |= ({"binding": {
+			"description": "The connection Secret exposed according to the Service Binding specification (servicebinding.io).",
+			"properties": {"name": {"type": "string"}},
+			"required": ["name"],
+			"type": "object"
+		}} + .)' "${file}"
+done
@@ -42,6 +42,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/servicebinding"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/conditions"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/events"
@@ -793,6 +794,7 @@ func validateSecret(k8sClient client.Client, project akov2.AtlasProject, deploym
 		"username":                    []byte(username),
 		"password":                    []byte(password),
 	}
+	Expect(servicebinding.Fill(expectedData, string(expectedData["connectionStringStandard"]), string(expectedData["connectionStringStandardSrv"]))).To(Succeed())
 	expectedLabels := map[string]string{
 		"atlas.mongodb.com/project-id":   project.ID(),
 		"atlas.mongodb.com/cluster-name": deployment.GetDeploymentName(),